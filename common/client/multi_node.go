@@ -2,11 +2,16 @@ package client
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"io"
 	"math"
 	"math/big"
+	"net"
+	"regexp"
 	"slices"
 	"sync"
+	"syscall"
 	"time"
 
 	"github.com/prometheus/client_golang/prometheus"
@@ -100,6 +105,7 @@ type multiNode[
 	selectionMode       string
 	noNewHeadsThreshold time.Duration
 	nodeSelector        NodeSelector[CHAIN_ID, HEAD, RPC_CLIENT]
+	callObserver        callObserver[CHAIN_ID, HEAD, RPC_CLIENT]
 	leaseDuration       time.Duration
 	leaseTicker         *time.Ticker
 	chainFamily         string
@@ -166,6 +172,10 @@ func NewMultiNode[
 		sendTxSoftTimeout:   sendTxSoftTimeout,
 	}
 
+	if o, ok := nodeSelector.(callObserver[CHAIN_ID, HEAD, RPC_CLIENT]); ok {
+		c.callObserver = o
+	}
+
 	c.lggr.Debugf("The MultiNode is configured to use NodeSelectionMode: %s", selectionMode)
 
 	return c
@@ -192,6 +202,12 @@ func (c *multiNode[CHAIN_ID, SEQ, ADDR, BLOCK_HASH, TX, TX_HASH, EVENT, EVENT_OP
 				// otherwise leave no nodes available. It is better to have one
 				// node in a degraded state than no nodes at all.
 				rawNode.nLiveNodes = c.nLiveNodes
+				if c.callObserver != nil {
+					n := n
+					rawNode.observePoll = func(latency time.Duration, failed bool) {
+						c.callObserver.observePoll(n, latency, failed)
+					}
+				}
 			}
 			// node will handle its own redialing and automatic recovery
 			if err := ms.Start(ctx, n); err != nil {
@@ -215,6 +231,9 @@ func (c *multiNode[CHAIN_ID, SEQ, ADDR, BLOCK_HASH, TX, TX_HASH, EVENT, EVENT_OP
 			go c.checkLeaseLoop()
 		} else {
 			c.lggr.Info("Best node switching is disabled")
+			if c.selectionMode == NodeSelectionModeLatencyWeighted {
+				c.lggr.Warn("NodeSelectionMode LatencyWeighted only switches away from a slow node while LeaseDuration is set")
+			}
 		}
 
 		return nil
@@ -271,6 +290,33 @@ func (c *multiNode[CHAIN_ID, SEQ, ADDR, BLOCK_HASH, TX, TX_HASH, EVENT, EVENT_OP
 	return c.activeNode, err
 }
 
+// observeCall reports whether a call to node failed to the NodeSelector, if it selects on it. Only transport errors
+// count as failures: other errors, like reverts or unknown transactions, are still answers from the node.
+func (c *multiNode[CHAIN_ID, SEQ, ADDR, BLOCK_HASH, TX, TX_HASH, EVENT, EVENT_OPS, TX_RECEIPT, FEE, HEAD, RPC_CLIENT, BATCH_ELEM]) observeCall(node Node[CHAIN_ID, HEAD, RPC_CLIENT], err error) {
+	if c.callObserver == nil || errors.Is(err, context.Canceled) {
+		return
+	}
+	c.callObserver.observeCall(node, err != nil && isTransportError(err))
+}
+
+// httpStatusErrorRe matches the errors of RPC clients for a rate limited or failed HTTP request, which start with
+// the status, e.g. "503 Service Unavailable: ...".
+var httpStatusErrorRe = regexp.MustCompile(`(^|: )(429|5\d\d) [A-Z]`)
+
+// isTransportError returns whether err means the call did not get an answer from the node: it timed out, the
+// connection failed or was reset, or the node was rate limiting or failing requests.
+func isTransportError(err error) bool {
+	if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) ||
+		errors.Is(err, syscall.ECONNRESET) || errors.Is(err, syscall.ECONNREFUSED) {
+		return true
+	}
+	var netErr net.Error
+	if errors.As(err, &netErr) {
+		return true
+	}
+	return httpStatusErrorRe.MatchString(err.Error())
+}
+
 // nLiveNodes returns the number of currently alive nodes, as well as the highest block number and greatest total difficulty.
 // totalDifficulty will be 0 if all nodes return nil.
 func (c *multiNode[CHAIN_ID, SEQ, ADDR, BLOCK_HASH, TX, TX_HASH, EVENT, EVENT_OPS, TX_RECEIPT, FEE, HEAD, RPC_CLIENT, BATCH_ELEM]) nLiveNodes() (nLiveNodes int, blockNumber int64, totalDifficulty *big.Int) {
@@ -380,7 +426,9 @@ func (c *multiNode[CHAIN_ID, SEQ, ADDR, BLOCK_HASH, TX, TX_HASH, EVENT, EVENT_OP
 	if err != nil {
 		return nil, err
 	}
-	return n.RPC().BalanceAt(ctx, account, blockNumber)
+	res, err := n.RPC().BalanceAt(ctx, account, blockNumber)
+	c.observeCall(n, err)
+	return res, err
 }
 
 func (c *multiNode[CHAIN_ID, SEQ, ADDR, BLOCK_HASH, TX, TX_HASH, EVENT, EVENT_OPS, TX_RECEIPT, FEE, HEAD, RPC_CLIENT, BATCH_ELEM]) BatchCallContext(ctx context.Context, b []BATCH_ELEM) error {
@@ -388,7 +436,9 @@ func (c *multiNode[CHAIN_ID, SEQ, ADDR, BLOCK_HASH, TX, TX_HASH, EVENT, EVENT_OP
 	if err != nil {
 		return err
 	}
-	return n.RPC().BatchCallContext(ctx, b)
+	err = n.RPC().BatchCallContext(ctx, b)
+	c.observeCall(n, err)
+	return err
 }
 
 // BatchCallContextAll calls BatchCallContext for every single node including
@@ -438,7 +488,9 @@ func (c *multiNode[CHAIN_ID, SEQ, ADDR, BLOCK_HASH, TX, TX_HASH, EVENT, EVENT_OP
 	if err != nil {
 		return h, err
 	}
-	return n.RPC().BlockByHash(ctx, hash)
+	res, err := n.RPC().BlockByHash(ctx, hash)
+	c.observeCall(n, err)
+	return res, err
 }
 
 func (c *multiNode[CHAIN_ID, SEQ, ADDR, BLOCK_HASH, TX, TX_HASH, EVENT, EVENT_OPS, TX_RECEIPT, FEE, HEAD, RPC_CLIENT, BATCH_ELEM]) BlockByNumber(ctx context.Context, number *big.Int) (h HEAD, err error) {
@@ -446,7 +498,9 @@ func (c *multiNode[CHAIN_ID, SEQ, ADDR, BLOCK_HASH, TX, TX_HASH, EVENT, EVENT_OP
 	if err != nil {
 		return h, err
 	}
-	return n.RPC().BlockByNumber(ctx, number)
+	res, err := n.RPC().BlockByNumber(ctx, number)
+	c.observeCall(n, err)
+	return res, err
 }
 
 func (c *multiNode[CHAIN_ID, SEQ, ADDR, BLOCK_HASH, TX, TX_HASH, EVENT, EVENT_OPS, TX_RECEIPT, FEE, HEAD, RPC_CLIENT, BATCH_ELEM]) CallContext(ctx context.Context, result interface{}, method string, args ...interface{}) error {
@@ -454,7 +508,9 @@ func (c *multiNode[CHAIN_ID, SEQ, ADDR, BLOCK_HASH, TX, TX_HASH, EVENT, EVENT_OP
 	if err != nil {
 		return err
 	}
-	return n.RPC().CallContext(ctx, result, method, args...)
+	err = n.RPC().CallContext(ctx, result, method, args...)
+	c.observeCall(n, err)
+	return err
 }
 
 func (c *multiNode[CHAIN_ID, SEQ, ADDR, BLOCK_HASH, TX, TX_HASH, EVENT, EVENT_OPS, TX_RECEIPT, FEE, HEAD, RPC_CLIENT, BATCH_ELEM]) CallContract(
@@ -466,7 +522,9 @@ func (c *multiNode[CHAIN_ID, SEQ, ADDR, BLOCK_HASH, TX, TX_HASH, EVENT, EVENT_OP
 	if err != nil {
 		return rpcErr, err
 	}
-	return n.RPC().CallContract(ctx, attempt, blockNumber)
+	res, err := n.RPC().CallContract(ctx, attempt, blockNumber)
+	c.observeCall(n, err)
+	return res, err
 }
 
 func (c *multiNode[CHAIN_ID, SEQ, ADDR, BLOCK_HASH, TX, TX_HASH, EVENT, EVENT_OPS, TX_RECEIPT, FEE, HEAD, RPC_CLIENT, BATCH_ELEM]) PendingCallContract(
@@ -477,7 +535,9 @@ func (c *multiNode[CHAIN_ID, SEQ, ADDR, BLOCK_HASH, TX, TX_HASH, EVENT, EVENT_OP
 	if err != nil {
 		return rpcErr, err
 	}
-	return n.RPC().PendingCallContract(ctx, attempt)
+	res, err := n.RPC().PendingCallContract(ctx, attempt)
+	c.observeCall(n, err)
+	return res, err
 }
 
 // ChainID makes a direct RPC call. In most cases it should be better to use the configured chain id instead by
@@ -487,7 +547,9 @@ func (c *multiNode[CHAIN_ID, SEQ, ADDR, BLOCK_HASH, TX, TX_HASH, EVENT, EVENT_OP
 	if err != nil {
 		return id, err
 	}
-	return n.RPC().ChainID(ctx)
+	res, err := n.RPC().ChainID(ctx)
+	c.observeCall(n, err)
+	return res, err
 }
 
 func (c *multiNode[CHAIN_ID, SEQ, ADDR, BLOCK_HASH, TX, TX_HASH, EVENT, EVENT_OPS, TX_RECEIPT, FEE, HEAD, RPC_CLIENT, BATCH_ELEM]) ChainType() config.ChainType {
@@ -499,7 +561,9 @@ func (c *multiNode[CHAIN_ID, SEQ, ADDR, BLOCK_HASH, TX, TX_HASH, EVENT, EVENT_OP
 	if err != nil {
 		return code, err
 	}
-	return n.RPC().CodeAt(ctx, account, blockNumber)
+	res, err := n.RPC().CodeAt(ctx, account, blockNumber)
+	c.observeCall(n, err)
+	return res, err
 }
 
 func (c *multiNode[CHAIN_ID, SEQ, ADDR, BLOCK_HASH, TX, TX_HASH, EVENT, EVENT_OPS, TX_RECEIPT, FEE, HEAD, RPC_CLIENT, BATCH_ELEM]) ConfiguredChainID() CHAIN_ID {
@@ -511,7 +575,9 @@ func (c *multiNode[CHAIN_ID, SEQ, ADDR, BLOCK_HASH, TX, TX_HASH, EVENT, EVENT_OP
 	if err != nil {
 		return gas, err
 	}
-	return n.RPC().EstimateGas(ctx, call)
+	res, err := n.RPC().EstimateGas(ctx, call)
+	c.observeCall(n, err)
+	return res, err
 }
 
 func (c *multiNode[CHAIN_ID, SEQ, ADDR, BLOCK_HASH, TX, TX_HASH, EVENT, EVENT_OPS, TX_RECEIPT, FEE, HEAD, RPC_CLIENT, BATCH_ELEM]) FilterEvents(ctx context.Context, query EVENT_OPS) (e []EVENT, err error) {
@@ -519,7 +585,9 @@ func (c *multiNode[CHAIN_ID, SEQ, ADDR, BLOCK_HASH, TX, TX_HASH, EVENT, EVENT_OP
 	if err != nil {
 		return e, err
 	}
-	return n.RPC().FilterEvents(ctx, query)
+	res, err := n.RPC().FilterEvents(ctx, query)
+	c.observeCall(n, err)
+	return res, err
 }
 
 func (c *multiNode[CHAIN_ID, SEQ, ADDR, BLOCK_HASH, TX, TX_HASH, EVENT, EVENT_OPS, TX_RECEIPT, FEE, HEAD, RPC_CLIENT, BATCH_ELEM]) IsL2() bool {
@@ -531,7 +599,9 @@ func (c *multiNode[CHAIN_ID, SEQ, ADDR, BLOCK_HASH, TX, TX_HASH, EVENT, EVENT_OP
 	if err != nil {
 		return h, err
 	}
-	return n.RPC().LatestBlockHeight(ctx)
+	res, err := n.RPC().LatestBlockHeight(ctx)
+	c.observeCall(n, err)
+	return res, err
 }
 
 func (c *multiNode[CHAIN_ID, SEQ, ADDR, BLOCK_HASH, TX, TX_HASH, EVENT, EVENT_OPS, TX_RECEIPT, FEE, HEAD, RPC_CLIENT, BATCH_ELEM]) LINKBalance(ctx context.Context, accountAddress ADDR, linkAddress ADDR) (b *assets.Link, err error) {
@@ -539,7 +609,9 @@ func (c *multiNode[CHAIN_ID, SEQ, ADDR, BLOCK_HASH, TX, TX_HASH, EVENT, EVENT_OP
 	if err != nil {
 		return b, err
 	}
-	return n.RPC().LINKBalance(ctx, accountAddress, linkAddress)
+	res, err := n.RPC().LINKBalance(ctx, accountAddress, linkAddress)
+	c.observeCall(n, err)
+	return res, err
 }
 
 func (c *multiNode[CHAIN_ID, SEQ, ADDR, BLOCK_HASH, TX, TX_HASH, EVENT, EVENT_OPS, TX_RECEIPT, FEE, HEAD, RPC_CLIENT, BATCH_ELEM]) NodeStates() (states map[string]string) {
@@ -558,7 +630,9 @@ func (c *multiNode[CHAIN_ID, SEQ, ADDR, BLOCK_HASH, TX, TX_HASH, EVENT, EVENT_OP
 	if err != nil {
 		return s, err
 	}
-	return n.RPC().PendingSequenceAt(ctx, addr)
+	res, err := n.RPC().PendingSequenceAt(ctx, addr)
+	c.observeCall(n, err)
+	return res, err
 }
 
 func (c *multiNode[CHAIN_ID, SEQ, ADDR, BLOCK_HASH, TX, TX_HASH, EVENT, EVENT_OPS, TX_RECEIPT, FEE, HEAD, RPC_CLIENT, BATCH_ELEM]) SendEmptyTransaction(
@@ -573,7 +647,9 @@ func (c *multiNode[CHAIN_ID, SEQ, ADDR, BLOCK_HASH, TX, TX_HASH, EVENT, EVENT_OP
 	if err != nil {
 		return txhash, err
 	}
-	return n.RPC().SendEmptyTransaction(ctx, newTxAttempt, seq, gasLimit, fee, fromAddress)
+	res, err := n.RPC().SendEmptyTransaction(ctx, newTxAttempt, seq, gasLimit, fee, fromAddress)
+	c.observeCall(n, err)
+	return res, err
 }
 
 type sendTxResult struct {
@@ -777,7 +853,9 @@ func (c *multiNode[CHAIN_ID, SEQ, ADDR, BLOCK_HASH, TX, TX_HASH, EVENT, EVENT_OP
 	if err != nil {
 		return s, err
 	}
-	return n.RPC().SequenceAt(ctx, account, blockNumber)
+	res, err := n.RPC().SequenceAt(ctx, account, blockNumber)
+	c.observeCall(n, err)
+	return res, err
 }
 
 func (c *multiNode[CHAIN_ID, SEQ, ADDR, BLOCK_HASH, TX, TX_HASH, EVENT, EVENT_OPS, TX_RECEIPT, FEE, HEAD, RPC_CLIENT, BATCH_ELEM]) SimulateTransaction(ctx context.Context, tx TX) error {
@@ -785,7 +863,9 @@ func (c *multiNode[CHAIN_ID, SEQ, ADDR, BLOCK_HASH, TX, TX_HASH, EVENT, EVENT_OP
 	if err != nil {
 		return err
 	}
-	return n.RPC().SimulateTransaction(ctx, tx)
+	err = n.RPC().SimulateTransaction(ctx, tx)
+	c.observeCall(n, err)
+	return err
 }
 
 func (c *multiNode[CHAIN_ID, SEQ, ADDR, BLOCK_HASH, TX, TX_HASH, EVENT, EVENT_OPS, TX_RECEIPT, FEE, HEAD, RPC_CLIENT, BATCH_ELEM]) Subscribe(ctx context.Context, channel chan<- HEAD, args ...interface{}) (s types.Subscription, err error) {
@@ -801,7 +881,9 @@ func (c *multiNode[CHAIN_ID, SEQ, ADDR, BLOCK_HASH, TX, TX_HASH, EVENT, EVENT_OP
 	if err != nil {
 		return b, err
 	}
-	return n.RPC().TokenBalance(ctx, account, tokenAddr)
+	res, err := n.RPC().TokenBalance(ctx, account, tokenAddr)
+	c.observeCall(n, err)
+	return res, err
 }
 
 func (c *multiNode[CHAIN_ID, SEQ, ADDR, BLOCK_HASH, TX, TX_HASH, EVENT, EVENT_OPS, TX_RECEIPT, FEE, HEAD, RPC_CLIENT, BATCH_ELEM]) TransactionByHash(ctx context.Context, txHash TX_HASH) (tx TX, err error) {
@@ -809,7 +891,9 @@ func (c *multiNode[CHAIN_ID, SEQ, ADDR, BLOCK_HASH, TX, TX_HASH, EVENT, EVENT_OP
 	if err != nil {
 		return tx, err
 	}
-	return n.RPC().TransactionByHash(ctx, txHash)
+	res, err := n.RPC().TransactionByHash(ctx, txHash)
+	c.observeCall(n, err)
+	return res, err
 }
 
 func (c *multiNode[CHAIN_ID, SEQ, ADDR, BLOCK_HASH, TX, TX_HASH, EVENT, EVENT_OPS, TX_RECEIPT, FEE, HEAD, RPC_CLIENT, BATCH_ELEM]) TransactionReceipt(ctx context.Context, txHash TX_HASH) (txr TX_RECEIPT, err error) {
@@ -817,7 +901,9 @@ func (c *multiNode[CHAIN_ID, SEQ, ADDR, BLOCK_HASH, TX, TX_HASH, EVENT, EVENT_OP
 	if err != nil {
 		return txr, err
 	}
-	return n.RPC().TransactionReceipt(ctx, txHash)
+	res, err := n.RPC().TransactionReceipt(ctx, txHash)
+	c.observeCall(n, err)
+	return res, err
 }
 
 func (c *multiNode[CHAIN_ID, SEQ, ADDR, BLOCK_HASH, TX, TX_HASH, EVENT, EVENT_OPS, TX_RECEIPT, FEE, HEAD, RPC_CLIENT, BATCH_ELEM]) LatestFinalizedBlock(ctx context.Context) (head HEAD, err error) {
@@ -826,5 +912,7 @@ func (c *multiNode[CHAIN_ID, SEQ, ADDR, BLOCK_HASH, TX, TX_HASH, EVENT, EVENT_OP
 		return head, err
 	}
 
-	return n.RPC().LatestFinalizedBlock(ctx)
+	res, err := n.RPC().LatestFinalizedBlock(ctx)
+	c.observeCall(n, err)
+	return res, err
 }
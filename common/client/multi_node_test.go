@@ -4,8 +4,11 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io"
 	"math/big"
 	"math/rand"
+	"net"
+	"syscall"
 	"testing"
 	"time"
 
@@ -878,3 +881,24 @@ func TestMultiNode_SendTransaction_aggregateTxResults(t *testing.T) {
 	assert.Empty(t, codesToCover, "all of the SendTxReturnCode must be covered by this test")
 
 }
+
+func TestMultiNode_isTransportError(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		err       error
+		transport bool
+	}{
+		{context.DeadlineExceeded, true},
+		{fmt.Errorf("read: %w", syscall.ECONNRESET), true},
+		{&net.OpError{Op: "dial", Net: "tcp", Err: syscall.ECONNREFUSED}, true},
+		{io.ErrUnexpectedEOF, true},
+		{errors.New("429 Too Many Requests: rate limited"), true},
+		{fmt.Errorf("call failed: %w", errors.New("503 Service Unavailable: ")), true},
+		{errors.New("execution reverted"), false},
+		{errors.New("not found"), false},
+		{errors.New("nonce too low: 500 pending"), false},
+	} {
+		assert.Equal(t, tc.transport, isTransportError(tc.err), tc.err.Error())
+	}
+}
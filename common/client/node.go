@@ -105,6 +105,10 @@ type node[
 	//  moved to out-of-sync state. It is better to have one out-of-sync node than no nodes at all.
 	//  2. compare against the highest head (by number or difficulty) to ensure we don't fall behind too far.
 	nLiveNodes func() (count int, blockNumber int64, totalDifficulty *big.Int)
+	// observePoll is a passed in function that allows this node to report how long its liveness polls take, and
+	// whether they fail, to a NodeSelector which selects on it. The polls measure every alive node at the same
+	// rate, not only the one currently selected.
+	observePoll func(latency time.Duration, failed bool)
 }
 
 func NewNode[
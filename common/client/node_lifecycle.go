@@ -146,8 +146,12 @@ func (n *node[CHAIN_ID, HEAD, RPC]) aliveLoop() {
 			promPoolRPCNodePolls.WithLabelValues(n.chainID.String(), n.name).Inc()
 			lggr.Tracew("Polling for version", "nodeState", n.State(), "pollFailures", pollFailures)
 			ctx, cancel := context.WithTimeout(n.nodeCtx, pollInterval)
+			pollStart := time.Now()
 			version, err := n.RPC().ClientVersion(ctx)
 			cancel()
+			if n.observePoll != nil {
+				n.observePoll(time.Since(pollStart), err != nil)
+			}
 			if err != nil {
 				// prevent overflow
 				if pollFailures < math.MaxUint32 {
@@ -244,7 +248,7 @@ func (n *node[CHAIN_ID, HEAD, RPC]) syncStatus(num int64, td *big.Int) (outOfSyn
 	ln, highest, greatest := n.nLiveNodes()
 	mode := n.nodePoolCfg.SelectionMode()
 	switch mode {
-	case NodeSelectionModeHighestHead, NodeSelectionModeRoundRobin, NodeSelectionModePriorityLevel:
+	case NodeSelectionModeHighestHead, NodeSelectionModeRoundRobin, NodeSelectionModePriorityLevel, NodeSelectionModeLatencyWeighted:
 		return num < highest-int64(threshold), ln
 	case NodeSelectionModeTotalDifficulty:
 		bigThreshold := big.NewInt(int64(threshold))
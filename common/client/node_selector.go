@@ -2,6 +2,7 @@ package client
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/smartcontractkit/chainlink/v2/common/types"
 )
@@ -11,6 +12,7 @@ const (
 	NodeSelectionModeRoundRobin      = "RoundRobin"
 	NodeSelectionModeTotalDifficulty = "TotalDifficulty"
 	NodeSelectionModePriorityLevel   = "PriorityLevel"
+	NodeSelectionModeLatencyWeighted = "LatencyWeighted"
 )
 
 //go:generate mockery --quiet --name NodeSelector --structname mockNodeSelector --filename "mock_node_selector_test.go" --inpackage --case=underscore
@@ -26,6 +28,22 @@ type NodeSelector[
 	Name() string
 }
 
+// callObserver is implemented by the NodeSelectors which select on how the nodes respond to RPC calls.
+type callObserver[
+	CHAIN_ID types.ID,
+	HEAD Head,
+	RPC NodeClient[CHAIN_ID, HEAD],
+] interface {
+	// observePoll records that a liveness poll of node took latency, and whether it failed. The polls are the same
+	// call, made to every alive node at the same rate, so their latencies compare the nodes.
+	// Implementation must be thread-safe.
+	observePoll(node Node[CHAIN_ID, HEAD, RPC], latency time.Duration, failed bool)
+	// observeCall records whether a call to node failed to get an answer from it. The latencies of calls are not
+	// recorded, as they depend on the method called more than on the node.
+	// Implementation must be thread-safe.
+	observeCall(node Node[CHAIN_ID, HEAD, RPC], failed bool)
+}
+
 func newNodeSelector[
 	CHAIN_ID types.ID,
 	HEAD Head,
@@ -40,6 +58,8 @@ func newNodeSelector[
 		return NewTotalDifficultyNodeSelector[CHAIN_ID, HEAD, RPC](nodes)
 	case NodeSelectionModePriorityLevel:
 		return NewPriorityLevelNodeSelector[CHAIN_ID, HEAD, RPC](nodes)
+	case NodeSelectionModeLatencyWeighted:
+		return NewLatencyWeightedNodeSelector[CHAIN_ID, HEAD, RPC](nodes)
 	default:
 		panic(fmt.Sprintf("unsupported NodeSelectionMode: %s", selectionMode))
 	}
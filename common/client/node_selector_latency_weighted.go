@@ -0,0 +1,124 @@
+package client
+
+import (
+	"sync"
+	"time"
+
+	"github.com/smartcontractkit/chainlink/v2/common/types"
+)
+
+const (
+	// latencyWeightedDecay is the weight of the latest poll or call in a node's rolling latency and error rate.
+	latencyWeightedDecay = 0.1
+	// latencyWeightedErrorPenalty is how much a node's error rate inflates its score: a node failing 10% of its calls
+	// scores as if it were twice as slow.
+	latencyWeightedErrorPenalty = 10
+	// latencyWeightedHysteresis is how much better than the currently selected node another node must score to be
+	// selected instead, so that nodes performing alike do not flap.
+	latencyWeightedHysteresis = 0.2
+)
+
+// nodeCallStats are the rolling averages of a node's polls and calls.
+type nodeCallStats struct {
+	latency   float64 // seconds, of the liveness polls
+	errorRate float64 // fraction of polls and calls which failed
+	polled    bool
+}
+
+type latencyWeightedNodeSelector[
+	CHAIN_ID types.ID,
+	HEAD Head,
+	RPC NodeClient[CHAIN_ID, HEAD],
+] struct {
+	nodes []Node[CHAIN_ID, HEAD, RPC]
+
+	mu       sync.Mutex
+	stats    map[Node[CHAIN_ID, HEAD, RPC]]*nodeCallStats
+	selected Node[CHAIN_ID, HEAD, RPC]
+}
+
+// NewLatencyWeightedNodeSelector returns a NodeSelector selecting the alive node with the lowest rolling latency of
+// its liveness polls, weighted by its rolling error rate. Nodes which have not been polled yet are preferred, so that
+// they get measured.
+func NewLatencyWeightedNodeSelector[
+	CHAIN_ID types.ID,
+	HEAD Head,
+	RPC NodeClient[CHAIN_ID, HEAD],
+](nodes []Node[CHAIN_ID, HEAD, RPC]) NodeSelector[CHAIN_ID, HEAD, RPC] {
+	return &latencyWeightedNodeSelector[CHAIN_ID, HEAD, RPC]{
+		nodes: nodes,
+		stats: make(map[Node[CHAIN_ID, HEAD, RPC]]*nodeCallStats, len(nodes)),
+	}
+}
+
+func (s *latencyWeightedNodeSelector[CHAIN_ID, HEAD, RPC]) Select() Node[CHAIN_ID, HEAD, RPC] {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var best Node[CHAIN_ID, HEAD, RPC]
+	var bestScore float64
+	for _, n := range s.nodes {
+		if n.State() != nodeStateAlive {
+			continue
+		}
+		score := s.score(n)
+		if best == nil || score < bestScore || (score == bestScore && n.Order() < best.Order()) {
+			best, bestScore = n, score
+		}
+	}
+
+	// keep the selected node unless the best one is clearly better
+	if best != nil && s.selected != nil && s.selected != best && s.selected.State() == nodeStateAlive &&
+		bestScore > s.score(s.selected)*(1-latencyWeightedHysteresis) {
+		return s.selected
+	}
+	s.selected = best
+	return best
+}
+
+func (s *latencyWeightedNodeSelector[CHAIN_ID, HEAD, RPC]) Name() string {
+	return NodeSelectionModeLatencyWeighted
+}
+
+func (s *latencyWeightedNodeSelector[CHAIN_ID, HEAD, RPC]) observePoll(node Node[CHAIN_ID, HEAD, RPC], latency time.Duration, failed bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	stats, ok := s.stats[node]
+	if !ok || !stats.polled {
+		if !ok {
+			stats = &nodeCallStats{errorRate: failureRate(failed)}
+			s.stats[node] = stats
+		}
+		stats.latency, stats.polled = latency.Seconds(), true
+		return
+	}
+	stats.latency += latencyWeightedDecay * (latency.Seconds() - stats.latency)
+	stats.errorRate += latencyWeightedDecay * (failureRate(failed) - stats.errorRate)
+}
+
+func (s *latencyWeightedNodeSelector[CHAIN_ID, HEAD, RPC]) observeCall(node Node[CHAIN_ID, HEAD, RPC], failed bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	stats, ok := s.stats[node]
+	if !ok {
+		s.stats[node] = &nodeCallStats{errorRate: failureRate(failed)}
+		return
+	}
+	stats.errorRate += latencyWeightedDecay * (failureRate(failed) - stats.errorRate)
+}
+
+// score is lower for better nodes. Must be called with mu held.
+func (s *latencyWeightedNodeSelector[CHAIN_ID, HEAD, RPC]) score(node Node[CHAIN_ID, HEAD, RPC]) float64 {
+	stats, ok := s.stats[node]
+	if !ok || !stats.polled {
+		return 0
+	}
+	return stats.latency * (1 + latencyWeightedErrorPenalty*stats.errorRate)
+}
+
+func failureRate(failed bool) float64 {
+	if failed {
+		return 1
+	}
+	return 0
+}
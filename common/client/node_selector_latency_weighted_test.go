@@ -0,0 +1,90 @@
+package client
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/smartcontractkit/chainlink/v2/common/types"
+)
+
+func TestLatencyWeightedNodeSelectorName(t *testing.T) {
+	selector := newNodeSelector[types.ID, Head, NodeClient[types.ID, Head]](NodeSelectionModeLatencyWeighted, nil)
+	assert.Equal(t, selector.Name(), NodeSelectionModeLatencyWeighted)
+}
+
+func TestLatencyWeightedNodeSelector(t *testing.T) {
+	t.Parallel()
+
+	type nodeClient NodeClient[types.ID, Head]
+	var nodes []Node[types.ID, Head, nodeClient]
+
+	for i := 0; i < 4; i++ {
+		node := newMockNode[types.ID, Head, nodeClient](t)
+		if i == 0 {
+			// first node is out of sync
+			node.On("State").Return(nodeStateOutOfSync).Maybe()
+		} else {
+			// others are alive
+			node.On("State").Return(nodeStateAlive).Maybe()
+		}
+		node.On("Order").Return(int32(i)).Maybe()
+		nodes = append(nodes, node)
+	}
+
+	selector := newNodeSelector(NodeSelectionModeLatencyWeighted, nodes)
+	observer := selector.(callObserver[types.ID, Head, nodeClient])
+
+	// nodes without calls are preferred, lowest Order first
+	assert.Same(t, nodes[1], selector.Select())
+
+	observer.observePoll(nodes[0], time.Millisecond, false)
+	observer.observePoll(nodes[1], 100*time.Millisecond, false)
+	observer.observePoll(nodes[2], 300*time.Millisecond, false)
+	assert.Same(t, nodes[3], selector.Select())
+
+	observer.observePoll(nodes[3], 200*time.Millisecond, false)
+	// node 1 is fastest alive node
+	assert.Same(t, nodes[1], selector.Select())
+
+	// node 3 becoming slightly faster is not enough to switch
+	for i := 0; i < 10; i++ {
+		observer.observePoll(nodes[3], 90*time.Millisecond, false)
+	}
+	assert.Same(t, nodes[1], selector.Select())
+
+	// node 1 failing polls is penalised
+	for i := 0; i < 5; i++ {
+		observer.observePoll(nodes[1], 100*time.Millisecond, true)
+	}
+	assert.Same(t, nodes[3], selector.Select())
+
+	// so is node 3 failing calls, which do not change its latency
+	for i := 0; i < 10; i++ {
+		observer.observeCall(nodes[3], true)
+	}
+	assert.Same(t, nodes[2], selector.Select())
+}
+
+func TestLatencyWeightedNodeSelector_None(t *testing.T) {
+	t.Parallel()
+
+	type nodeClient NodeClient[types.ID, Head]
+	var nodes []Node[types.ID, Head, nodeClient]
+
+	for i := 0; i < 3; i++ {
+		node := newMockNode[types.ID, Head, nodeClient](t)
+		if i == 0 {
+			// first node is out of sync
+			node.On("State").Return(nodeStateOutOfSync)
+		} else {
+			// others are unreachable
+			node.On("State").Return(nodeStateUnreachable)
+		}
+		nodes = append(nodes, node)
+	}
+
+	selector := newNodeSelector(NodeSelectionModeLatencyWeighted, nodes)
+	assert.Nil(t, selector.Select())
+}
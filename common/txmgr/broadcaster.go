@@ -85,6 +85,27 @@ type TransmitChecker[
 	Check(ctx context.Context, l logger.SugaredLogger, tx txmgrtypes.Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE], a txmgrtypes.TxAttempt[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) error
 }
 
+// RevertError is returned by a TransmitChecker when the transaction would revert on chain, and by
+// TxmClient.CallContract when replaying a transaction which reverted on chain. Its Reason is saved as the RevertReason
+// of the transaction.
+type RevertError struct {
+	// Reason is the decoded revert reason.
+	Reason string
+	Err    error
+}
+
+func (e *RevertError) Error() string { return e.Err.Error() }
+
+func (e *RevertError) Unwrap() error { return e.Err }
+
+// String stringifies the underlying error for logging, which for RPC errors includes all of their fields.
+func (e *RevertError) String() string {
+	if s, ok := e.Err.(fmt.Stringer); ok {
+		return s.String()
+	}
+	return e.Err.Error()
+}
+
 // Broadcaster monitors txes for transactions that need to
 // be broadcast, assigns sequences and ensures that at least one node
 // somewhere has received the transaction successfully.
@@ -541,6 +562,10 @@ func (eb *Broadcaster[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) hand
 		lgr.Warn("Transmission checker timed out, sending anyway")
 	} else if err != nil {
 		etx.Error = null.StringFrom(err.Error())
+		var revertErr *RevertError
+		if errors.As(err, &revertErr) {
+			etx.RevertReason = null.StringFrom(revertErr.Reason)
+		}
 		lgr.Warnw("Transmission checker failed, fatally erroring transaction.", "err", err)
 		return eb.saveFatallyErroredTransaction(lgr, etx), true
 	}
@@ -577,8 +577,13 @@ func (ec *Confirmer[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) bat
 			rpcError, errExtract := ec.client.CallContract(ctx, attempt, receipt.GetBlockNumber())
 			if errExtract == nil {
 				l.Warnw("transaction reverted on-chain", "hash", receipt.GetTxHash(), "rpcError", rpcError.String())
+				if revertErr, ok := rpcError.(*RevertError); ok {
+					if errSave := ec.txStore.UpdateTxRevertReason(ctx, attempt.TxID, revertErr.Reason); errSave != nil {
+						l.Errorw("Failed to save revert reason", "revertReason", revertErr.Reason, "err", errSave)
+					}
+				}
 			} else {
-				l.Warnw("transaction reverted on-chain unable to extract revert reason", "hash", receipt.GetTxHash(), "err", err)
+				l.Warnw("transaction reverted on-chain unable to extract revert reason", "hash", receipt.GetTxHash(), "err", errExtract)
 			}
 			// This might increment more than once e.g. in case of re-orgs going back and forth we might re-fetch the same receipt
 			promRevertedTxCount.WithLabelValues(ec.chainID.String()).Add(1)
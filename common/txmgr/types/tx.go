@@ -110,6 +110,10 @@ type TransmitCheckerSpec[ADDR types.Hashable] struct {
 	// VRFRequestBlockNumber is the block number in which the provided VRF request has been made.
 	// This should be set iff CheckerType is TransmitCheckerTypeVRFV2.
 	VRFRequestBlockNumber *big.Int `json:",omitempty"`
+
+	// Simulate, if set, also simulates the transaction once the check of CheckerType passed, e.g. for jobs with
+	// simulateTransactions set.
+	Simulate bool `json:",omitempty"`
 }
 
 // TransmitCheckerType describes the type of check that should be performed before a transaction is
@@ -207,6 +211,8 @@ type Tx[
 	// necessarily the same as the on-chain encoded value (i.e. Optimism)
 	FeeLimit uint64
 	Error    null.String
+	// RevertReason is the decoded reason the transaction reverted with, if it did
+	RevertReason null.String
 	// BroadcastAt is updated every time an attempt for this tx is re-sent
 	// In almost all cases it will be within a second or so of the actual send time.
 	BroadcastAt *time.Time
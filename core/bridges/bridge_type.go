@@ -1,8 +1,11 @@
 package bridges
 
 import (
+	"crypto/hmac"
+	"crypto/sha256"
 	"crypto/subtle"
 	"database/sql/driver"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
 	"math/big"
@@ -78,21 +81,21 @@ func NewBridgeType(btr *BridgeTypeRequest) (*BridgeTypeAuthentication,
 	}
 
 	return &BridgeTypeAuthentication{
-			Name:                   btr.Name,
-			URL:                    btr.URL,
-			Confirmations:          btr.Confirmations,
-			IncomingToken:          incomingToken,
-			OutgoingToken:          outgoingToken,
-			MinimumContractPayment: btr.MinimumContractPayment,
-		}, &BridgeType{
-			Name:                   btr.Name,
-			URL:                    btr.URL,
-			Confirmations:          btr.Confirmations,
-			IncomingTokenHash:      hash,
-			Salt:                   salt,
-			OutgoingToken:          outgoingToken,
-			MinimumContractPayment: btr.MinimumContractPayment,
-		}, nil
+		Name:                   btr.Name,
+		URL:                    btr.URL,
+		Confirmations:          btr.Confirmations,
+		IncomingToken:          incomingToken,
+		OutgoingToken:          outgoingToken,
+		MinimumContractPayment: btr.MinimumContractPayment,
+	}, &BridgeType{
+		Name:                   btr.Name,
+		URL:                    btr.URL,
+		Confirmations:          btr.Confirmations,
+		IncomingTokenHash:      hash,
+		Salt:                   salt,
+		OutgoingToken:          outgoingToken,
+		MinimumContractPayment: btr.MinimumContractPayment,
+	}, nil
 }
 
 // AuthenticateBridgeType returns true if the passed token matches its
@@ -105,6 +108,18 @@ func AuthenticateBridgeType(bt *BridgeType, token string) (bool, error) {
 	return subtle.ConstantTimeCompare([]byte(hash), []byte(bt.IncomingTokenHash)) == 1, nil
 }
 
+// AuthenticateBridgeResume returns true if signature is the hex encoded HMAC-SHA256 of body, keyed with the bridge's
+// OutgoingToken. Async bridge tasks requiring signed resumes only accept results signed this way.
+func AuthenticateBridgeResume(bt *BridgeType, body []byte, signature string) bool {
+	sig, err := hex.DecodeString(strings.TrimPrefix(signature, "0x"))
+	if err != nil {
+		return false
+	}
+	mac := hmac.New(sha256.New, []byte(bt.OutgoingToken))
+	mac.Write(body)
+	return hmac.Equal(sig, mac.Sum(nil))
+}
+
 func incomingTokenHash(token, salt string) (string, error) {
 	input := fmt.Sprintf("%s-%s", token, salt)
 	hash, err := utils.Sha256(input)
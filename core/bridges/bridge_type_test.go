@@ -1,6 +1,9 @@
 package bridges_test
 
 import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
 	"math/big"
 	"math/rand"
@@ -37,6 +40,22 @@ func TestBridgeTypeRequest(t *testing.T) {
 	assert.Error(t, r.SetID("abc123.,<>/.foobar"))
 }
 
+func TestBridgeType_AuthenticateResume(t *testing.T) {
+	t.Parallel()
+
+	_, bt := cltest.NewBridgeType(t, cltest.BridgeOpts{})
+	body := []byte(`{"data":{"result":"100"}}`)
+	mac := hmac.New(sha256.New, []byte(bt.OutgoingToken))
+	mac.Write(body)
+	signature := hex.EncodeToString(mac.Sum(nil))
+
+	assert.True(t, bridges.AuthenticateBridgeResume(bt, body, signature))
+	assert.True(t, bridges.AuthenticateBridgeResume(bt, body, "0x"+signature))
+	assert.False(t, bridges.AuthenticateBridgeResume(bt, []byte(`{"data":{"result":"101"}}`), signature))
+	assert.False(t, bridges.AuthenticateBridgeResume(bt, body, "gibberish"))
+	assert.False(t, bridges.AuthenticateBridgeResume(bt, body, ""))
+}
+
 func TestBridgeType_Authenticate(t *testing.T) {
 	t.Parallel()
 
@@ -0,0 +1,281 @@
+package actions
+
+import (
+	"context"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/mitchellh/mapstructure"
+	"google.golang.org/protobuf/proto"
+
+	chainselectors "github.com/smartcontractkit/chain-selectors"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/capabilities"
+	commontypes "github.com/smartcontractkit/chainlink-common/pkg/types"
+	"github.com/smartcontractkit/chainlink-common/pkg/values"
+
+	"github.com/smartcontractkit/chainlink/v2/core/capabilities/internal/abivalues"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/legacyevm"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/relay/evm"
+	"github.com/smartcontractkit/chainlink/v2/core/services/relay/evm/types"
+)
+
+// contractName is the name the contract of a read is bound to in its ChainReader.
+const contractName = "contract"
+
+const (
+	confidenceFinalized   = "finalized"
+	confidenceUnconfirmed = "unconfirmed"
+)
+
+func InitializeRead(registry commontypes.CapabilitiesRegistry, legacyEVMChains legacyevm.LegacyChainContainer, lggr logger.Logger) error {
+	for _, chain := range legacyEVMChains.Slice() {
+		capability := NewEvmRead(chain, lggr)
+		if err := registry.Add(context.TODO(), capability); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+var (
+	_ capabilities.ActionCapability = &EvmRead{}
+)
+
+// EvmRead reads the latest value of a contract method or event with a ChainReader, e.g.
+//
+//	actions:
+//	  - type: read_ethereum-testnet-sepolia
+//	    ref: read_answer
+//	    inputs:
+//	      params: {}
+//	    config:
+//	      address: "0x..."
+//	      abi: '[{"type":"function","name":"latestAnswer",...}]'
+//	      method: latestAnswer
+//
+// The ChainReader of each step is created when its workflow is registered.
+type EvmRead struct {
+	chain legacyevm.Chain
+	capabilities.CapabilityInfo
+	lggr logger.Logger
+
+	mu      sync.Mutex
+	readers map[string]evm.ChainReaderService
+}
+
+type EvmReadConfig struct {
+	Address string
+	ABI     string
+	// Method is the name of the contract method, or of the event if ReadType is event.
+	Method   string
+	ReadType string
+	// Confidence is finalized, the default, or unconfirmed. It applies to events only,
+	// and is rejected for methods, which are always called at the latest block.
+	Confidence string
+}
+
+func NewEvmRead(chain legacyevm.Chain, lggr logger.Logger) *EvmRead {
+	// generate ID based on chain selector
+	name := fmt.Sprintf("read_%v", chain.ID())
+	chainName, err := chainselectors.NameFromChainId(chain.ID().Uint64())
+	if err == nil {
+		name = fmt.Sprintf("read_%v", chainName)
+	}
+
+	info := capabilities.MustNewCapabilityInfo(
+		name,
+		capabilities.CapabilityTypeAction,
+		"Read action.",
+		"v1.0.0",
+	)
+
+	return &EvmRead{
+		chain:          chain,
+		CapabilityInfo: info,
+		lggr:           lggr.Named("EvmRead"),
+		readers:        map[string]evm.ChainReaderService{},
+	}
+}
+
+func parseReadConfig(rawConfig *values.Map) (EvmReadConfig, error) {
+	var config EvmReadConfig
+	if rawConfig == nil {
+		return config, errors.New("missing config")
+	}
+	configAny, err := rawConfig.Unwrap()
+	if err != nil {
+		return config, err
+	}
+	if err = mapstructure.Decode(configAny, &config); err != nil {
+		return config, err
+	}
+
+	if !common.IsHexAddress(config.Address) {
+		return config, fmt.Errorf("invalid address %q", config.Address)
+	}
+	if config.Method == "" {
+		return config, errors.New("missing method")
+	}
+	var readType types.ReadType
+	if config.ReadType != "" {
+		if err = readType.UnmarshalText([]byte(strings.ToLower(config.ReadType))); err != nil {
+			return config, err
+		}
+	}
+	switch config.Confidence {
+	case "":
+		if readType == types.Event {
+			config.Confidence = confidenceFinalized
+		}
+	case confidenceFinalized, confidenceUnconfirmed:
+		if readType != types.Event {
+			return config, fmt.Errorf("confidence applies to events only, method %s is called at the latest block", config.Method)
+		}
+	default:
+		return config, fmt.Errorf("invalid confidence %q, expected %s or %s", config.Confidence, confidenceFinalized, confidenceUnconfirmed)
+	}
+	return config, nil
+}
+
+// readerKey identifies the reader of a step by its workflow and config, since a
+// workflow may read from several contracts.
+func readerKey(workflowID string, config *values.Map) (string, error) {
+	b, err := proto.MarshalOptions{Deterministic: true}.Marshal(values.Proto(config))
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%s-%x", workflowID, sha256.Sum256(b)), nil
+}
+
+func (cap *EvmRead) newReader(ctx context.Context, config EvmReadConfig) (evm.ChainReaderService, error) {
+	var readType types.ReadType
+	if config.ReadType != "" {
+		if err := readType.UnmarshalText([]byte(strings.ToLower(config.ReadType))); err != nil {
+			return nil, err
+		}
+	}
+
+	reader, err := evm.NewChainReaderService(ctx, cap.lggr, cap.chain.LogPoller(), cap.chain, types.ChainReaderConfig{
+		Contracts: map[string]types.ChainContractReader{
+			contractName: {
+				ContractABI: config.ABI,
+				Configs: map[string]*types.ChainReaderDefinition{
+					config.Method: {ChainSpecificName: config.Method, ReadType: readType},
+				},
+			},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = reader.Bind(ctx, []commontypes.BoundContract{{
+		Address: config.Address,
+		Name:    contractName,
+		Pending: config.Confidence == confidenceUnconfirmed,
+	}})
+	if err != nil {
+		return nil, err
+	}
+	return reader, nil
+}
+
+func (cap *EvmRead) Execute(ctx context.Context, callback chan<- capabilities.CapabilityResponse, request capabilities.CapabilityRequest) error {
+	config, err := parseReadConfig(request.Config)
+	if err != nil {
+		return err
+	}
+	key, err := readerKey(request.Metadata.WorkflowID, request.Config)
+	if err != nil {
+		return err
+	}
+	cap.mu.Lock()
+	reader, ok := cap.readers[key]
+	cap.mu.Unlock()
+	if !ok {
+		return fmt.Errorf("workflow %s is not registered", request.Metadata.WorkflowID)
+	}
+
+	params := map[string]any{}
+	if request.Inputs != nil {
+		if p, ok := request.Inputs.Underlying["params"]; ok {
+			unwrapped, err := p.Unwrap()
+			if err != nil {
+				return err
+			}
+			if params, ok = unwrapped.(map[string]any); !ok {
+				return fmt.Errorf("params must be a map, got %T", unwrapped)
+			}
+		}
+	}
+
+	typeProvider, ok := reader.(commontypes.ContractTypeProvider)
+	if !ok {
+		return errors.New("chain reader does not provide contract types")
+	}
+	result, err := typeProvider.CreateContractType(contractName, config.Method, false)
+	if err != nil {
+		return err
+	}
+	if err = reader.GetLatestValue(ctx, contractName, config.Method, params, result); err != nil {
+		return fmt.Errorf("failed to read %s: %w", config.Method, err)
+	}
+	value, err := values.Wrap(abivalues.Normalize(result))
+	if err != nil {
+		return err
+	}
+
+	go func() {
+		callback <- capabilities.CapabilityResponse{Value: value}
+		close(callback)
+	}()
+	return nil
+}
+
+func (cap *EvmRead) RegisterToWorkflow(ctx context.Context, request capabilities.RegisterToWorkflowRequest) error {
+	config, err := parseReadConfig(request.Config)
+	if err != nil {
+		return err
+	}
+	key, err := readerKey(request.Metadata.WorkflowID, request.Config)
+	if err != nil {
+		return err
+	}
+
+	cap.mu.Lock()
+	defer cap.mu.Unlock()
+	if _, ok := cap.readers[key]; ok {
+		return nil
+	}
+	reader, err := cap.newReader(ctx, config)
+	if err != nil {
+		return fmt.Errorf("failed to create chain reader: %w", err)
+	}
+	if err = reader.Start(ctx); err != nil {
+		return fmt.Errorf("failed to start chain reader: %w", err)
+	}
+	cap.readers[key] = reader
+	return nil
+}
+
+func (cap *EvmRead) UnregisterFromWorkflow(ctx context.Context, request capabilities.UnregisterFromWorkflowRequest) error {
+	key, err := readerKey(request.Metadata.WorkflowID, request.Config)
+	if err != nil {
+		return err
+	}
+
+	cap.mu.Lock()
+	reader, ok := cap.readers[key]
+	delete(cap.readers, key)
+	cap.mu.Unlock()
+	if !ok {
+		return nil
+	}
+	return reader.Close()
+}
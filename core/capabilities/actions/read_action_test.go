@@ -0,0 +1,118 @@
+package actions_test
+
+import (
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/capabilities"
+	"github.com/smartcontractkit/chainlink-common/pkg/values"
+
+	"github.com/smartcontractkit/chainlink/v2/core/capabilities/actions"
+	clientmocks "github.com/smartcontractkit/chainlink/v2/core/chains/evm/client/mocks"
+	lpmocks "github.com/smartcontractkit/chainlink/v2/core/chains/evm/logpoller/mocks"
+	evmmocks "github.com/smartcontractkit/chainlink/v2/core/chains/legacyevm/mocks"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+)
+
+const balanceABI = `[{"type":"function","name":"balanceOf","stateMutability":"view",
+	"inputs":[{"name":"account","type":"address"}],
+	"outputs":[{"name":"balance","type":"uint256"},{"name":"frozen","type":"bool"}]}]`
+
+func TestEvmRead(t *testing.T) {
+	ctx := testutils.Context(t)
+	contractABI, err := abi.JSON(strings.NewReader(balanceABI))
+	require.NoError(t, err)
+	contract := testutils.NewAddress()
+	account := testutils.NewAddress()
+
+	client := clientmocks.NewClient(t)
+	chain := evmmocks.NewChain(t)
+	chain.On("ID").Return(big.NewInt(11155111))
+	chain.On("Client").Return(client)
+	chain.On("LogPoller").Return(lpmocks.NewLogPoller(t))
+
+	capability := actions.NewEvmRead(chain, logger.TestLogger(t))
+	assert.Equal(t, "read_ethereum-testnet-sepolia", capability.ID)
+
+	config, err := values.NewMap(map[string]any{
+		"address": contract.Hex(),
+		"abi":     balanceABI,
+		"method":  "balanceOf",
+	})
+	require.NoError(t, err)
+	inputs, err := values.NewMap(map[string]any{
+		"params": map[string]any{"account": account.Hex()},
+	})
+	require.NoError(t, err)
+	request := capabilities.CapabilityRequest{
+		Metadata: capabilities.RequestMetadata{WorkflowID: "a-workflow", WorkflowExecutionID: "an-execution"},
+		Config:   config,
+		Inputs:   inputs,
+	}
+
+	_, err = capabilities.ExecuteSync(ctx, capability, request)
+	require.ErrorContains(t, err, "workflow a-workflow is not registered")
+
+	registration := capabilities.RegisterToWorkflowRequest{
+		Metadata: capabilities.RegistrationMetadata{WorkflowID: "a-workflow"},
+		Config:   config,
+	}
+	require.NoError(t, capability.RegisterToWorkflow(ctx, registration))
+
+	calldata, err := contractABI.Pack("balanceOf", account)
+	require.NoError(t, err)
+	result, err := contractABI.Methods["balanceOf"].Outputs.Pack(big.NewInt(1000), true)
+	require.NoError(t, err)
+	client.On("CallContract", mock.Anything, mock.MatchedBy(func(msg ethereum.CallMsg) bool {
+		return *msg.To == contract && string(msg.Data) == string(calldata)
+	}), (*big.Int)(nil)).Return(result, nil).Once()
+
+	resp, err := capabilities.ExecuteSync(ctx, capability, request)
+	require.NoError(t, err)
+	require.Len(t, resp.Underlying, 1)
+	expected, err := values.NewMap(map[string]any{
+		"Balance": *big.NewInt(1000),
+		"Frozen":  true,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, expected, resp.Underlying[0])
+
+	require.NoError(t, capability.UnregisterFromWorkflow(ctx, capabilities.UnregisterFromWorkflowRequest{
+		Metadata: registration.Metadata,
+		Config:   config,
+	}))
+	_, err = capabilities.ExecuteSync(ctx, capability, request)
+	require.ErrorContains(t, err, "not registered")
+
+	invalid, err := values.NewMap(map[string]any{
+		"address":    contract.Hex(),
+		"abi":        balanceABI,
+		"method":     "balanceOf",
+		"confidence": "safe",
+	})
+	require.NoError(t, err)
+	require.ErrorContains(t, capability.RegisterToWorkflow(ctx, capabilities.RegisterToWorkflowRequest{
+		Metadata: registration.Metadata,
+		Config:   invalid,
+	}), `invalid confidence "safe"`)
+
+	invalid, err = values.NewMap(map[string]any{
+		"address":    contract.Hex(),
+		"abi":        balanceABI,
+		"method":     "balanceOf",
+		"confidence": "finalized",
+	})
+	require.NoError(t, err)
+	require.ErrorContains(t, capability.RegisterToWorkflow(ctx, capabilities.RegisterToWorkflowRequest{
+		Metadata: registration.Metadata,
+		Config:   invalid,
+	}), "confidence applies to events only")
+}
@@ -0,0 +1,77 @@
+// Package abivalues converts values decoded from the EVM ABI into values
+// supported by capabilities.
+package abivalues
+
+import (
+	"fmt"
+	"math/big"
+	"reflect"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+var bigIntType = reflect.TypeOf(big.Int{})
+
+// Normalize converts a value decoded from an ABI, e.g. by the relay/evm codec,
+// into one that can be wrapped by the values package.
+func Normalize(v any) any {
+	switch t := v.(type) {
+	case nil:
+		return nil
+	case *big.Int:
+		if t == nil {
+			return nil
+		}
+		return *t
+	case common.Address:
+		return t.Hex()
+	case []byte, string, bool:
+		return t
+	}
+
+	rv := reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return rv.Int()
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return *new(big.Int).SetUint64(rv.Uint())
+	case reflect.Array:
+		if rv.Type().Elem().Kind() == reflect.Uint8 {
+			b := make([]byte, rv.Len())
+			reflect.Copy(reflect.ValueOf(b), rv)
+			return b
+		}
+		fallthrough
+	case reflect.Slice:
+		l := make([]any, rv.Len())
+		for i := range l {
+			l[i] = Normalize(rv.Index(i).Interface())
+		}
+		return l
+	case reflect.Map:
+		m := make(map[string]any, rv.Len())
+		iter := rv.MapRange()
+		for iter.Next() {
+			m[fmt.Sprint(iter.Key().Interface())] = Normalize(iter.Value().Interface())
+		}
+		return m
+	case reflect.Struct:
+		m := make(map[string]any, rv.NumField())
+		for i := 0; i < rv.NumField(); i++ {
+			if rv.Type().Field(i).IsExported() {
+				m[rv.Type().Field(i).Name] = Normalize(rv.Field(i).Interface())
+			}
+		}
+		return m
+	case reflect.Pointer:
+		if rv.IsNil() {
+			return nil
+		}
+		// the codec represents integers with sized types based on big.Int
+		if rv.Elem().Type().ConvertibleTo(bigIntType) {
+			return rv.Elem().Convert(bigIntType).Interface()
+		}
+		return Normalize(rv.Elem().Interface())
+	}
+	return v
+}
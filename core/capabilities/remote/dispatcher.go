@@ -0,0 +1,125 @@
+package remote
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+
+	ragetypes "github.com/smartcontractkit/libocr/ragep2p/types"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/services"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	p2ptypes "github.com/smartcontractkit/chainlink/v2/core/services/p2p/types"
+)
+
+type receiverKey struct {
+	capabilityID string
+	role         Role
+}
+
+// dispatcher is the single consumer of the messages received by the P2P peer.
+type dispatcher struct {
+	services.StateMachine
+	peerWrapper p2ptypes.PeerWrapper
+	lggr        logger.Logger
+
+	mu        sync.RWMutex
+	receivers map[receiverKey]Receiver
+
+	stopCh services.StopChan
+	wg     sync.WaitGroup
+}
+
+var _ Dispatcher = (*dispatcher)(nil)
+
+func NewDispatcher(peerWrapper p2ptypes.PeerWrapper, lggr logger.Logger) *dispatcher {
+	return &dispatcher{
+		peerWrapper: peerWrapper,
+		lggr:        lggr.Named("Dispatcher"),
+		receivers:   map[receiverKey]Receiver{},
+		stopCh:      make(services.StopChan),
+	}
+}
+
+func (d *dispatcher) Start(ctx context.Context) error {
+	return d.StartOnce("Dispatcher", func() error {
+		d.wg.Add(1)
+		go d.receiveLoop()
+		return nil
+	})
+}
+
+func (d *dispatcher) Close() error {
+	return d.StopOnce("Dispatcher", func() error {
+		close(d.stopCh)
+		d.wg.Wait()
+		return nil
+	})
+}
+
+func (d *dispatcher) SetReceiver(capabilityID string, role Role, receiver Receiver) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	k := receiverKey{capabilityID: capabilityID, role: role}
+	if _, ok := d.receivers[k]; ok {
+		return fmt.Errorf("receiver already exists for capability %s", capabilityID)
+	}
+	d.receivers[k] = receiver
+	return nil
+}
+
+func (d *dispatcher) RemoveReceiver(capabilityID string, role Role) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	delete(d.receivers, receiverKey{capabilityID: capabilityID, role: role})
+}
+
+func (d *dispatcher) Send(peerID ragetypes.PeerID, msg *Message) error {
+	b, err := json.Marshal(msg)
+	if err != nil {
+		return fmt.Errorf("failed to marshal message: %w", err)
+	}
+	return d.peerWrapper.GetPeer().Send(peerID, b)
+}
+
+func (d *dispatcher) receiveLoop() {
+	defer d.wg.Done()
+	recvCh := d.peerWrapper.GetPeer().Receive()
+	for {
+		select {
+		case <-d.stopCh:
+			return
+		case m := <-recvCh:
+			msg := &Message{}
+			if err := json.Unmarshal(m.Payload, msg); err != nil {
+				d.lggr.Errorw("failed to unmarshal message", "sender", m.Sender, "err", err)
+				continue
+			}
+			msg.Sender = m.Sender
+
+			d.mu.RLock()
+			receiver, ok := d.receivers[receiverKey{capabilityID: msg.CapabilityID, role: msg.Method.role()}]
+			d.mu.RUnlock()
+			if !ok {
+				d.lggr.Debugw("no receiver for message", "capabilityID", msg.CapabilityID, "method", msg.Method, "sender", m.Sender)
+				continue
+			}
+			receiver.Receive(msg)
+		}
+	}
+}
+
+func (d *dispatcher) Ready() error {
+	return d.StateMachine.Ready()
+}
+
+func (d *dispatcher) HealthReport() map[string]error {
+	return map[string]error{d.Name(): d.Healthy()}
+}
+
+func (d *dispatcher) Name() string {
+	return d.lggr.Name()
+}
@@ -0,0 +1,65 @@
+package remote_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	ragetypes "github.com/smartcontractkit/libocr/ragep2p/types"
+
+	"github.com/smartcontractkit/chainlink/v2/core/capabilities/remote"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	p2ptypes "github.com/smartcontractkit/chainlink/v2/core/services/p2p/types"
+	"github.com/smartcontractkit/chainlink/v2/core/services/p2p/types/mocks"
+)
+
+type receiverFunc func(msg *remote.Message)
+
+func (f receiverFunc) Receive(msg *remote.Message) { f(msg) }
+
+func TestDispatcher_SendAndReceive(t *testing.T) {
+	ctx := testutils.Context(t)
+	recvCh := make(chan p2ptypes.Message)
+	peer := mocks.NewPeer(t)
+	peer.On("Receive").Return((<-chan p2ptypes.Message)(recvCh))
+	wrapper := mocks.NewPeerWrapper(t)
+	wrapper.On("GetPeer").Return(peer)
+
+	dispatcher := remote.NewDispatcher(wrapper, logger.TestLogger(t))
+	require.NoError(t, dispatcher.Start(ctx))
+	defer func() { require.NoError(t, dispatcher.Close()) }()
+
+	other := ragetypes.PeerID{1}
+	msg := &remote.Message{CapabilityID: "a-capability", Method: remote.MethodExecute, MessageID: "an-id", Payload: []byte("a payload")}
+	b, err := json.Marshal(msg)
+	require.NoError(t, err)
+	peer.On("Send", other, b).Return(nil).Once()
+	require.NoError(t, dispatcher.Send(other, msg))
+
+	requests := make(chan *remote.Message, 1)
+	responses := make(chan *remote.Message, 1)
+	require.NoError(t, dispatcher.SetReceiver("a-capability", remote.RoleServer, receiverFunc(func(m *remote.Message) { requests <- m })))
+	require.NoError(t, dispatcher.SetReceiver("a-capability", remote.RoleClient, receiverFunc(func(m *remote.Message) { responses <- m })))
+	require.Error(t, dispatcher.SetReceiver("a-capability", remote.RoleServer, receiverFunc(func(m *remote.Message) {})))
+
+	recvCh <- p2ptypes.Message{Sender: other, Payload: b}
+	received := <-requests
+	assert.Equal(t, other, received.Sender)
+	assert.Equal(t, msg.MessageID, received.MessageID)
+	assert.Equal(t, msg.Payload, received.Payload)
+
+	response, err := json.Marshal(&remote.Message{CapabilityID: "a-capability", Method: remote.MethodExecuteResponse, MessageID: "an-id"})
+	require.NoError(t, err)
+	recvCh <- p2ptypes.Message{Sender: other, Payload: response}
+	assert.Equal(t, remote.MethodExecuteResponse, (<-responses).Method)
+
+	// messages without a receiver are dropped
+	dispatcher.RemoveReceiver("a-capability", remote.RoleServer)
+	recvCh <- p2ptypes.Message{Sender: other, Payload: b}
+	recvCh <- p2ptypes.Message{Sender: other, Payload: response}
+	<-responses
+	assert.Empty(t, requests)
+}
@@ -0,0 +1,193 @@
+package remote
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/google/uuid"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/capabilities"
+	"github.com/smartcontractkit/chainlink-common/pkg/values"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+)
+
+// executableClient is a local proxy of an action, consensus or target capability
+// hosted by a remote DON. Requests are sent to every member of the DON and a
+// response is only returned once F+1 members agree on it.
+type executableClient struct {
+	capabilities.CapabilityInfo
+	don        DON
+	dispatcher Dispatcher
+	lggr       logger.Logger
+
+	mu      sync.Mutex
+	pending map[string]*pendingRequest
+}
+
+type pendingRequest struct {
+	ctx        context.Context
+	aggregator *aggregator
+	callback   chan<- capabilities.CapabilityResponse
+	done       chan struct{}
+}
+
+// respond delivers a response, unless the caller stopped waiting for it.
+func (p *pendingRequest) respond(resp capabilities.CapabilityResponse) bool {
+	select {
+	case p.callback <- resp:
+		return true
+	case <-p.ctx.Done():
+		return false
+	}
+}
+
+var _ capabilities.CallbackExecutable = (*executableClient)(nil)
+var _ Receiver = (*executableClient)(nil)
+
+// NewExecutableClient returns a proxy of the remote capability described by info,
+// which can be added to the local registry.
+func NewExecutableClient(info capabilities.CapabilityInfo, don DON, dispatcher Dispatcher, lggr logger.Logger) (*executableClient, error) {
+	if info.CapabilityType == capabilities.CapabilityTypeTrigger {
+		return nil, fmt.Errorf("capability %s is a trigger, use a trigger client instead", info.ID)
+	}
+
+	c := &executableClient{
+		CapabilityInfo: info,
+		don:            don,
+		dispatcher:     dispatcher,
+		lggr:           lggr.Named("ExecutableClient").With("capabilityID", info.ID),
+		pending:        map[string]*pendingRequest{},
+	}
+	if err := dispatcher.SetReceiver(info.ID, RoleClient, c); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+func (c *executableClient) RegisterToWorkflow(ctx context.Context, request capabilities.RegisterToWorkflowRequest) error {
+	payload, err := encodeRequest(request.Metadata.WorkflowID, "", request.Config, nil)
+	if err != nil {
+		return err
+	}
+	return broadcast(c.dispatcher, c.don, &Message{CapabilityID: c.ID, Method: MethodRegisterToWorkflow, Payload: payload})
+}
+
+func (c *executableClient) UnregisterFromWorkflow(ctx context.Context, request capabilities.UnregisterFromWorkflowRequest) error {
+	payload, err := encodeRequest(request.Metadata.WorkflowID, "", request.Config, nil)
+	if err != nil {
+		return err
+	}
+	return broadcast(c.dispatcher, c.don, &Message{CapabilityID: c.ID, Method: MethodUnregisterFromWorkflow, Payload: payload})
+}
+
+// Execute sends the request to the remote DON. The response is delivered on callback
+// once F+1 members of the DON agree on it, or an error if ctx is done before then.
+func (c *executableClient) Execute(ctx context.Context, callback chan<- capabilities.CapabilityResponse, request capabilities.CapabilityRequest) error {
+	payload, err := encodeRequest(request.Metadata.WorkflowID, request.Metadata.WorkflowExecutionID, request.Config, request.Inputs)
+	if err != nil {
+		return err
+	}
+
+	id := uuid.NewString()
+	p := &pendingRequest{
+		ctx:        ctx,
+		aggregator: newAggregator(c.don),
+		callback:   callback,
+		done:       make(chan struct{}),
+	}
+	c.mu.Lock()
+	c.pending[id] = p
+	c.mu.Unlock()
+
+	err = broadcast(c.dispatcher, c.don, &Message{CapabilityID: c.ID, Method: MethodExecute, MessageID: id, Payload: payload})
+	if err != nil {
+		c.remove(id)
+		return err
+	}
+
+	go func() {
+		select {
+		case <-p.done:
+		case <-ctx.Done():
+			if c.remove(id) {
+				// the caller may no longer be listening, so don't block on it
+				select {
+				case callback <- capabilities.CapabilityResponse{Err: fmt.Errorf("no response from remote capability %s: %w", c.ID, ctx.Err())}:
+				default:
+				}
+				close(callback)
+			}
+		}
+	}()
+	return nil
+}
+
+func (c *executableClient) Receive(msg *Message) {
+	if msg.Method != MethodExecuteResponse {
+		c.lggr.Warnw("unexpected method", "method", msg.Method, "sender", msg.Sender)
+		return
+	}
+
+	c.mu.Lock()
+	p, ok := c.pending[msg.MessageID]
+	agreed := ok && p.aggregator.add(msg)
+	if agreed {
+		delete(c.pending, msg.MessageID)
+	}
+	c.mu.Unlock()
+	if !agreed {
+		return
+	}
+	defer close(p.done)
+	defer close(p.callback)
+
+	if msg.Error != "" {
+		p.respond(capabilities.CapabilityResponse{Err: errors.New(msg.Error)})
+		return
+	}
+	v, err := unmarshalValue(msg.Payload)
+	if err != nil {
+		p.respond(capabilities.CapabilityResponse{Err: fmt.Errorf("failed to unmarshal response: %w", err)})
+		return
+	}
+	list, ok := v.(*values.List)
+	if !ok {
+		p.respond(capabilities.CapabilityResponse{Err: fmt.Errorf("expected a list of responses, got %T", v)})
+		return
+	}
+	for _, r := range list.Underlying {
+		if !p.respond(capabilities.CapabilityResponse{Value: r}) {
+			return
+		}
+	}
+}
+
+// remove forgets a pending request, returning false if it had already been completed.
+func (c *executableClient) remove(id string) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	_, ok := c.pending[id]
+	delete(c.pending, id)
+	return ok
+}
+
+// broadcast sends msg to every member of don. It fails if fewer than F+1
+// members could be reached, since a response could then never be agreed on.
+func broadcast(dispatcher Dispatcher, don DON, msg *Message) error {
+	var errs error
+	sent := 0
+	for _, m := range don.Members {
+		if err := dispatcher.Send(m, msg); err != nil {
+			errs = errors.Join(errs, fmt.Errorf("failed to send to %s: %w", m, err))
+			continue
+		}
+		sent++
+	}
+	if sent <= int(don.F) {
+		return fmt.Errorf("failed to send %s for capability %s to enough members of the DON: %w", msg.Method, msg.CapabilityID, errs)
+	}
+	return nil
+}
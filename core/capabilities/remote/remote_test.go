@@ -0,0 +1,448 @@
+package remote
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	ragetypes "github.com/smartcontractkit/libocr/ragep2p/types"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/capabilities"
+	"github.com/smartcontractkit/chainlink-common/pkg/values"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+)
+
+// testNetwork connects testDispatchers in memory.
+type testNetwork struct {
+	mu    sync.Mutex
+	nodes map[ragetypes.PeerID]*testDispatcher
+}
+
+func newTestNetwork() *testNetwork {
+	return &testNetwork{nodes: map[ragetypes.PeerID]*testDispatcher{}}
+}
+
+func (n *testNetwork) newDispatcher(id ragetypes.PeerID) *testDispatcher {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	d := &testDispatcher{id: id, network: n, receivers: map[receiverKey]Receiver{}}
+	n.nodes[id] = d
+	return d
+}
+
+type testDispatcher struct {
+	id      ragetypes.PeerID
+	network *testNetwork
+
+	mu        sync.Mutex
+	receivers map[receiverKey]Receiver
+}
+
+var _ Dispatcher = (*testDispatcher)(nil)
+
+func (d *testDispatcher) SetReceiver(capabilityID string, role Role, receiver Receiver) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.receivers[receiverKey{capabilityID: capabilityID, role: role}] = receiver
+	return nil
+}
+
+func (d *testDispatcher) RemoveReceiver(capabilityID string, role Role) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	delete(d.receivers, receiverKey{capabilityID: capabilityID, role: role})
+}
+
+func (d *testDispatcher) Send(peerID ragetypes.PeerID, msg *Message) error {
+	d.network.mu.Lock()
+	to, ok := d.network.nodes[peerID]
+	d.network.mu.Unlock()
+	if !ok {
+		return errors.New("unknown peer")
+	}
+
+	m := *msg
+	m.Sender = d.id
+	to.mu.Lock()
+	receiver, ok := to.receivers[receiverKey{capabilityID: m.CapabilityID, role: m.Method.role()}]
+	to.mu.Unlock()
+	if ok {
+		go receiver.Receive(&m)
+	}
+	return nil
+}
+
+func (d *testDispatcher) Start(ctx context.Context) error { return nil }
+func (d *testDispatcher) Close() error                    { return nil }
+func (d *testDispatcher) Ready() error                    { return nil }
+func (d *testDispatcher) HealthReport() map[string]error  { return nil }
+func (d *testDispatcher) Name() string                    { return "testDispatcher" }
+
+type testTarget struct {
+	capabilities.CapabilityInfo
+	response values.Value
+	err      error
+
+	executions atomic.Int32
+}
+
+func (c *testTarget) RegisterToWorkflow(ctx context.Context, request capabilities.RegisterToWorkflowRequest) error {
+	return nil
+}
+
+func (c *testTarget) UnregisterFromWorkflow(ctx context.Context, request capabilities.UnregisterFromWorkflowRequest) error {
+	return nil
+}
+
+func (c *testTarget) Execute(ctx context.Context, callback chan<- capabilities.CapabilityResponse, request capabilities.CapabilityRequest) error {
+	c.executions.Add(1)
+	if c.err != nil {
+		return c.err
+	}
+	callback <- capabilities.CapabilityResponse{Value: c.response}
+	close(callback)
+	return nil
+}
+
+type testTrigger struct {
+	capabilities.CapabilityInfo
+
+	mu           sync.Mutex
+	callbacks    map[string]chan<- capabilities.CapabilityResponse
+	unregistered int
+}
+
+func (c *testTrigger) RegisterTrigger(ctx context.Context, callback chan<- capabilities.CapabilityResponse, request capabilities.CapabilityRequest) error {
+	triggerID, err := getTriggerID(request)
+	if err != nil {
+		return err
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.callbacks[triggerID] = callback
+	return nil
+}
+
+func (c *testTrigger) UnregisterTrigger(ctx context.Context, request capabilities.CapabilityRequest) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.unregistered++
+	return nil
+}
+
+func (c *testTrigger) callback(triggerID string) chan<- capabilities.CapabilityResponse {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.callbacks[triggerID]
+}
+
+// newTestDONs returns a network with a workflow DON of one node and a capability DON of four nodes, with F=1.
+func newTestDONs() (*testNetwork, DON, DON) {
+	network := newTestNetwork()
+	workflowDON := DON{Members: []ragetypes.PeerID{{0xaa}}}
+	capabilityDON := DON{F: 1}
+	for i := 1; i <= 4; i++ {
+		capabilityDON.Members = append(capabilityDON.Members, ragetypes.PeerID{byte(i)})
+	}
+	for _, m := range append(workflowDON.Members, capabilityDON.Members...) {
+		network.newDispatcher(m)
+	}
+	return network, workflowDON, capabilityDON
+}
+
+func TestRemoteExecutable(t *testing.T) {
+	lggr := logger.TestLogger(t)
+	info := capabilities.MustNewCapabilityInfo("write_chain_a", capabilities.CapabilityTypeTarget, "a write target", "v1.0.0")
+	inputs, err := values.NewMap(map[string]any{"report": "<a report>"})
+	require.NoError(t, err)
+	request := capabilities.CapabilityRequest{
+		Metadata: capabilities.RequestMetadata{WorkflowID: "a-workflow", WorkflowExecutionID: "an-execution"},
+		Inputs:   inputs,
+	}
+
+	testCases := []struct {
+		name    string
+		targets []*testTarget
+		value   values.Value
+		errMsg  string
+	}{
+		{
+			name: "a faulty node is outvoted",
+			targets: []*testTarget{
+				{response: values.NewString("ok")},
+				{response: values.NewString("ok")},
+				{response: values.NewString("ok")},
+				{response: values.NewString("forged")},
+			},
+			value: values.NewString("ok"),
+		},
+		{
+			name: "errors are agreed on",
+			targets: []*testTarget{
+				{err: errors.New("boom")},
+				{err: errors.New("boom")},
+				{response: values.NewString("ok")},
+				{err: errors.New("boom")},
+			},
+			errMsg: "boom",
+		},
+		{
+			name: "no agreement",
+			targets: []*testTarget{
+				{response: values.NewString("a")},
+				{response: values.NewString("b")},
+				{response: values.NewString("c")},
+				{response: values.NewString("d")},
+			},
+			errMsg: "context timed out",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			network, workflowDON, capabilityDON := newTestDONs()
+			for i, target := range tc.targets {
+				target.CapabilityInfo = info
+				s := NewServer(info.ID, target, workflowDON, network.nodes[capabilityDON.Members[i]], lggr)
+				require.NoError(t, s.Start(testutils.Context(t)))
+				t.Cleanup(func() { require.NoError(t, s.Close()) })
+			}
+
+			client, err := NewExecutableClient(info, capabilityDON, network.nodes[workflowDON.Members[0]], lggr)
+			require.NoError(t, err)
+
+			ctx, cancel := context.WithTimeout(testutils.Context(t), time.Second)
+			defer cancel()
+			resp, err := capabilities.ExecuteSync(ctx, client, request)
+			if tc.errMsg != "" {
+				assert.ErrorContains(t, err, tc.errMsg)
+				return
+			}
+			require.NoError(t, err)
+			require.Len(t, resp.Underlying, 1)
+			assert.Equal(t, tc.value, resp.Underlying[0])
+		})
+	}
+}
+
+func TestRemoteExecutable_ExecutesOnce(t *testing.T) {
+	lggr := logger.TestLogger(t)
+	info := capabilities.MustNewCapabilityInfo("write_chain_a", capabilities.CapabilityTypeTarget, "a write target", "v1.0.0")
+	inputs, err := values.NewMap(map[string]any{"report": "<a report>"})
+	require.NoError(t, err)
+	request := capabilities.CapabilityRequest{
+		Metadata: capabilities.RequestMetadata{WorkflowID: "a-workflow", WorkflowExecutionID: "an-execution"},
+		Inputs:   inputs,
+	}
+
+	network := newTestNetwork()
+	workflowDON := DON{F: 1}
+	for i := 1; i <= 4; i++ {
+		workflowDON.Members = append(workflowDON.Members, ragetypes.PeerID{0xa0 + byte(i)})
+	}
+	capabilityDON := DON{Members: []ragetypes.PeerID{{0x01}}}
+	for _, m := range append(workflowDON.Members, capabilityDON.Members...) {
+		network.newDispatcher(m)
+	}
+
+	target := &testTarget{CapabilityInfo: info, response: values.NewString("ok")}
+	s := NewServer(info.ID, target, workflowDON, network.nodes[capabilityDON.Members[0]], lggr)
+	require.NoError(t, s.Start(testutils.Context(t)))
+	t.Cleanup(func() { require.NoError(t, s.Close()) })
+
+	var wg sync.WaitGroup
+	execute := func(member ragetypes.PeerID) {
+		client, err := NewExecutableClient(info, capabilityDON, network.nodes[member], lggr)
+		require.NoError(t, err)
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			ctx, cancel := context.WithTimeout(testutils.Context(t), 5*time.Second)
+			defer cancel()
+			resp, err := capabilities.ExecuteSync(ctx, client, request)
+			if assert.NoError(t, err) && assert.Len(t, resp.Underlying, 1) {
+				assert.Equal(t, values.NewString("ok"), resp.Underlying[0])
+			}
+		}()
+	}
+
+	// a single caller is not enough to execute the request with F=1
+	execute(workflowDON.Members[0])
+	assert.Never(t, func() bool { return target.executions.Load() > 0 }, 200*time.Millisecond, 10*time.Millisecond)
+
+	for _, m := range workflowDON.Members[1:] {
+		execute(m)
+	}
+	wg.Wait()
+	assert.Equal(t, int32(1), target.executions.Load())
+}
+
+func TestRemoteTrigger(t *testing.T) {
+	lggr := logger.TestLogger(t)
+	ctx := testutils.Context(t)
+	info := capabilities.MustNewCapabilityInfo("on_mercury_report", capabilities.CapabilityTypeTrigger, "a trigger", "v1.0.0")
+	network, workflowDON, capabilityDON := newTestDONs()
+
+	var triggers []*testTrigger
+	for _, m := range capabilityDON.Members {
+		trigger := &testTrigger{CapabilityInfo: info, callbacks: map[string]chan<- capabilities.CapabilityResponse{}}
+		triggers = append(triggers, trigger)
+		s := NewServer(info.ID, trigger, workflowDON, network.nodes[m], lggr)
+		require.NoError(t, s.Start(ctx))
+		t.Cleanup(func() { require.NoError(t, s.Close()) })
+	}
+
+	client, err := NewTriggerClient(info, capabilityDON, network.nodes[workflowDON.Members[0]], lggr)
+	require.NoError(t, err)
+
+	triggerInputs, err := values.NewMap(map[string]any{"triggerId": "a-trigger"})
+	require.NoError(t, err)
+	request := capabilities.CapabilityRequest{
+		Metadata: capabilities.RequestMetadata{WorkflowID: "a-workflow"},
+		Inputs:   triggerInputs,
+	}
+	events := make(chan capabilities.CapabilityResponse, 10)
+	require.NoError(t, client.RegisterTrigger(ctx, events, request))
+	require.Eventually(t, func() bool {
+		for _, trigger := range triggers {
+			if trigger.callback("a-trigger") == nil {
+				return false
+			}
+		}
+		return true
+	}, testutils.WaitTimeout(t), testutils.TestInterval)
+
+	// an event sent by a single, possibly faulty, node is not delivered
+	triggers[0].callback("a-trigger") <- capabilities.CapabilityResponse{Value: values.NewString("forged")}
+	// an event sent by F+1 nodes is delivered once
+	event := capabilities.CapabilityResponse{Value: values.NewString("an event")}
+	for _, trigger := range triggers[1:] {
+		trigger.callback("a-trigger") <- event
+	}
+
+	assert.Equal(t, event, <-events)
+	time.Sleep(100 * time.Millisecond)
+	assert.Empty(t, events)
+
+	require.NoError(t, client.UnregisterTrigger(ctx, request))
+	require.Eventually(t, func() bool {
+		for _, trigger := range triggers {
+			trigger.mu.Lock()
+			unregistered := trigger.unregistered
+			trigger.mu.Unlock()
+			if unregistered != 1 {
+				return false
+			}
+		}
+		return true
+	}, testutils.WaitTimeout(t), testutils.TestInterval)
+}
+
+func TestRemoteTrigger_RegistersOnceFPlusOneSubscribed(t *testing.T) {
+	lggr := logger.TestLogger(t)
+	ctx := testutils.Context(t)
+	info := capabilities.MustNewCapabilityInfo("on_mercury_report", capabilities.CapabilityTypeTrigger, "a trigger", "v1.0.0")
+
+	network := newTestNetwork()
+	workflowDON := DON{F: 1}
+	for i := 1; i <= 4; i++ {
+		workflowDON.Members = append(workflowDON.Members, ragetypes.PeerID{0xa0 + byte(i)})
+	}
+	capabilityDON := DON{Members: []ragetypes.PeerID{{0x01}}}
+	for _, m := range append(workflowDON.Members, capabilityDON.Members...) {
+		network.newDispatcher(m)
+	}
+
+	trigger := &testTrigger{CapabilityInfo: info, callbacks: map[string]chan<- capabilities.CapabilityResponse{}}
+	s := NewServer(info.ID, trigger, workflowDON, network.nodes[capabilityDON.Members[0]], lggr)
+	require.NoError(t, s.Start(ctx))
+	t.Cleanup(func() { require.NoError(t, s.Close()) })
+
+	triggerInputs, err := values.NewMap(map[string]any{"triggerId": "a-trigger"})
+	require.NoError(t, err)
+	request := capabilities.CapabilityRequest{
+		Metadata: capabilities.RequestMetadata{WorkflowID: "a-workflow"},
+		Inputs:   triggerInputs,
+	}
+	var clients []*triggerClient
+	var events []chan capabilities.CapabilityResponse
+	for _, m := range workflowDON.Members[:2] {
+		client, err := NewTriggerClient(info, capabilityDON, network.nodes[m], lggr)
+		require.NoError(t, err)
+		clients = append(clients, client)
+		events = append(events, make(chan capabilities.CapabilityResponse, 10))
+	}
+
+	// a single subscriber is not enough to register the trigger with F=1
+	require.NoError(t, clients[0].RegisterTrigger(ctx, events[0], request))
+	assert.Never(t, func() bool { return trigger.callback("a-trigger") != nil }, 200*time.Millisecond, 10*time.Millisecond)
+
+	require.NoError(t, clients[1].RegisterTrigger(ctx, events[1], request))
+	require.Eventually(t, func() bool { return trigger.callback("a-trigger") != nil }, testutils.WaitTimeout(t), testutils.TestInterval)
+
+	event := capabilities.CapabilityResponse{Value: values.NewString("an event")}
+	trigger.callback("a-trigger") <- event
+	for _, e := range events {
+		assert.Equal(t, event, <-e)
+	}
+
+	for _, client := range clients {
+		require.NoError(t, client.UnregisterTrigger(ctx, request))
+	}
+	require.Eventually(t, func() bool {
+		trigger.mu.Lock()
+		defer trigger.mu.Unlock()
+		return trigger.unregistered == 1
+	}, testutils.WaitTimeout(t), testutils.TestInterval)
+}
+
+func TestTriggerClient_DropsEventsOfUnregisteredTrigger(t *testing.T) {
+	lggr := logger.TestLogger(t)
+	ctx := testutils.Context(t)
+	info := capabilities.MustNewCapabilityInfo("on_mercury_report", capabilities.CapabilityTypeTrigger, "a trigger", "v1.0.0")
+	network, workflowDON, capabilityDON := newTestDONs()
+	capabilityDON.F = 0
+
+	client, err := NewTriggerClient(info, capabilityDON, network.nodes[workflowDON.Members[0]], lggr)
+	require.NoError(t, err)
+	triggerInputs, err := values.NewMap(map[string]any{"triggerId": "a-trigger"})
+	require.NoError(t, err)
+	request := capabilities.CapabilityRequest{
+		Metadata: capabilities.RequestMetadata{WorkflowID: "a-workflow"},
+		Inputs:   triggerInputs,
+	}
+	// nobody reads the events
+	require.NoError(t, client.RegisterTrigger(ctx, make(chan capabilities.CapabilityResponse), request))
+
+	payload, err := marshalValue(values.NewString("an event"))
+	require.NoError(t, err)
+	received := make(chan struct{})
+	go func() {
+		defer close(received)
+		client.Receive(&Message{CapabilityID: info.ID, Method: MethodTriggerEvent, MessageID: "a-trigger", Sender: capabilityDON.Members[0], Payload: payload})
+	}()
+	assert.Never(t, func() bool {
+		select {
+		case <-received:
+			return true
+		default:
+			return false
+		}
+	}, 100*time.Millisecond, 10*time.Millisecond)
+
+	// unregistering the trigger releases the event
+	require.NoError(t, client.UnregisterTrigger(ctx, request))
+	select {
+	case <-received:
+	case <-time.After(testutils.WaitTimeout(t)):
+		t.Fatal("event was not dropped")
+	}
+}
@@ -0,0 +1,501 @@
+package remote
+
+import (
+	"context"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	ragetypes "github.com/smartcontractkit/libocr/ragep2p/types"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/capabilities"
+	"github.com/smartcontractkit/chainlink-common/pkg/services"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+)
+
+// requestExpiry is how long the requests of the callers to an execution are kept while waiting for
+// F+1 of them, and for late callers to be sent the response after that.
+const requestExpiry = 10 * time.Minute
+
+// server exposes a local capability to the members of a remote DON. It dispatches
+// their requests to the capability and sends the responses back to them.
+//
+// Every member of the calling DON sends the same request for a step of an execution, so the
+// capability is only executed once for them, when F+1 members sent it, and the response is sent
+// to every member which sent it. Likewise, a trigger is only registered with the capability once
+// F+1 members subscribed to it, and its events are sent to every subscriber.
+type server struct {
+	services.StateMachine
+	capabilityID string
+	capability   capabilities.BaseCapability
+	callers      DON
+	dispatcher   Dispatcher
+	lggr         logger.Logger
+
+	mu       sync.Mutex
+	triggers map[string]*serverTrigger
+	// removedTriggers are the triggers removed while registered or registering, by ID, until they are unregistered.
+	removedTriggers map[string]*serverTrigger
+	requests        map[requestKey]*serverRequest
+
+	stopCh services.StopChan
+	wg     sync.WaitGroup
+}
+
+// serverTrigger is a trigger registered with the local capability on behalf of remote subscribers.
+type serverTrigger struct {
+	request capabilities.CapabilityRequest
+	stopCh  chan struct{}
+	// registering is set once F+1 callers subscribed, and registered once the local capability
+	// registered the trigger. Both are guarded by the server's mu.
+	registering bool
+	registered  bool
+	// done is closed once the trigger is no longer registered with the local capability after it
+	// was removed, and prev is the trigger with the same ID removed before it was added, which it
+	// waits for before registering so that their registrations do not overlap.
+	done chan struct{}
+	prev *serverTrigger
+
+	// mu guards subscribers separately from the server, since a trigger may emit events during registration.
+	mu          sync.Mutex
+	subscribers map[ragetypes.PeerID]bool
+}
+
+// requestKey identifies a step of an execution: the request metadata carries no step reference, so
+// the steps of an execution are told apart by their config and inputs.
+type requestKey struct {
+	executionID string
+	payload     [32]byte
+}
+
+// serverRequest collects the callers sending the same request until it is executed.
+type serverRequest struct {
+	receivedAt time.Time
+	// callers maps each caller to the ID of its message, which its response must carry.
+	callers  map[ragetypes.PeerID]string
+	executed bool
+	// response is set once the request was executed.
+	response *Message
+}
+
+var _ services.Service = (*server)(nil)
+var _ Receiver = (*server)(nil)
+
+// NewServer returns a service exposing the local capability with the given ID to
+// the members of the callers DON. Requests from any other node are ignored.
+func NewServer(capabilityID string, capability capabilities.BaseCapability, callers DON, dispatcher Dispatcher, lggr logger.Logger) *server {
+	return &server{
+		capabilityID:    capabilityID,
+		capability:      capability,
+		callers:         callers,
+		dispatcher:      dispatcher,
+		lggr:            lggr.Named("CapabilityServer").With("capabilityID", capabilityID),
+		triggers:        map[string]*serverTrigger{},
+		removedTriggers: map[string]*serverTrigger{},
+		requests:        map[requestKey]*serverRequest{},
+		stopCh:          make(services.StopChan),
+	}
+}
+
+func (s *server) Start(ctx context.Context) error {
+	return s.StartOnce("CapabilityServer", func() error {
+		return s.dispatcher.SetReceiver(s.capabilityID, RoleServer, s)
+	})
+}
+
+func (s *server) Close() error {
+	return s.StopOnce("CapabilityServer", func() error {
+		s.dispatcher.RemoveReceiver(s.capabilityID, RoleServer)
+		close(s.stopCh)
+		s.wg.Wait()
+
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		trigger, ok := s.capability.(capabilities.TriggerCapability)
+		var errs error
+		for id, t := range s.triggers {
+			close(t.stopCh)
+			if ok && t.registered {
+				if err := trigger.UnregisterTrigger(context.Background(), t.request); err != nil {
+					errs = errors.Join(errs, fmt.Errorf("failed to unregister trigger %s: %w", id, err))
+				}
+			}
+		}
+		s.triggers = map[string]*serverTrigger{}
+		return errs
+	})
+}
+
+func (s *server) Receive(msg *Message) {
+	if !s.callers.isMember(msg.Sender) {
+		s.lggr.Warnw("ignoring request from a node outside of the calling DON", "sender", msg.Sender, "method", msg.Method)
+		return
+	}
+
+	req, err := decodeRequest(msg.Payload)
+	if err != nil {
+		s.lggr.Errorw("failed to decode request", "sender", msg.Sender, "method", msg.Method, "err", err)
+		s.respondError(msg, err)
+		return
+	}
+
+	// requests arriving while the server is closing are dropped
+	ok := s.IfStarted(func() {
+		switch msg.Method {
+		case MethodExecute:
+			s.addRequest(msg, req)
+		case MethodRegisterToWorkflow, MethodUnregisterFromWorkflow:
+			s.wg.Add(1)
+			go func() {
+				defer s.wg.Done()
+				s.registerToWorkflow(msg, req)
+			}()
+		case MethodRegisterTrigger:
+			s.registerTrigger(msg, req)
+		case MethodUnregisterTrigger:
+			s.unregisterTrigger(msg, req)
+		default:
+			s.lggr.Warnw("unexpected method", "method", msg.Method, "sender", msg.Sender)
+		}
+	})
+	if !ok {
+		s.lggr.Debugw("dropping request: server is not started", "method", msg.Method, "sender", msg.Sender)
+	}
+}
+
+// addRequest records the sender of an execute request, executing it once F+1 callers sent it, or
+// responding right away to a caller sending it after it was executed.
+func (s *server) addRequest(msg *Message, req capabilities.CapabilityRequest) {
+	key := requestKey{executionID: req.Metadata.WorkflowExecutionID, payload: sha256.Sum256(msg.Payload)}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.expireRequests()
+	r, ok := s.requests[key]
+	if !ok {
+		r = &serverRequest{receivedAt: time.Now(), callers: map[ragetypes.PeerID]string{}}
+		s.requests[key] = r
+	}
+	if _, ok := r.callers[msg.Sender]; ok {
+		return
+	}
+	r.callers[msg.Sender] = msg.MessageID
+
+	switch {
+	case r.response != nil:
+		s.sendResponse(msg.Sender, msg.MessageID, r.response)
+	case !r.executed && len(r.callers) > int(s.callers.F):
+		r.executed = true
+		s.wg.Add(1)
+		go func() {
+			defer s.wg.Done()
+			s.execute(r, req)
+		}()
+	}
+}
+
+// expireRequests forgets the requests received more than requestExpiry ago. s.mu must be held.
+func (s *server) expireRequests() {
+	for k, r := range s.requests {
+		if time.Since(r.receivedAt) > requestExpiry {
+			delete(s.requests, k)
+		}
+	}
+}
+
+// execute executes the request and sends the response to each of its callers.
+func (s *server) execute(r *serverRequest, req capabilities.CapabilityRequest) {
+	response := &Message{CapabilityID: s.capabilityID, Method: MethodExecuteResponse}
+	if payload, err := s.executeSync(req); err != nil {
+		response.Error = err.Error()
+	} else {
+		response.Payload = payload
+	}
+
+	s.mu.Lock()
+	r.response = response
+	callers := make(map[ragetypes.PeerID]string, len(r.callers))
+	for p, id := range r.callers {
+		callers[p] = id
+	}
+	s.mu.Unlock()
+	for p, id := range callers {
+		s.sendResponse(p, id, response)
+	}
+}
+
+func (s *server) executeSync(req capabilities.CapabilityRequest) ([]byte, error) {
+	executable, ok := s.capability.(capabilities.CallbackExecutable)
+	if !ok {
+		return nil, fmt.Errorf("capability %s is not executable", s.capabilityID)
+	}
+
+	ctx, cancel := s.stopCh.NewCtx()
+	defer cancel()
+	resp, err := capabilities.ExecuteSync(ctx, executable, req)
+	if err != nil {
+		return nil, err
+	}
+	payload, err := marshalValue(resp)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal response: %w", err)
+	}
+	return payload, nil
+}
+
+// sendResponse sends the response to a caller, with the ID of the message of its request.
+func (s *server) sendResponse(peerID ragetypes.PeerID, messageID string, response *Message) {
+	msg := *response
+	msg.MessageID = messageID
+	s.send(peerID, &msg)
+}
+
+func (s *server) registerToWorkflow(msg *Message, req capabilities.CapabilityRequest) {
+	executable, ok := s.capability.(capabilities.CallbackExecutable)
+	if !ok {
+		s.lggr.Warnw("capability is not executable", "method", msg.Method, "sender", msg.Sender)
+		return
+	}
+
+	ctx, cancel := s.stopCh.NewCtx()
+	defer cancel()
+	metadata := capabilities.RegistrationMetadata{WorkflowID: req.Metadata.WorkflowID}
+	var err error
+	if msg.Method == MethodRegisterToWorkflow {
+		err = executable.RegisterToWorkflow(ctx, capabilities.RegisterToWorkflowRequest{Metadata: metadata, Config: req.Config})
+	} else {
+		err = executable.UnregisterFromWorkflow(ctx, capabilities.UnregisterFromWorkflowRequest{Metadata: metadata, Config: req.Config})
+	}
+	if err != nil {
+		s.lggr.Errorw("failed to handle request", "method", msg.Method, "sender", msg.Sender, "workflowID", metadata.WorkflowID, "err", err)
+	}
+}
+
+// registerTrigger subscribes the sender to the trigger with the ID carried by msg, registering it
+// with the local capability, in the background, once F+1 callers subscribed.
+func (s *server) registerTrigger(msg *Message, req capabilities.CapabilityRequest) {
+	trigger, ok := s.capability.(capabilities.TriggerCapability)
+	if !ok {
+		s.respondTriggerError(msg, fmt.Errorf("capability %s is not a trigger", s.capabilityID))
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	t, ok := s.triggers[msg.MessageID]
+	if !ok {
+		t = &serverTrigger{
+			request:     req,
+			subscribers: map[ragetypes.PeerID]bool{},
+			stopCh:      make(chan struct{}),
+			done:        make(chan struct{}),
+			prev:        s.removedTriggers[msg.MessageID],
+		}
+		s.triggers[msg.MessageID] = t
+	}
+	t.mu.Lock()
+	t.subscribers[msg.Sender] = true
+	subscribers := len(t.subscribers)
+	t.mu.Unlock()
+	if t.registering || subscribers <= int(s.callers.F) {
+		return
+	}
+
+	t.registering = true
+	s.wg.Add(1)
+	go func() {
+		defer s.wg.Done()
+		s.register(trigger, msg.MessageID, t)
+	}()
+}
+
+// register registers the trigger with the local capability and forwards its events. A trigger
+// whose subscribers all unsubscribed while it was registering is unregistered right away, and the
+// subscribers of a trigger which failed to register are sent the error.
+func (s *server) register(trigger capabilities.TriggerCapability, triggerID string, t *serverTrigger) {
+	if t.prev != nil {
+		select {
+		case <-t.prev.done:
+		case <-s.stopCh:
+			return
+		}
+		s.mu.Lock()
+		removed := s.triggers[triggerID] != t
+		if removed {
+			s.removeDone(triggerID, t)
+		}
+		s.mu.Unlock()
+		if removed {
+			return
+		}
+	}
+
+	ctx, cancel := s.stopCh.NewCtx()
+	defer cancel()
+	callbackCh := make(chan capabilities.CapabilityResponse)
+	s.wg.Add(1)
+	go func() {
+		defer s.wg.Done()
+		s.forwardEvents(triggerID, t, callbackCh)
+	}()
+	err := trigger.RegisterTrigger(ctx, callbackCh, t.request)
+
+	s.mu.Lock()
+	if err != nil {
+		if s.triggers[triggerID] == t {
+			delete(s.triggers, triggerID)
+			close(t.stopCh)
+		}
+		s.removeDone(triggerID, t)
+		s.mu.Unlock()
+		response := &Message{CapabilityID: s.capabilityID, Method: MethodTriggerEvent, MessageID: triggerID, Error: fmt.Sprintf("failed to register trigger: %v", err)}
+		for _, p := range t.subscriberList() {
+			s.send(p, response)
+		}
+		return
+	}
+	t.registered = true
+	removed := s.triggers[triggerID] != t
+	s.mu.Unlock()
+
+	if removed {
+		s.unregister(trigger, triggerID, t)
+	}
+}
+
+// unregisterTrigger unsubscribes the sender from the trigger with the ID carried by msg,
+// unregistering it from the local capability, in the background, once it has no subscribers left.
+func (s *server) unregisterTrigger(msg *Message, req capabilities.CapabilityRequest) {
+	trigger, ok := s.capability.(capabilities.TriggerCapability)
+	if !ok {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	t, ok := s.triggers[msg.MessageID]
+	if !ok {
+		return
+	}
+	t.mu.Lock()
+	delete(t.subscribers, msg.Sender)
+	remaining := len(t.subscribers)
+	t.mu.Unlock()
+	if remaining > 0 {
+		return
+	}
+
+	delete(s.triggers, msg.MessageID)
+	close(t.stopCh)
+	if !t.registering {
+		close(t.done)
+		return
+	}
+	s.removedTriggers[msg.MessageID] = t
+	// a trigger which is still registering is unregistered by register once registered
+	if !t.registered {
+		return
+	}
+	s.wg.Add(1)
+	go func() {
+		defer s.wg.Done()
+		s.unregister(trigger, msg.MessageID, t)
+	}()
+}
+
+// unregister unregisters a removed trigger from the local capability.
+func (s *server) unregister(trigger capabilities.TriggerCapability, triggerID string, t *serverTrigger) {
+	ctx, cancel := s.stopCh.NewCtx()
+	defer cancel()
+	if err := trigger.UnregisterTrigger(ctx, t.request); err != nil {
+		s.lggr.Errorw("failed to unregister trigger", "triggerID", triggerID, "err", err)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.removeDone(triggerID, t)
+}
+
+// removeDone marks a trigger as no longer registered with the local capability. s.mu must be held.
+func (s *server) removeDone(triggerID string, t *serverTrigger) {
+	if s.removedTriggers[triggerID] == t {
+		delete(s.removedTriggers, triggerID)
+	}
+	close(t.done)
+}
+
+// forwardEvents sends every event of a trigger to its subscribers until the trigger is unregistered.
+func (s *server) forwardEvents(triggerID string, t *serverTrigger, callbackCh <-chan capabilities.CapabilityResponse) {
+	for {
+		select {
+		case <-s.stopCh:
+			return
+		case <-t.stopCh:
+			return
+		case event, ok := <-callbackCh:
+			if !ok {
+				return
+			}
+
+			msg := &Message{CapabilityID: s.capabilityID, Method: MethodTriggerEvent, MessageID: triggerID}
+			if event.Err != nil {
+				msg.Error = event.Err.Error()
+			} else {
+				payload, err := marshalValue(event.Value)
+				if err != nil {
+					s.lggr.Errorw("failed to marshal trigger event", "triggerID", triggerID, "err", err)
+					continue
+				}
+				msg.Payload = payload
+			}
+
+			for _, p := range t.subscriberList() {
+				s.send(p, msg)
+			}
+		}
+	}
+}
+
+func (t *serverTrigger) subscriberList() []ragetypes.PeerID {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	subscribers := make([]ragetypes.PeerID, 0, len(t.subscribers))
+	for p := range t.subscribers {
+		subscribers = append(subscribers, p)
+	}
+	return subscribers
+}
+
+func (s *server) respondError(msg *Message, err error) {
+	switch msg.Method {
+	case MethodExecute:
+		s.send(msg.Sender, &Message{CapabilityID: s.capabilityID, Method: MethodExecuteResponse, MessageID: msg.MessageID, Error: err.Error()})
+	case MethodRegisterTrigger:
+		s.respondTriggerError(msg, err)
+	}
+}
+
+func (s *server) respondTriggerError(msg *Message, err error) {
+	s.send(msg.Sender, &Message{CapabilityID: s.capabilityID, Method: MethodTriggerEvent, MessageID: msg.MessageID, Error: err.Error()})
+}
+
+func (s *server) send(peerID ragetypes.PeerID, msg *Message) {
+	if err := s.dispatcher.Send(peerID, msg); err != nil {
+		s.lggr.Errorw("failed to send response", "peerID", peerID, "method", msg.Method, "err", err)
+	}
+}
+
+func (s *server) Ready() error {
+	return s.StateMachine.Ready()
+}
+
+func (s *server) HealthReport() map[string]error {
+	return map[string]error{s.Name(): s.Healthy()}
+}
+
+func (s *server) Name() string {
+	return s.lggr.Name()
+}
@@ -0,0 +1,189 @@
+package remote
+
+import (
+	"context"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/capabilities"
+	"github.com/smartcontractkit/chainlink-common/pkg/values"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+)
+
+// eventExpiry is how long the responses to a trigger event are kept while waiting
+// for F+1 members of the DON to send it, and for late duplicates after that.
+const eventExpiry = 10 * time.Minute
+
+// triggerClient is a local proxy of a trigger capability hosted by a remote DON.
+// Registrations are sent to every member of the DON, and events are only
+// delivered once F+1 members sent the same event.
+type triggerClient struct {
+	capabilities.CapabilityInfo
+	don        DON
+	dispatcher Dispatcher
+	lggr       logger.Logger
+
+	mu            sync.Mutex
+	subscriptions map[string]*subscription
+}
+
+type subscription struct {
+	callback chan<- capabilities.CapabilityResponse
+	events   map[[32]byte]*pendingEvent
+	// stop is closed when the trigger is unregistered, so that nothing waits on callback anymore.
+	stop chan struct{}
+}
+
+type pendingEvent struct {
+	aggregator *aggregator
+	receivedAt time.Time
+}
+
+var _ capabilities.TriggerCapability = (*triggerClient)(nil)
+var _ Receiver = (*triggerClient)(nil)
+
+// NewTriggerClient returns a proxy of the remote trigger described by info,
+// which can be added to the local registry.
+func NewTriggerClient(info capabilities.CapabilityInfo, don DON, dispatcher Dispatcher, lggr logger.Logger) (*triggerClient, error) {
+	if info.CapabilityType != capabilities.CapabilityTypeTrigger {
+		return nil, fmt.Errorf("capability %s is not a trigger", info.ID)
+	}
+
+	c := &triggerClient{
+		CapabilityInfo: info,
+		don:            don,
+		dispatcher:     dispatcher,
+		lggr:           lggr.Named("TriggerClient").With("capabilityID", info.ID),
+		subscriptions:  map[string]*subscription{},
+	}
+	if err := dispatcher.SetReceiver(info.ID, RoleClient, c); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+func (c *triggerClient) RegisterTrigger(ctx context.Context, callback chan<- capabilities.CapabilityResponse, request capabilities.CapabilityRequest) error {
+	triggerID, err := getTriggerID(request)
+	if err != nil {
+		return err
+	}
+	payload, err := encodeRequest(request.Metadata.WorkflowID, "", request.Config, request.Inputs)
+	if err != nil {
+		return err
+	}
+
+	c.mu.Lock()
+	if _, ok := c.subscriptions[triggerID]; ok {
+		c.mu.Unlock()
+		return fmt.Errorf("trigger %s is already registered", triggerID)
+	}
+	sub := &subscription{callback: callback, events: map[[32]byte]*pendingEvent{}, stop: make(chan struct{})}
+	c.subscriptions[triggerID] = sub
+	c.mu.Unlock()
+
+	err = broadcast(c.dispatcher, c.don, &Message{CapabilityID: c.ID, Method: MethodRegisterTrigger, MessageID: triggerID, Payload: payload})
+	if err != nil {
+		c.mu.Lock()
+		if c.subscriptions[triggerID] == sub {
+			delete(c.subscriptions, triggerID)
+			close(sub.stop)
+		}
+		c.mu.Unlock()
+		return err
+	}
+	return nil
+}
+
+func (c *triggerClient) UnregisterTrigger(ctx context.Context, request capabilities.CapabilityRequest) error {
+	triggerID, err := getTriggerID(request)
+	if err != nil {
+		return err
+	}
+	payload, err := encodeRequest(request.Metadata.WorkflowID, "", request.Config, request.Inputs)
+	if err != nil {
+		return err
+	}
+
+	c.mu.Lock()
+	if sub, ok := c.subscriptions[triggerID]; ok {
+		delete(c.subscriptions, triggerID)
+		close(sub.stop)
+	}
+	c.mu.Unlock()
+
+	return broadcast(c.dispatcher, c.don, &Message{CapabilityID: c.ID, Method: MethodUnregisterTrigger, MessageID: triggerID, Payload: payload})
+}
+
+func (c *triggerClient) Receive(msg *Message) {
+	if msg.Method != MethodTriggerEvent {
+		c.lggr.Warnw("unexpected method", "method", msg.Method, "sender", msg.Sender)
+		return
+	}
+
+	c.mu.Lock()
+	sub, ok := c.subscriptions[msg.MessageID]
+	if !ok {
+		c.mu.Unlock()
+		c.lggr.Debugw("event for unknown trigger", "triggerID", msg.MessageID, "sender", msg.Sender)
+		return
+	}
+
+	now := time.Now()
+	for k, e := range sub.events {
+		if now.Sub(e.receivedAt) > eventExpiry {
+			delete(sub.events, k)
+		}
+	}
+
+	key := sha256.Sum256(append([]byte(msg.Error+"\x00"), msg.Payload...))
+	e, ok := sub.events[key]
+	if !ok {
+		e = &pendingEvent{aggregator: newAggregator(c.don), receivedAt: now}
+		sub.events[key] = e
+	}
+	agreed := e.aggregator.add(msg)
+	c.mu.Unlock()
+	if !agreed {
+		return
+	}
+
+	if msg.Error != "" {
+		c.deliver(sub, msg.MessageID, capabilities.CapabilityResponse{Err: errors.New(msg.Error)})
+		return
+	}
+	v, err := unmarshalValue(msg.Payload)
+	if err != nil {
+		c.lggr.Errorw("failed to unmarshal trigger event", "triggerID", msg.MessageID, "err", err)
+		return
+	}
+	c.deliver(sub, msg.MessageID, capabilities.CapabilityResponse{Value: v})
+}
+
+// deliver sends an event to the subscriber of the trigger, unless the trigger is unregistered
+// first, in which case the event is dropped.
+func (c *triggerClient) deliver(sub *subscription, triggerID string, response capabilities.CapabilityResponse) {
+	select {
+	case sub.callback <- response:
+	case <-sub.stop:
+		c.lggr.Debugw("dropped event of unregistered trigger", "triggerID", triggerID)
+	}
+}
+
+func getTriggerID(request capabilities.CapabilityRequest) (string, error) {
+	if request.Inputs == nil {
+		return "", errors.New("missing triggerId input")
+	}
+	v, ok := request.Inputs.Underlying["triggerId"]
+	if !ok {
+		return "", errors.New("missing triggerId input")
+	}
+	s, ok := v.(*values.String)
+	if !ok {
+		return "", fmt.Errorf("triggerId must be a string, got %T", v)
+	}
+	return s.Underlying, nil
+}
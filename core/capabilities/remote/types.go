@@ -0,0 +1,207 @@
+package remote
+
+import (
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+
+	"google.golang.org/protobuf/proto"
+
+	ragetypes "github.com/smartcontractkit/libocr/ragep2p/types"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/capabilities"
+	"github.com/smartcontractkit/chainlink-common/pkg/services"
+	"github.com/smartcontractkit/chainlink-common/pkg/values"
+	"github.com/smartcontractkit/chainlink-common/pkg/values/pb"
+)
+
+// Method is the operation a Message carries.
+type Method string
+
+const (
+	// Requests, sent by the DON using a capability to the DON hosting it.
+	MethodExecute                Method = "Execute"
+	MethodRegisterToWorkflow     Method = "RegisterToWorkflow"
+	MethodUnregisterFromWorkflow Method = "UnregisterFromWorkflow"
+	MethodRegisterTrigger        Method = "RegisterTrigger"
+	MethodUnregisterTrigger      Method = "UnregisterTrigger"
+
+	// Responses, sent by the DON hosting a capability back to the DON using it.
+	MethodExecuteResponse Method = "ExecuteResponse"
+	MethodTriggerEvent    Method = "TriggerEvent"
+)
+
+// Role identifies which side of a remote capability a Receiver handles.
+type Role int
+
+const (
+	// RoleClient receives the responses to the requests of a local proxy of a remote capability.
+	RoleClient Role = iota
+	// RoleServer receives the requests made by remote DONs to a local capability.
+	RoleServer
+)
+
+// role returns the role of the receivers messages using the method are dispatched to.
+func (m Method) role() Role {
+	switch m {
+	case MethodExecuteResponse, MethodTriggerEvent:
+		return RoleClient
+	default:
+		return RoleServer
+	}
+}
+
+// Message is the envelope of every request and response exchanged between DONs.
+type Message struct {
+	CapabilityID string `json:"capabilityId"`
+	Method       Method `json:"method"`
+	// MessageID correlates responses with their request. For triggers, it is the ID of the trigger.
+	MessageID string `json:"messageId"`
+	Payload   []byte `json:"payload,omitempty"`
+	Error     string `json:"error,omitempty"`
+
+	// Sender is set by the Dispatcher on the messages it receives.
+	Sender ragetypes.PeerID `json:"-"`
+}
+
+// DON is a set of nodes hosting or using a capability, of which at most F may be faulty.
+type DON struct {
+	Members []ragetypes.PeerID
+	F       uint8
+}
+
+func (d DON) isMember(peerID ragetypes.PeerID) bool {
+	for _, m := range d.Members {
+		if m == peerID {
+			return true
+		}
+	}
+	return false
+}
+
+// Receiver handles the messages dispatched to a capability.
+type Receiver interface {
+	Receive(msg *Message)
+}
+
+// Dispatcher sends messages to other nodes over P2P and routes the messages it
+// receives to the Receiver set for their capability and role.
+type Dispatcher interface {
+	services.Service
+	SetReceiver(capabilityID string, role Role, receiver Receiver) error
+	RemoveReceiver(capabilityID string, role Role)
+	Send(peerID ragetypes.PeerID, msg *Message) error
+}
+
+// requestPayload is the payload of every request.
+type requestPayload struct {
+	WorkflowID          string `json:"workflowId"`
+	WorkflowExecutionID string `json:"workflowExecutionId,omitempty"`
+	Config              []byte `json:"config,omitempty"`
+	Inputs              []byte `json:"inputs,omitempty"`
+}
+
+func encodeRequest(workflowID string, executionID string, config *values.Map, inputs *values.Map) ([]byte, error) {
+	p := requestPayload{WorkflowID: workflowID, WorkflowExecutionID: executionID}
+
+	var err error
+	if config != nil {
+		if p.Config, err = marshalValue(config); err != nil {
+			return nil, fmt.Errorf("failed to marshal config: %w", err)
+		}
+	}
+	if inputs != nil {
+		if p.Inputs, err = marshalValue(inputs); err != nil {
+			return nil, fmt.Errorf("failed to marshal inputs: %w", err)
+		}
+	}
+	return json.Marshal(p)
+}
+
+func decodeRequest(payload []byte) (capabilities.CapabilityRequest, error) {
+	var p requestPayload
+	if err := json.Unmarshal(payload, &p); err != nil {
+		return capabilities.CapabilityRequest{}, err
+	}
+
+	req := capabilities.CapabilityRequest{
+		Metadata: capabilities.RequestMetadata{
+			WorkflowID:          p.WorkflowID,
+			WorkflowExecutionID: p.WorkflowExecutionID,
+		},
+	}
+	var err error
+	if req.Config, err = unmarshalMap(p.Config); err != nil {
+		return req, fmt.Errorf("failed to unmarshal config: %w", err)
+	}
+	if req.Inputs, err = unmarshalMap(p.Inputs); err != nil {
+		return req, fmt.Errorf("failed to unmarshal inputs: %w", err)
+	}
+	return req, nil
+}
+
+func marshalValue(v values.Value) ([]byte, error) {
+	return proto.Marshal(values.Proto(v))
+}
+
+func unmarshalValue(b []byte) (values.Value, error) {
+	pv := &pb.Value{}
+	if err := proto.Unmarshal(b, pv); err != nil {
+		return nil, err
+	}
+	return values.FromProto(pv), nil
+}
+
+func unmarshalMap(b []byte) (*values.Map, error) {
+	if b == nil {
+		return nil, nil
+	}
+	v, err := unmarshalValue(b)
+	if err != nil {
+		return nil, err
+	}
+	m, ok := v.(*values.Map)
+	if !ok {
+		return nil, fmt.Errorf("expected a map, got %T", v)
+	}
+	return m, nil
+}
+
+// aggregator collects the responses of the members of a DON to a single request,
+// and reports agreement once F+1 members sent the same response, so that a
+// faulty member can neither forge nor block a response.
+type aggregator struct {
+	don     DON
+	senders map[ragetypes.PeerID]bool
+	counts  map[[32]byte]int
+	done    bool
+}
+
+func newAggregator(don DON) *aggregator {
+	return &aggregator{
+		don:     don,
+		senders: map[ragetypes.PeerID]bool{},
+		counts:  map[[32]byte]int{},
+	}
+}
+
+// add records the response and returns true the first time F+1 members agree on it.
+// Responses from non-members and repeated responses from a member are ignored.
+func (a *aggregator) add(msg *Message) bool {
+	if a.done || !a.don.isMember(msg.Sender) || a.senders[msg.Sender] {
+		return false
+	}
+	a.senders[msg.Sender] = true
+
+	h := sha256.New()
+	h.Write(msg.Payload)
+	h.Write([]byte(msg.Error))
+	var key [32]byte
+	copy(key[:], h.Sum(nil))
+
+	a.counts[key]++
+	if a.counts[key] > int(a.don.F) {
+		a.done = true
+	}
+	return a.done
+}
@@ -2,34 +2,52 @@ package capabilities
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"slices"
 
+	"github.com/smartcontractkit/chainlink-common/pkg/capabilities"
 	"github.com/smartcontractkit/chainlink-common/pkg/services"
 	"github.com/smartcontractkit/chainlink-common/pkg/types"
 
 	"github.com/smartcontractkit/libocr/ragep2p"
 	ragetypes "github.com/smartcontractkit/libocr/ragep2p/types"
 
+	"github.com/smartcontractkit/chainlink/v2/core/capabilities/remote"
+	"github.com/smartcontractkit/chainlink/v2/core/config"
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/p2pkey"
 	p2ptypes "github.com/smartcontractkit/chainlink/v2/core/services/p2p/types"
 )
 
 type registrySyncer struct {
-	peerWrapper p2ptypes.PeerWrapper
-	registry    types.CapabilitiesRegistry
-	lggr        logger.Logger
+	peerWrapper        p2ptypes.PeerWrapper
+	registry           types.CapabilitiesRegistry
+	dispatcher         remote.Dispatcher
+	remoteCapabilities []config.RemoteCapability
+	lggr               logger.Logger
+
+	servers []services.Service
+	clients []string
 }
 
 var _ services.Service = &registrySyncer{}
 
 // RegistrySyncer updates local Registry to match its onchain counterpart
-func NewRegistrySyncer(peerWrapper p2ptypes.PeerWrapper, registry types.CapabilitiesRegistry, lggr logger.Logger) *registrySyncer {
+func NewRegistrySyncer(peerWrapper p2ptypes.PeerWrapper, registry types.CapabilitiesRegistry, dispatcher remote.Dispatcher, remoteCapabilities []config.RemoteCapability, lggr logger.Logger) *registrySyncer {
 	return &registrySyncer{
-		peerWrapper: peerWrapper,
-		registry:    registry,
-		lggr:        lggr,
+		peerWrapper:        peerWrapper,
+		registry:           registry,
+		dispatcher:         dispatcher,
+		remoteCapabilities: remoteCapabilities,
+		lggr:               lggr,
 	}
 }
 
+// Start exposes the local capabilities hosted for the workflow DON, and adds proxies of the
+// remote capabilities used by the workflow DON to the registry, depending on which DON of each
+// remote capability this node is a member of. It then connects to the members of the other DON.
+// NOTE: the remote capabilities are configured until the onchain registry is read.
 func (s *registrySyncer) Start(ctx context.Context) error {
 	// NOTE: temporary hard-coded values
 	defaultStreamConfig := p2ptypes.StreamConfig{
@@ -45,26 +63,95 @@ func (s *registrySyncer) Start(ctx context.Context) error {
 			Capacity: 1000,
 		},
 	}
-	peerIDs := []string{
-		"12D3KooWF3dVeJ6YoT5HFnYhmwQWWMoEwVFzJQ5kKCMX3ZityxMC",
-		"12D3KooWQsmok6aD8PZqt3RnJhQRrNzKHLficq7zYFRp7kZ1hHP8",
-		"12D3KooWJbZLiMuGeKw78s3LM5TNgBTJHcF39DraxLu14bucG9RN",
-		"12D3KooWGqfSPhHKmQycfhRjgUDE2vg9YWZN27Eue8idb2ZUk6EH",
-	}
+
+	self := s.peerWrapper.GetPeer().ID()
 	peers := make(map[ragetypes.PeerID]p2ptypes.StreamConfig)
-	for _, peerID := range peerIDs {
-		var p ragetypes.PeerID
-		err := p.UnmarshalText([]byte(peerID))
-		if err != nil {
-			return err
+	for _, rc := range s.remoteCapabilities {
+		capabilityDON := newDON(rc.CapabilityDONMembers(), rc.CapabilityDONF())
+		workflowDON := newDON(rc.WorkflowDONMembers(), rc.WorkflowDONF())
+
+		var others []ragetypes.PeerID
+		switch {
+		case slices.Contains(capabilityDON.Members, self):
+			if err := s.serve(ctx, rc.ID(), workflowDON); err != nil {
+				return errors.Join(err, s.Close())
+			}
+			others = workflowDON.Members
+		case slices.Contains(workflowDON.Members, self):
+			if err := s.addClient(ctx, rc, capabilityDON); err != nil {
+				return errors.Join(err, s.Close())
+			}
+			others = capabilityDON.Members
+		default:
+			continue
+		}
+		for _, p := range others {
+			if p != self {
+				peers[p] = defaultStreamConfig
+			}
 		}
-		peers[p] = defaultStreamConfig
 	}
 	return s.peerWrapper.GetPeer().UpdateConnections(peers)
 }
 
-func (s *registrySyncer) Close() error {
-	return s.peerWrapper.GetPeer().UpdateConnections(map[ragetypes.PeerID]p2ptypes.StreamConfig{})
+// serve exposes the local capability with the given ID to the members of the workflow DON.
+func (s *registrySyncer) serve(ctx context.Context, id string, workflowDON remote.DON) error {
+	capability, err := s.registry.Get(ctx, id)
+	if err != nil {
+		return fmt.Errorf("failed to get local capability %s to serve to the workflow DON: %w", id, err)
+	}
+	server := remote.NewServer(id, capability, workflowDON, s.dispatcher, s.lggr)
+	if err := server.Start(ctx); err != nil {
+		return fmt.Errorf("failed to start server of capability %s: %w", id, err)
+	}
+	s.servers = append(s.servers, server)
+	return nil
+}
+
+// addClient adds a proxy of the capability hosted by the capability DON to the registry.
+func (s *registrySyncer) addClient(ctx context.Context, rc config.RemoteCapability, capabilityDON remote.DON) error {
+	info := capabilities.CapabilityInfo{
+		ID:             rc.ID(),
+		CapabilityType: rc.Type(),
+		Description:    fmt.Sprintf("Remote %s hosted by a DON of %d nodes", rc.Type(), len(capabilityDON.Members)),
+	}
+	var (
+		client capabilities.BaseCapability
+		err    error
+	)
+	if rc.Type() == capabilities.CapabilityTypeTrigger {
+		client, err = remote.NewTriggerClient(info, capabilityDON, s.dispatcher, s.lggr)
+	} else {
+		client, err = remote.NewExecutableClient(info, capabilityDON, s.dispatcher, s.lggr)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to create client of remote capability %s: %w", rc.ID(), err)
+	}
+	s.clients = append(s.clients, rc.ID())
+	if err := s.registry.Add(ctx, client); err != nil {
+		return fmt.Errorf("failed to add remote capability %s to the registry: %w", rc.ID(), err)
+	}
+	return nil
+}
+
+func newDON(members []p2pkey.PeerID, f uint8) remote.DON {
+	don := remote.DON{F: f}
+	for _, m := range members {
+		don.Members = append(don.Members, ragetypes.PeerID(m))
+	}
+	return don
+}
+
+func (s *registrySyncer) Close() (err error) {
+	for _, server := range s.servers {
+		err = errors.Join(err, server.Close())
+	}
+	s.servers = nil
+	for _, id := range s.clients {
+		s.dispatcher.RemoveReceiver(id, remote.RoleClient)
+	}
+	s.clients = nil
+	return errors.Join(err, s.peerWrapper.GetPeer().UpdateConnections(map[ragetypes.PeerID]p2ptypes.StreamConfig{}))
 }
 
 func (s *registrySyncer) Ready() error {
@@ -1,15 +1,27 @@
 package capabilities_test
 
 import (
+	"context"
+	"sync"
 	"testing"
 
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 
+	ragetypes "github.com/smartcontractkit/libocr/ragep2p/types"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/capabilities"
+	"github.com/smartcontractkit/chainlink-common/pkg/services"
 	commonMocks "github.com/smartcontractkit/chainlink-common/pkg/types/mocks"
 	coreCapabilities "github.com/smartcontractkit/chainlink/v2/core/capabilities"
+	"github.com/smartcontractkit/chainlink/v2/core/capabilities/remote"
+	"github.com/smartcontractkit/chainlink/v2/core/capabilities/triggers"
+	"github.com/smartcontractkit/chainlink/v2/core/config"
 	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/p2pkey"
+	p2ptypes "github.com/smartcontractkit/chainlink/v2/core/services/p2p/types"
 	"github.com/smartcontractkit/chainlink/v2/core/services/p2p/types/mocks"
 )
 
@@ -17,12 +29,166 @@ func TestSyncer_CleanStartClose(t *testing.T) {
 	lggr := logger.TestLogger(t)
 	ctx := testutils.Context(t)
 	peer := mocks.NewPeer(t)
+	peer.On("ID").Return(ragetypes.PeerID{})
 	peer.On("UpdateConnections", mock.Anything).Return(nil)
 	wrapper := mocks.NewPeerWrapper(t)
 	wrapper.On("GetPeer").Return(peer)
 	registry := commonMocks.NewCapabilitiesRegistry(t)
 
-	syncer := coreCapabilities.NewRegistrySyncer(wrapper, registry, lggr)
+	syncer := coreCapabilities.NewRegistrySyncer(wrapper, registry, newFakeDispatcher(), nil, lggr)
 	require.NoError(t, syncer.Start(ctx))
 	require.NoError(t, syncer.Close())
 }
+
+type fakeRemoteCapability struct {
+	id                   string
+	capabilityType       capabilities.CapabilityType
+	capabilityDONMembers []p2pkey.PeerID
+	workflowDONMembers   []p2pkey.PeerID
+}
+
+var _ config.RemoteCapability = fakeRemoteCapability{}
+
+func (f fakeRemoteCapability) ID() string                            { return f.id }
+func (f fakeRemoteCapability) Type() capabilities.CapabilityType     { return f.capabilityType }
+func (f fakeRemoteCapability) CapabilityDONMembers() []p2pkey.PeerID { return f.capabilityDONMembers }
+func (f fakeRemoteCapability) CapabilityDONF() uint8                 { return 1 }
+func (f fakeRemoteCapability) WorkflowDONMembers() []p2pkey.PeerID   { return f.workflowDONMembers }
+func (f fakeRemoteCapability) WorkflowDONF() uint8                   { return 1 }
+
+type receiverKey struct {
+	capabilityID string
+	role         remote.Role
+}
+
+type fakeDispatcher struct {
+	mu        sync.Mutex
+	receivers map[receiverKey]remote.Receiver
+}
+
+func newFakeDispatcher() *fakeDispatcher {
+	return &fakeDispatcher{receivers: map[receiverKey]remote.Receiver{}}
+}
+
+func (d *fakeDispatcher) SetReceiver(capabilityID string, role remote.Role, receiver remote.Receiver) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.receivers[receiverKey{capabilityID, role}] = receiver
+	return nil
+}
+
+func (d *fakeDispatcher) RemoveReceiver(capabilityID string, role remote.Role) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	delete(d.receivers, receiverKey{capabilityID, role})
+}
+
+func (d *fakeDispatcher) hasReceiver(capabilityID string, role remote.Role) bool {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	_, ok := d.receivers[receiverKey{capabilityID, role}]
+	return ok
+}
+
+func (d *fakeDispatcher) Send(peerID ragetypes.PeerID, msg *remote.Message) error { return nil }
+func (d *fakeDispatcher) Start(ctx context.Context) error                         { return nil }
+func (d *fakeDispatcher) Close() error                                            { return nil }
+func (d *fakeDispatcher) Ready() error                                            { return nil }
+func (d *fakeDispatcher) HealthReport() map[string]error                          { return nil }
+func (d *fakeDispatcher) Name() string                                            { return "fakeDispatcher" }
+
+func TestSyncer_RemoteCapabilities(t *testing.T) {
+	lggr := logger.TestLogger(t)
+	ctx := testutils.Context(t)
+
+	// the members of the DONs are numbered by their first byte
+	newMembers := func(first byte) []p2pkey.PeerID {
+		var members []p2pkey.PeerID
+		for i := first; i < first+4; i++ {
+			members = append(members, p2pkey.PeerID{i})
+		}
+		return members
+	}
+	capabilityDON, otherCapabilityDON, workflowDON := newMembers(1), newMembers(5), newMembers(9)
+	remoteCapabilities := []config.RemoteCapability{
+		fakeRemoteCapability{id: triggers.CronTriggerID, capabilityType: capabilities.CapabilityTypeTrigger, capabilityDONMembers: capabilityDON, workflowDONMembers: workflowDON},
+		fakeRemoteCapability{id: "write_remote-chain", capabilityType: capabilities.CapabilityTypeTarget, capabilityDONMembers: otherCapabilityDON, workflowDONMembers: workflowDON},
+	}
+	noPeers := map[ragetypes.PeerID]p2ptypes.StreamConfig{}
+
+	newSyncer := func(t *testing.T, self p2pkey.PeerID, registry *coreCapabilities.Registry, dispatcher remote.Dispatcher) (*mocks.Peer, services.Service) {
+		peer := mocks.NewPeer(t)
+		peer.On("ID").Return(ragetypes.PeerID(self))
+		wrapper := mocks.NewPeerWrapper(t)
+		wrapper.On("GetPeer").Return(peer)
+		return peer, coreCapabilities.NewRegistrySyncer(wrapper, registry, dispatcher, remoteCapabilities, lggr)
+	}
+
+	t.Run("member of the capability DON serves the local capability", func(t *testing.T) {
+		registry := coreCapabilities.NewRegistry(lggr)
+		require.NoError(t, registry.Add(ctx, triggers.NewCronTrigger(lggr)))
+		dispatcher := newFakeDispatcher()
+		peer, syncer := newSyncer(t, capabilityDON[0], registry, dispatcher)
+		peer.On("UpdateConnections", mock.MatchedBy(func(peers map[ragetypes.PeerID]p2ptypes.StreamConfig) bool {
+			// connects to the workflow DON only
+			for _, m := range workflowDON {
+				if _, ok := peers[ragetypes.PeerID(m)]; !ok {
+					return false
+				}
+			}
+			return len(peers) == len(workflowDON)
+		})).Return(nil).Once()
+
+		require.NoError(t, syncer.Start(ctx))
+		assert.True(t, dispatcher.hasReceiver(triggers.CronTriggerID, remote.RoleServer))
+		assert.False(t, dispatcher.hasReceiver("write_remote-chain", remote.RoleServer))
+
+		peer.On("UpdateConnections", noPeers).Return(nil).Once()
+		require.NoError(t, syncer.Close())
+		assert.False(t, dispatcher.hasReceiver(triggers.CronTriggerID, remote.RoleServer))
+	})
+
+	t.Run("member of the capability DON fails without the local capability", func(t *testing.T) {
+		registry := coreCapabilities.NewRegistry(lggr)
+		peer, syncer := newSyncer(t, capabilityDON[0], registry, newFakeDispatcher())
+		peer.On("UpdateConnections", noPeers).Return(nil).Once()
+
+		require.ErrorContains(t, syncer.Start(ctx), "failed to get local capability cron-trigger")
+	})
+
+	t.Run("member of the workflow DON adds the remote capabilities to the registry", func(t *testing.T) {
+		registry := coreCapabilities.NewRegistry(lggr)
+		dispatcher := newFakeDispatcher()
+		peer, syncer := newSyncer(t, workflowDON[0], registry, dispatcher)
+		peer.On("UpdateConnections", mock.MatchedBy(func(peers map[ragetypes.PeerID]p2ptypes.StreamConfig) bool {
+			// connects to both capability DONs
+			return len(peers) == 8
+		})).Return(nil).Once()
+
+		require.NoError(t, syncer.Start(ctx))
+		trigger, err := registry.GetTrigger(ctx, triggers.CronTriggerID)
+		require.NoError(t, err)
+		info, err := trigger.Info(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, capabilities.CapabilityTypeTrigger, info.CapabilityType)
+		_, err = registry.GetTarget(ctx, "write_remote-chain")
+		require.NoError(t, err)
+		assert.True(t, dispatcher.hasReceiver(triggers.CronTriggerID, remote.RoleClient))
+		assert.True(t, dispatcher.hasReceiver("write_remote-chain", remote.RoleClient))
+
+		peer.On("UpdateConnections", noPeers).Return(nil).Once()
+		require.NoError(t, syncer.Close())
+		assert.False(t, dispatcher.hasReceiver(triggers.CronTriggerID, remote.RoleClient))
+	})
+
+	t.Run("node outside of the DONs does nothing", func(t *testing.T) {
+		registry := coreCapabilities.NewRegistry(lggr)
+		peer, syncer := newSyncer(t, p2pkey.PeerID{0xff}, registry, newFakeDispatcher())
+		peer.On("UpdateConnections", noPeers).Return(nil).Once()
+
+		require.NoError(t, syncer.Start(ctx))
+		list, err := registry.List(ctx)
+		require.NoError(t, err)
+		assert.Empty(t, list)
+	})
+}
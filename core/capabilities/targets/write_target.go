@@ -2,11 +2,15 @@ package targets
 
 import (
 	"context"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
+	"math/big"
 	"strings"
+	"time"
 
 	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/mitchellh/mapstructure"
 	"github.com/pkg/errors"
@@ -17,6 +21,7 @@ import (
 	commontypes "github.com/smartcontractkit/chainlink-common/pkg/types"
 	"github.com/smartcontractkit/chainlink-common/pkg/values"
 	txmgrcommon "github.com/smartcontractkit/chainlink/v2/common/txmgr"
+	txmgrtypes "github.com/smartcontractkit/chainlink/v2/common/txmgr/types"
 	abiutil "github.com/smartcontractkit/chainlink/v2/core/chains/evm/abi"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/txmgr"
 	evmtypes "github.com/smartcontractkit/chainlink/v2/core/chains/evm/types"
@@ -44,6 +49,31 @@ var (
 
 const defaultGasLimit = 200000
 
+var allTxStates = []txmgrtypes.TxState{
+	txmgrcommon.TxUnstarted,
+	txmgrcommon.TxInProgress,
+	txmgrcommon.TxUnconfirmed,
+	txmgrcommon.TxConfirmedMissingReceipt,
+	txmgrcommon.TxConfirmed,
+	txmgrcommon.TxFatalError,
+}
+
+// Statuses of the responses of the write target.
+const (
+	// TxStatusPending is the status of a transaction queued in the txmgr.
+	TxStatusPending = "pending"
+	// TxStatusConfirmed is the status of a transaction included on chain.
+	TxStatusConfirmed = "confirmed"
+	// TxStatusReverted is the status of a transaction included on chain which reverted.
+	TxStatusReverted = "reverted"
+	// TxStatusFatal is the status of a transaction the txmgr gave up on.
+	TxStatusFatal = "fatal"
+	// TxStatusTransmitted is the status of a report already transmitted to the forwarder, e.g. by another node.
+	TxStatusTransmitted = "transmitted"
+)
+
+const txStatusPollInterval = time.Second
+
 type EvmWrite struct {
 	chain legacyevm.Chain
 	capabilities.CapabilityInfo
@@ -157,9 +187,16 @@ func encodePayload(args []any, rawSelector string) ([]byte, error) {
 	// return append(method.ID, arguments...), nil
 }
 
+// Execute sends the report to the forwarder, unless another node already transmitted it, and
+// responds twice: once the transaction is queued, with status pending, and again with its final
+// state, unless the transaction already has one, which is then the only response. A repeated
+// execution, e.g. a retry, picks up the transaction queued by the first one, since the idempotency
+// key of the transaction is derived from the execution, the chain and the receiver.
+//
+// The forwarder records a single transmitter per execution, whatever the receiver, so the report
+// is only skipped when that record is for this receiver, see transmittedTo.
 func (cap *EvmWrite) Execute(ctx context.Context, callback chan<- capabilities.CapabilityResponse, request capabilities.CapabilityRequest) error {
 	cap.lggr.Debugw("Execute", "request", request)
-	// TODO: idempotency
 
 	// TODO: extract into ChainWriter?
 	txm := cap.chain.TxManager()
@@ -177,6 +214,43 @@ func (cap *EvmWrite) Execute(ctx context.Context, callback chan<- capabilities.C
 	}
 	inputs := inputsAny.(map[string]any)
 
+	executionID, err := parseExecutionID(request.Metadata.WorkflowExecutionID)
+	if err != nil {
+		return err
+	}
+	receiver := common.HexToAddress(reqConfig.Address)
+	idempotencyKey := transmissionKey(request.Metadata.WorkflowExecutionID, cap.chain.ID(), receiver)
+
+	ownTxes, err := cap.chain.TxManager().FindTxesByMetaFieldAndStates(ctx, "WorkflowExecutionID", request.Metadata.WorkflowExecutionID, allTxStates, cap.chain.ID())
+	if err != nil {
+		return err
+	}
+	for _, tx := range ownTxes {
+		if tx.IdempotencyKey != nil && *tx.IdempotencyKey == idempotencyKey {
+			return cap.respondWithState(ctx, callback, tx.ID)
+		}
+	}
+
+	transmitter, err := cap.getTransmitter(ctx, config.ForwarderAddress().Address(), executionID)
+	if err != nil {
+		return err
+	}
+	if transmittedTo(transmitter, config.FromAddress().Address(), ownTxes) {
+		cap.lggr.Debugw("Report already transmitted", "executionID", request.Metadata.WorkflowExecutionID, "transmitter", transmitter)
+		value, err := values.NewMap(map[string]any{
+			"status":      TxStatusTransmitted,
+			"transmitter": transmitter.Hex(),
+		})
+		if err != nil {
+			return err
+		}
+		go func() {
+			defer close(callback)
+			sendResponse(ctx, callback, capabilities.CapabilityResponse{Value: value})
+		}()
+		return nil
+	}
+
 	// evaluate any variables in reqConfig.Params
 	args, err := evaluateParams(reqConfig.Params, inputs)
 	if err != nil {
@@ -194,7 +268,7 @@ func (cap *EvmWrite) Execute(ctx context.Context, callback chan<- capabilities.C
 	signatures := [][]byte{}
 
 	// construct forwarding payload
-	calldata, err := forwardABI.Pack("report", common.HexToAddress(reqConfig.Address), data, signatures)
+	calldata, err := forwardABI.Pack("report", receiver, data, signatures)
 	if err != nil {
 		return err
 	}
@@ -209,6 +283,7 @@ func (cap *EvmWrite) Execute(ctx context.Context, callback chan<- capabilities.C
 		CheckerType: txmgr.TransmitCheckerTypeSimulate,
 	}
 	req := txmgr.TxRequest{
+		IdempotencyKey: &idempotencyKey,
 		FromAddress:    config.FromAddress().Address(),
 		ToAddress:      config.ForwarderAddress().Address(),
 		EncodedPayload: calldata,
@@ -222,18 +297,159 @@ func (cap *EvmWrite) Execute(ctx context.Context, callback chan<- capabilities.C
 	if err != nil {
 		return err
 	}
-	fmt.Printf("Transaction submitted %v", tx.ID)
+	cap.lggr.Debugw("Transaction submitted", "txID", tx.ID, "executionID", request.Metadata.WorkflowExecutionID)
+	return cap.respondWithState(ctx, callback, tx.ID)
+}
+
+// respondWithState responds with the final state of the transaction if it already has one, or else
+// with status pending and again once the transaction reaches its final state, and closes the
+// callback.
+func (cap *EvmWrite) respondWithState(ctx context.Context, callback chan<- capabilities.CapabilityResponse, txID int64) error {
+	final, err := cap.finalState(ctx, txID)
+	if err != nil {
+		return err
+	}
+	if final != nil {
+		go func() {
+			defer close(callback)
+			sendResponse(ctx, callback, capabilities.CapabilityResponse{Value: final})
+		}()
+		return nil
+	}
+
+	pending, err := values.NewMap(map[string]any{
+		"status": TxStatusPending,
+		"txId":   txID,
+	})
+	if err != nil {
+		return err
+	}
 	go func() {
-		// TODO: cast tx.Error to Err (or Value to Value?)
-		callback <- capabilities.CapabilityResponse{
-			Value: nil,
-			Err:   nil,
+		defer close(callback)
+		if !sendResponse(ctx, callback, capabilities.CapabilityResponse{Value: pending}) {
+			return
 		}
-		close(callback)
+		final, err := cap.waitForFinalState(ctx, txID)
+		if err != nil {
+			cap.lggr.Debugw("Stopped waiting for transaction", "txID", txID, "err", err)
+			return
+		}
+		sendResponse(ctx, callback, capabilities.CapabilityResponse{Value: final})
 	}()
 	return nil
 }
 
+// transmissionKey identifies the transmission of a report of an execution to a receiver on a chain, so that every
+// write target of an execution gets its own transaction. It is used as the idempotency key of the transaction, which
+// is unique across chains.
+func transmissionKey(executionID string, chainID *big.Int, receiver common.Address) string {
+	return fmt.Sprintf("%s-%s-%s", executionID, chainID, receiver.Hex())
+}
+
+// transmittedTo tells whether the transmission the forwarder recorded for an execution, if any, is
+// the one of this receiver, given the transactions this node queued for the execution on this
+// chain, none of which is for this receiver. The forwarder does not record the receiver, so the
+// record is attributed to this receiver only if this node did not make it, and did not queue a
+// transmission of the execution to another receiver which the record could be for.
+func transmittedTo(transmitter, fromAddress common.Address, ownTxes []*txmgr.Tx) bool {
+	if transmitter == (common.Address{}) || transmitter == fromAddress {
+		return false
+	}
+	return len(ownTxes) == 0
+}
+
+// parseExecutionID decodes a workflow execution ID into the bytes32 the forwarder keys reports by.
+func parseExecutionID(executionID string) ([32]byte, error) {
+	var id [32]byte
+	b, err := hex.DecodeString(executionID)
+	if err != nil || len(b) != len(id) {
+		return id, fmt.Errorf("invalid workflow execution ID %q: expected 32 hex encoded bytes", executionID)
+	}
+	copy(id[:], b)
+	return id, nil
+}
+
+// getTransmitter returns the address which transmitted the report of an execution, or the zero
+// address if the report was not transmitted yet.
+func (cap *EvmWrite) getTransmitter(ctx context.Context, forwarderAddress common.Address, executionID [32]byte) (common.Address, error) {
+	caller, err := forwarder.NewKeystoneForwarderCaller(forwarderAddress, cap.chain.Client())
+	if err != nil {
+		return common.Address{}, err
+	}
+	transmitter, err := caller.GetTransmitter(&bind.CallOpts{Context: ctx}, executionID)
+	if err != nil {
+		return common.Address{}, fmt.Errorf("failed to get transmitter from forwarder: %w", err)
+	}
+	return transmitter, nil
+}
+
+// waitForFinalState polls the txmgr until the transaction is confirmed, reverted or fatal, and
+// returns its final state.
+func (cap *EvmWrite) waitForFinalState(ctx context.Context, txID int64) (*values.Map, error) {
+	ticker := time.NewTicker(txStatusPollInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-ticker.C:
+		}
+
+		final, err := cap.finalState(ctx, txID)
+		if err != nil {
+			cap.lggr.Errorw("Failed to get transaction state", "txID", txID, "err", err)
+		} else if final != nil {
+			return final, nil
+		}
+	}
+}
+
+// finalState returns the final state of the transaction, or nil if it is still pending.
+func (cap *EvmWrite) finalState(ctx context.Context, txID int64) (*values.Map, error) {
+	txes, err := cap.chain.TxManager().FindTxesWithAttemptsAndReceiptsByIdsAndState(ctx,
+		[]big.Int{*big.NewInt(txID)},
+		[]txmgrtypes.TxState{txmgrcommon.TxConfirmed, txmgrcommon.TxFatalError},
+		cap.chain.ID(),
+	)
+	if err != nil || len(txes) == 0 {
+		return nil, err
+	}
+	tx := txes[0]
+
+	state := map[string]any{"txId": txID}
+	if tx.State == txmgrcommon.TxFatalError {
+		state["status"] = TxStatusFatal
+		state["error"] = tx.Error.String
+		return values.NewMap(state)
+	}
+	for _, attempt := range tx.TxAttempts {
+		for _, receipt := range attempt.Receipts {
+			if receipt == nil || receipt.IsZero() {
+				continue
+			}
+			state["status"] = TxStatusConfirmed
+			if receipt.GetStatus() == 0 {
+				state["status"] = TxStatusReverted
+			}
+			state["txHash"] = receipt.GetTxHash().Hex()
+			state["blockNumber"] = receipt.GetBlockNumber().Int64()
+			return values.NewMap(state)
+		}
+	}
+	// confirmed, but the receipt is not loaded yet
+	return nil, nil
+}
+
+// sendResponse sends a response unless ctx is done first, since nobody waits for it then.
+func sendResponse(ctx context.Context, callback chan<- capabilities.CapabilityResponse, response capabilities.CapabilityResponse) bool {
+	select {
+	case callback <- response:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
+
 func (cap *EvmWrite) RegisterToWorkflow(ctx context.Context, request capabilities.RegisterToWorkflowRequest) error {
 	return nil
 }
@@ -4,9 +4,15 @@ import (
 	"math/big"
 	"testing"
 
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+
 	"github.com/smartcontractkit/chainlink-common/pkg/capabilities"
 	"github.com/smartcontractkit/chainlink-common/pkg/values"
+	txmgrcommon "github.com/smartcontractkit/chainlink/v2/common/txmgr"
+	txmgrtypes "github.com/smartcontractkit/chainlink/v2/common/txmgr/types"
 	"github.com/smartcontractkit/chainlink/v2/core/capabilities/targets"
+	clientmocks "github.com/smartcontractkit/chainlink/v2/core/chains/evm/client/mocks"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/txmgr"
 	txmmocks "github.com/smartcontractkit/chainlink/v2/core/chains/evm/txmgr/mocks"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/types"
@@ -18,6 +24,7 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
 	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
 
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 )
@@ -28,8 +35,10 @@ func TestEvmWrite(t *testing.T) {
 	chain := evmmocks.NewChain(t)
 
 	txManager := txmmocks.NewMockEvmTxManager(t)
+	client := clientmocks.NewClient(t)
 	chain.On("ID").Return(big.NewInt(11155111))
 	chain.On("TxManager").Return(txManager)
+	chain.On("Client").Return(client)
 
 	cfg := configtest.NewGeneralConfig(t, func(c *chainlink.Config, s *chainlink.Secrets) {
 		a := testutils.NewAddress()
@@ -42,6 +51,7 @@ func TestEvmWrite(t *testing.T) {
 		require.NoError(t, err)
 		c.EVM[0].ChainWriter.ForwarderAddress = &forwarderAddr
 	})
+	forwarderAddress := cfg.EVMConfigs()[0].ChainWriter.ForwarderAddress.Address()
 	evmcfg := evmtest.NewChainScopedConfig(t, cfg)
 	chain.On("Config").Return(evmcfg)
 
@@ -59,16 +69,32 @@ func TestEvmWrite(t *testing.T) {
 	})
 	require.NoError(t, err)
 
+	executionID := "d3f9b3c2e8a5b1e7a2c4d6f8091a2b3c4d5e6f708192a3b4c5d6e7f8091a2b3c"
 	req := capabilities.CapabilityRequest{
 		Metadata: capabilities.RequestMetadata{
-			WorkflowID: "hello",
+			WorkflowID:          "hello",
+			WorkflowExecutionID: executionID,
 		},
 		Config: config,
 		Inputs: inputs,
 	}
 
-	txManager.On("CreateTransaction", mock.Anything, mock.Anything).Return(txmgr.Tx{}, nil).Run(func(args mock.Arguments) {
+	getTransmitter, err := forwardABI.Pack("getTransmitter", common.HexToHash(executionID))
+	require.NoError(t, err)
+	transmitterCall := client.On("CallContract", mock.Anything, mock.MatchedBy(func(msg ethereum.CallMsg) bool {
+		return *msg.To == forwarderAddress && string(msg.Data) == string(getTransmitter)
+	}), (*big.Int)(nil))
+	transmitterCall.Return(common.LeftPadBytes(nil, 32), nil)
+
+	// the transaction is keyed by the execution, the chain and the receiver, so that the write targets of an execution
+	// on other chains or to other receivers get their own
+	idempotencyKey := executionID + "-11155111-" + common.Address{}.Hex()
+	findTxes := txManager.On("FindTxesByMetaFieldAndStates", mock.Anything, "WorkflowExecutionID", executionID, mock.Anything, big.NewInt(11155111))
+	findTxes.Return([]*txmgr.Tx{}, nil).Once()
+
+	txManager.On("CreateTransaction", mock.Anything, mock.Anything).Return(txmgr.Tx{ID: 42}, nil).Run(func(args mock.Arguments) {
 		req := args.Get(1).(txmgr.TxRequest)
+		require.Equal(t, idempotencyKey, *req.IdempotencyKey)
 		payload := make(map[string]any)
 		method := forwardABI.Methods["report"]
 		err = method.Inputs.UnpackIntoMap(payload, req.EncodedPayload[4:])
@@ -82,11 +108,76 @@ func TestEvmWrite(t *testing.T) {
 
 	})
 
-	ch := make(chan capabilities.CapabilityResponse)
+	txHash := common.HexToHash("0x01")
+	finalTxes := func() []*txmgr.Tx {
+		return []*txmgr.Tx{{
+			ID:    42,
+			State: txmgrcommon.TxConfirmed,
+			TxAttempts: []txmgr.TxAttempt{{
+				Receipts: []txmgr.ChainReceipt{&types.Receipt{TxHash: txHash, BlockNumber: big.NewInt(7), Status: 1}},
+			}},
+		}}
+	}
+	findFinal := func() *mock.Call {
+		return txManager.On("FindTxesWithAttemptsAndReceiptsByIdsAndState", mock.Anything, []big.Int{*big.NewInt(42)},
+			[]txmgrtypes.TxState{txmgrcommon.TxConfirmed, txmgrcommon.TxFatalError}, big.NewInt(11155111),
+		)
+	}
+	findFinal().Return([]*txmgr.Tx{}, nil).Once()
+	findFinal().Return(finalTxes(), nil).Once()
 
-	err = capability.Execute(ctx, ch, req)
+	// the transaction is pending once queued, and the callback stays open until it is confirmed
+	resp, err := capabilities.ExecuteSync(ctx, capability, req)
+	require.NoError(t, err)
+	require.Len(t, resp.Underlying, 2)
+	pending, err := values.NewMap(map[string]any{"status": targets.TxStatusPending, "txId": 42})
+	require.NoError(t, err)
+	assert.Equal(t, pending, resp.Underlying[0])
+	confirmed, err := values.NewMap(map[string]any{
+		"status":      targets.TxStatusConfirmed,
+		"txId":        42,
+		"txHash":      txHash.Hex(),
+		"blockNumber": 7,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, confirmed, resp.Underlying[1])
+
+	// executing the request again only responds with the final state of the transaction, even though the forwarder
+	// now reports this node as the transmitter
+	transmitterCall.Return(common.LeftPadBytes(evmcfg.EVM().ChainWriter().FromAddress().Bytes(), 32), nil)
+	txManager.On("FindTxesByMetaFieldAndStates", mock.Anything, "WorkflowExecutionID", executionID, mock.Anything, big.NewInt(11155111)).
+		Return([]*txmgr.Tx{{ID: 41, IdempotencyKey: &executionID}, {ID: 42, IdempotencyKey: &idempotencyKey}}, nil).Once()
+	findFinal().Return(finalTxes(), nil).Once()
+
+	resp, err = capabilities.ExecuteSync(ctx, capability, req)
+	require.NoError(t, err)
+	require.Len(t, resp.Underlying, 1)
+	assert.Equal(t, confirmed, resp.Underlying[0])
+
+	// a report transmitted by another node is not sent again
+	findTxes.Return([]*txmgr.Tx{}, nil).Once()
+	transmitter := testutils.NewAddress()
+	transmitterCall.Return(common.LeftPadBytes(transmitter.Bytes(), 32), nil)
+	resp, err = capabilities.ExecuteSync(ctx, capability, req)
+	require.NoError(t, err)
+	require.Len(t, resp.Underlying, 1)
+	transmitted, err := values.NewMap(map[string]any{"status": targets.TxStatusTransmitted, "transmitter": transmitter.Hex()})
+	require.NoError(t, err)
+	assert.Equal(t, transmitted, resp.Underlying[0])
+	txManager.AssertNumberOfCalls(t, "CreateTransaction", 1)
+
+	// the forwarder does not record the receiver, so a transmission by another node is not taken for the one of this
+	// receiver when this node queued a transmission of the execution to another receiver
+	otherKey := executionID + "-11155111-" + testutils.NewAddress().Hex()
+	findTxes.Return([]*txmgr.Tx{{ID: 43, IdempotencyKey: &otherKey}}, nil).Once()
+	findFinal().Return(finalTxes(), nil).Once()
+	resp, err = capabilities.ExecuteSync(ctx, capability, req)
 	require.NoError(t, err)
+	require.Len(t, resp.Underlying, 1)
+	assert.Equal(t, confirmed, resp.Underlying[0])
+	txManager.AssertNumberOfCalls(t, "CreateTransaction", 2)
 
-	response := <-ch
-	require.Nil(t, response.Err)
+	req.Metadata.WorkflowExecutionID = "an-execution"
+	_, err = capabilities.ExecuteSync(ctx, capability, req)
+	require.ErrorContains(t, err, "invalid workflow execution ID")
 }
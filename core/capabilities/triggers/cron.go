@@ -0,0 +1,182 @@
+package triggers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/robfig/cron/v3"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/capabilities"
+	"github.com/smartcontractkit/chainlink-common/pkg/services"
+	"github.com/smartcontractkit/chainlink-common/pkg/values"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	croncore "github.com/smartcontractkit/chainlink/v2/core/services/cron"
+	"github.com/smartcontractkit/chainlink/v2/core/utils"
+)
+
+const CronTriggerID = "cron-trigger"
+
+var (
+	_ capabilities.TriggerCapability = (*CronTrigger)(nil)
+	_ services.Service               = (*CronTrigger)(nil)
+)
+
+// CronTrigger emits an event for each registered workflow on the schedule set
+// in its config, e.g.
+//
+//	triggers:
+//	  - type: cron-trigger
+//	    config:
+//	      schedule: "CRON_TZ=UTC */10 * * * * *"
+//
+// Schedules use the same syntax as cron jobs.
+type CronTrigger struct {
+	services.StateMachine
+	capabilities.CapabilityInfo
+	runner *cron.Cron
+	lggr   logger.Logger
+
+	mu            sync.Mutex
+	subscriptions map[string]*cronSubscription
+
+	stopCh services.StopChan
+}
+
+type cronSubscription struct {
+	entryID  cron.EntryID
+	callback chan<- capabilities.CapabilityResponse
+	stopCh   chan struct{}
+}
+
+func NewCronTrigger(lggr logger.Logger) *CronTrigger {
+	return &CronTrigger{
+		CapabilityInfo: capabilities.MustNewCapabilityInfo(
+			CronTriggerID,
+			capabilities.CapabilityTypeTrigger,
+			"Cron trigger.",
+			"v1.0.0",
+		),
+		runner:        croncore.NewRunner(),
+		lggr:          lggr.Named("CronTrigger"),
+		subscriptions: map[string]*cronSubscription{},
+		stopCh:        make(services.StopChan),
+	}
+}
+
+func (c *CronTrigger) Start(context.Context) error {
+	return c.StartOnce("CronTrigger", func() error {
+		c.runner.Start()
+		return nil
+	})
+}
+
+func (c *CronTrigger) Close() error {
+	return c.StopOnce("CronTrigger", func() error {
+		close(c.stopCh)
+		<-c.runner.Stop().Done()
+		return nil
+	})
+}
+
+func (c *CronTrigger) RegisterTrigger(ctx context.Context, callback chan<- capabilities.CapabilityResponse, request capabilities.CapabilityRequest) error {
+	triggerID, err := getTriggerID(request)
+	if err != nil {
+		return err
+	}
+	schedule, err := getStringConfig(request, "schedule")
+	if err != nil {
+		return err
+	}
+	if err = utils.ValidateCronSchedule(schedule); err != nil {
+		return err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if _, ok := c.subscriptions[triggerID]; ok {
+		return fmt.Errorf("trigger %s is already registered", triggerID)
+	}
+	sub := &cronSubscription{callback: callback, stopCh: make(chan struct{})}
+	sub.entryID, err = c.runner.AddFunc(schedule, func() { c.fire(triggerID, sub) })
+	if err != nil {
+		return fmt.Errorf("failed to schedule trigger %s: %w", triggerID, err)
+	}
+	c.subscriptions[triggerID] = sub
+	c.lggr.Debugw("Registered trigger", "triggerID", triggerID, "workflowID", request.Metadata.WorkflowID, "schedule", schedule)
+	return nil
+}
+
+func (c *CronTrigger) UnregisterTrigger(ctx context.Context, request capabilities.CapabilityRequest) error {
+	triggerID, err := getTriggerID(request)
+	if err != nil {
+		return err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	sub, ok := c.subscriptions[triggerID]
+	if !ok {
+		return fmt.Errorf("trigger %s is not registered", triggerID)
+	}
+	c.runner.Remove(sub.entryID)
+	close(sub.stopCh)
+	delete(c.subscriptions, triggerID)
+	return nil
+}
+
+// fire sends an event for the current tick. Ticks are truncated to the second, so
+// that every node of a DON emits the same event for the same tick.
+func (c *CronTrigger) fire(triggerID string, sub *cronSubscription) {
+	event, err := values.NewMap(map[string]any{
+		"triggerId":     triggerID,
+		"scheduledTime": time.Now().UTC().Truncate(time.Second).Format(time.RFC3339),
+	})
+	if err != nil {
+		c.lggr.Errorw("Failed to create event", "triggerID", triggerID, "err", err)
+		return
+	}
+
+	select {
+	case sub.callback <- capabilities.CapabilityResponse{Value: event}:
+	case <-sub.stopCh:
+	case <-c.stopCh:
+	}
+}
+
+func (c *CronTrigger) Ready() error {
+	return c.StateMachine.Ready()
+}
+
+func (c *CronTrigger) HealthReport() map[string]error {
+	return map[string]error{c.Name(): c.Healthy()}
+}
+
+func (c *CronTrigger) Name() string {
+	return c.lggr.Name()
+}
+
+func getTriggerID(request capabilities.CapabilityRequest) (string, error) {
+	if request.Inputs == nil {
+		return "", errors.New("missing triggerId input")
+	}
+	v, ok := request.Inputs.Underlying["triggerId"].(*values.String)
+	if !ok {
+		return "", errors.New("missing triggerId input")
+	}
+	return v.Underlying, nil
+}
+
+func getStringConfig(request capabilities.CapabilityRequest, key string) (string, error) {
+	if request.Config == nil {
+		return "", fmt.Errorf("missing %s config", key)
+	}
+	v, ok := request.Config.Underlying[key].(*values.String)
+	if !ok {
+		return "", fmt.Errorf("missing %s config", key)
+	}
+	return v.Underlying, nil
+}
@@ -0,0 +1,439 @@
+package triggers
+
+import (
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/mitchellh/mapstructure"
+
+	chainselectors "github.com/smartcontractkit/chain-selectors"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/capabilities"
+	"github.com/smartcontractkit/chainlink-common/pkg/services"
+	"github.com/smartcontractkit/chainlink-common/pkg/sqlutil"
+	commontypes "github.com/smartcontractkit/chainlink-common/pkg/types"
+	"github.com/smartcontractkit/chainlink-common/pkg/values"
+
+	"github.com/smartcontractkit/chainlink/v2/core/capabilities/internal/abivalues"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/logpoller"
+	evmtypes "github.com/smartcontractkit/chainlink/v2/core/chains/evm/types"
+	evmutils "github.com/smartcontractkit/chainlink/v2/core/chains/evm/utils"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/legacyevm"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/relay/evm"
+	relaytypes "github.com/smartcontractkit/chainlink/v2/core/services/relay/evm/types"
+)
+
+// InitializeLogTrigger adds a log trigger to the registry for every chain with the LogPoller enabled.
+func InitializeLogTrigger(registry commontypes.CapabilitiesRegistry, legacyEVMChains legacyevm.LegacyChainContainer, ds sqlutil.DataSource, lggr logger.Logger) error {
+	orm := NewLogTriggerORM(ds)
+	for _, chain := range legacyEVMChains.Slice() {
+		if !chain.Config().Feature().LogPoller() {
+			continue
+		}
+		capability := NewLogTrigger(chain.ID(), chain.LogPoller(), chain.Config().EVM().LogPollInterval(), orm, lggr)
+		if err := registry.Add(context.TODO(), capability); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+var _ capabilities.TriggerCapability = (*LogTrigger)(nil)
+
+// LogTrigger emits an event for each log of a contract event, once the block
+// containing it has the configured number of confirmations, e.g.
+//
+//	triggers:
+//	  - type: log-trigger_ethereum-testnet-sepolia
+//	    config:
+//	      address: "0x..."
+//	      abi: '[{"type":"event","name":"Transfer",...}]'
+//	      event: Transfer
+//	      confirmations: 12
+//
+// Each log is delivered at most once: logs moved to another block by a reorg are
+// not delivered again, and the last delivered block is persisted across restarts.
+// Logs are remembered until their block is finalized, up to maxDeliveredLogs.
+type LogTrigger struct {
+	capabilities.CapabilityInfo
+	chainID      *big.Int
+	lp           logpoller.LogPoller
+	pollInterval time.Duration
+	orm          LogTriggerORM
+	lggr         logger.Logger
+
+	mu            sync.Mutex
+	subscriptions map[string]*logSubscription
+}
+
+type LogTriggerConfig struct {
+	Address string
+	// ABI is the JSON ABI of the contract, which must include Event.
+	ABI           string
+	Event         string
+	Confirmations uint64
+}
+
+// maxDeliveredLogs bounds the logs a subscription remembers while their blocks are
+// not finalized. The logs of the oldest blocks are forgotten first.
+const maxDeliveredLogs = 10_000
+
+type deliveredBlock struct {
+	hash   common.Hash
+	number int64
+}
+
+type logSubscription struct {
+	triggerID     string
+	filterName    string
+	address       common.Address
+	event         abi.Event
+	codec         commontypes.RemoteCodec
+	confirmations int64
+	callback      chan<- capabilities.CapabilityResponse
+
+	// lastBlock is the last block delivered, or -1 until the first poll.
+	lastBlock int64
+	// delivered holds the logs delivered in blocks that are not yet finalized, by the
+	// number of the block they were delivered in.
+	delivered map[common.Hash]int64
+	// deliveredTxs holds the block each transaction had its logs delivered in, since a
+	// reorg may move them to a later block.
+	deliveredTxs map[common.Hash]deliveredBlock
+
+	stopCh services.StopChan
+	wg     sync.WaitGroup
+}
+
+func NewLogTrigger(chainID *big.Int, lp logpoller.LogPoller, pollInterval time.Duration, orm LogTriggerORM, lggr logger.Logger) *LogTrigger {
+	// generate ID based on chain selector
+	name := fmt.Sprintf("log-trigger_%v", chainID)
+	chainName, err := chainselectors.NameFromChainId(chainID.Uint64())
+	if err == nil {
+		name = fmt.Sprintf("log-trigger_%v", chainName)
+	}
+
+	return &LogTrigger{
+		CapabilityInfo: capabilities.MustNewCapabilityInfo(
+			name,
+			capabilities.CapabilityTypeTrigger,
+			"Log trigger.",
+			"v1.0.0",
+		),
+		chainID:       chainID,
+		lp:            lp,
+		pollInterval:  pollInterval,
+		orm:           orm,
+		lggr:          lggr.Named("LogTrigger").With("chainID", chainID.String()),
+		subscriptions: map[string]*logSubscription{},
+	}
+}
+
+func (l *LogTrigger) RegisterTrigger(ctx context.Context, callback chan<- capabilities.CapabilityResponse, request capabilities.CapabilityRequest) error {
+	triggerID, err := getTriggerID(request)
+	if err != nil {
+		return err
+	}
+	sub, err := newLogSubscription(triggerID, request.Config, callback)
+	if err != nil {
+		return fmt.Errorf("invalid config for trigger %s: %w", triggerID, err)
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if _, ok := l.subscriptions[triggerID]; ok {
+		return fmt.Errorf("trigger %s is already registered", triggerID)
+	}
+
+	err = l.lp.RegisterFilter(ctx, logpoller.Filter{
+		Name:      sub.filterName,
+		EventSigs: evmtypes.HashArray{sub.event.ID},
+		Addresses: evmtypes.AddressArray{sub.address},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to register filter for trigger %s: %w", triggerID, err)
+	}
+
+	block, found, err := l.orm.LastDeliveredBlock(ctx, l.chainID, triggerID)
+	if err != nil {
+		return err
+	}
+	if found {
+		sub.lastBlock = block
+		// the filter may have been unregistered while the node was down, so make sure
+		// the logs emitted since then are indexed
+		l.lp.ReplayAsync(block + 1)
+	}
+
+	l.subscriptions[triggerID] = sub
+	sub.wg.Add(1)
+	go func() {
+		defer sub.wg.Done()
+		l.pollLoop(sub)
+	}()
+	l.lggr.Debugw("Registered trigger", "triggerID", triggerID, "workflowID", request.Metadata.WorkflowID, "address", sub.address, "event", sub.event.Name)
+	return nil
+}
+
+func (l *LogTrigger) UnregisterTrigger(ctx context.Context, request capabilities.CapabilityRequest) error {
+	triggerID, err := getTriggerID(request)
+	if err != nil {
+		return err
+	}
+
+	l.mu.Lock()
+	sub, ok := l.subscriptions[triggerID]
+	delete(l.subscriptions, triggerID)
+	l.mu.Unlock()
+	if !ok {
+		return fmt.Errorf("trigger %s is not registered", triggerID)
+	}
+
+	close(sub.stopCh)
+	sub.wg.Wait()
+	// The last delivered block is kept, since triggers are also unregistered when the node stops, and resume from it
+	// once registered again. It is deleted along with the workflow instead, see workflows.Delegate.OnDeleteJob.
+	return l.lp.UnregisterFilter(ctx, sub.filterName)
+}
+
+func (l *LogTrigger) pollLoop(sub *logSubscription) {
+	ctx, cancel := sub.stopCh.NewCtx()
+	defer cancel()
+
+	ticker := time.NewTicker(l.pollInterval)
+	defer ticker.Stop()
+	for {
+		if err := l.poll(ctx, sub); err != nil && ctx.Err() == nil {
+			l.lggr.Errorw("Failed to poll logs", "triggerID", sub.triggerID, "err", err)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// poll delivers the logs of the blocks which reached the confirmation depth since the last poll.
+func (l *LogTrigger) poll(ctx context.Context, sub *logSubscription) error {
+	latest, err := l.lp.LatestBlock(ctx)
+	if err != nil {
+		return err
+	}
+	confirmed := latest.BlockNumber - sub.confirmations
+	if sub.lastBlock < 0 {
+		// a new trigger starts from the latest confirmed block, rather than delivering the whole history
+		sub.lastBlock = confirmed
+		return l.orm.SetLastDeliveredBlock(ctx, l.chainID, sub.triggerID, confirmed)
+	}
+	if confirmed <= sub.lastBlock {
+		return nil
+	}
+
+	logs, err := l.lp.Logs(ctx, sub.lastBlock+1, confirmed, sub.event.ID, sub.address)
+	if err != nil {
+		return err
+	}
+	for i := range logs {
+		log := &logs[i]
+		key := logKey(log)
+		if _, ok := sub.delivered[key]; ok {
+			continue
+		}
+		if tx, ok := sub.deliveredTxs[log.TxHash]; ok && tx.hash != log.BlockHash {
+			l.lggr.Debugw("Skipping log already delivered before a reorg", "triggerID", sub.triggerID, "txHash", log.TxHash, "blockNumber", log.BlockNumber)
+			continue
+		}
+
+		event, err := sub.decode(ctx, log)
+		if err != nil {
+			// a log that cannot be decoded never will be, so it is reported rather than retried
+			l.lggr.Errorw("Failed to decode log", "triggerID", sub.triggerID, "txHash", log.TxHash, "err", err)
+			event = capabilities.CapabilityResponse{Err: fmt.Errorf("failed to decode log %s:%d: %w", log.TxHash, log.LogIndex, err)}
+		}
+		select {
+		case sub.callback <- event:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+		sub.delivered[key] = log.BlockNumber
+		sub.deliveredTxs[log.TxHash] = deliveredBlock{log.BlockHash, log.BlockNumber}
+	}
+
+	sub.forget(latest.FinalizedBlockNumber)
+	for len(sub.delivered) > maxDeliveredLogs {
+		oldest := confirmed
+		for _, block := range sub.delivered {
+			oldest = min(oldest, block)
+		}
+		sub.forget(oldest)
+	}
+	sub.lastBlock = confirmed
+	// the last delivered block of the trigger is deleted along with its workflow, possibly before the trigger is
+	// unregistered, so it is only updated rather than set again
+	return l.orm.UpdateLastDeliveredBlock(ctx, l.chainID, sub.triggerID, confirmed)
+}
+
+// forget drops the logs and transactions delivered up to the given block.
+func (sub *logSubscription) forget(block int64) {
+	for key, b := range sub.delivered {
+		if b <= block {
+			delete(sub.delivered, key)
+		}
+	}
+	for txHash, b := range sub.deliveredTxs {
+		if b.number <= block {
+			delete(sub.deliveredTxs, txHash)
+		}
+	}
+}
+
+// logKey identifies a log by the block and transaction it was included in, and its index.
+func logKey(log *logpoller.Log) common.Hash {
+	var data []byte
+	data = append(data, log.BlockHash.Bytes()...)
+	data = append(data, log.TxHash.Bytes()...)
+	data = binary.BigEndian.AppendUint64(data, uint64(log.LogIndex))
+	return evmutils.Keccak256Fixed(data)
+}
+
+func newLogSubscription(triggerID string, rawConfig *values.Map, callback chan<- capabilities.CapabilityResponse) (*logSubscription, error) {
+	var config LogTriggerConfig
+	if rawConfig == nil {
+		return nil, errors.New("missing config")
+	}
+	configAny, err := rawConfig.Unwrap()
+	if err != nil {
+		return nil, err
+	}
+	if err = mapstructure.Decode(configAny, &config); err != nil {
+		return nil, err
+	}
+	if !common.IsHexAddress(config.Address) {
+		return nil, fmt.Errorf("invalid address %q", config.Address)
+	}
+
+	contractABI, err := abi.JSON(strings.NewReader(config.ABI))
+	if err != nil {
+		return nil, fmt.Errorf("invalid abi: %w", err)
+	}
+	event, ok := contractABI.Events[config.Event]
+	if !ok {
+		return nil, fmt.Errorf("event %q not found in abi", config.Event)
+	}
+	typeABI, err := nonIndexedInputsABI(config.ABI, config.Event)
+	if err != nil {
+		return nil, err
+	}
+	codec, err := evm.NewCodec(relaytypes.CodecConfig{Configs: map[string]relaytypes.ChainCodecConfig{
+		config.Event: {TypeABI: typeABI},
+	}})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create codec: %w", err)
+	}
+
+	return &logSubscription{
+		triggerID:     triggerID,
+		filterName:    logpoller.FilterName("LogTrigger", triggerID),
+		address:       common.HexToAddress(config.Address),
+		event:         event,
+		codec:         codec,
+		confirmations: int64(config.Confirmations),
+		callback:      callback,
+		lastBlock:     -1,
+		delivered:     map[common.Hash]int64{},
+		deliveredTxs:  map[common.Hash]deliveredBlock{},
+		stopCh:        make(services.StopChan),
+	}, nil
+}
+
+// nonIndexedInputsABI returns the JSON ABI of the arguments of the event which are
+// stored in the data of its logs, rather than in their topics.
+func nonIndexedInputsABI(rawABI string, eventName string) (string, error) {
+	var entries []struct {
+		Type   string            `json:"type"`
+		Name   string            `json:"name"`
+		Inputs []json.RawMessage `json:"inputs"`
+	}
+	if err := json.Unmarshal([]byte(rawABI), &entries); err != nil {
+		return "", fmt.Errorf("invalid abi: %w", err)
+	}
+	for _, entry := range entries {
+		if entry.Type != "event" || entry.Name != eventName {
+			continue
+		}
+		nonIndexed := []json.RawMessage{}
+		for _, input := range entry.Inputs {
+			var arg struct {
+				Indexed bool `json:"indexed"`
+			}
+			if err := json.Unmarshal(input, &arg); err != nil {
+				return "", fmt.Errorf("invalid abi: %w", err)
+			}
+			if !arg.Indexed {
+				nonIndexed = append(nonIndexed, input)
+			}
+		}
+		b, err := json.Marshal(nonIndexed)
+		return string(b), err
+	}
+	return "", fmt.Errorf("event %q not found in abi", eventName)
+}
+
+// decode returns the event for a log. As with the codec, the names of the event's
+// arguments are converted to Go names, e.g. Value for value.
+func (s *logSubscription) decode(ctx context.Context, log *logpoller.Log) (capabilities.CapabilityResponse, error) {
+	decoded, err := s.codec.CreateType(s.event.Name, false)
+	if err != nil {
+		return capabilities.CapabilityResponse{}, err
+	}
+	if err = s.codec.Decode(ctx, log.Data, decoded, s.event.Name); err != nil {
+		return capabilities.CapabilityResponse{}, err
+	}
+	data, ok := abivalues.Normalize(decoded).(map[string]any)
+	if !ok {
+		return capabilities.CapabilityResponse{}, fmt.Errorf("unexpected decoded type %T", decoded)
+	}
+
+	var indexed abi.Arguments
+	for _, input := range s.event.Inputs {
+		if input.Indexed {
+			indexed = append(indexed, input)
+		}
+	}
+	topics := log.GetTopics()
+	if len(topics) < len(indexed)+1 {
+		return capabilities.CapabilityResponse{}, errors.New("not enough topics to decode")
+	}
+	topicValues := map[string]any{}
+	if err = abi.ParseTopicsIntoMap(topicValues, indexed, topics[1:len(indexed)+1]); err != nil {
+		return capabilities.CapabilityResponse{}, err
+	}
+	for name, v := range topicValues {
+		data[abi.ToCamelCase(name)] = abivalues.Normalize(v)
+	}
+
+	event, err := values.NewMap(map[string]any{
+		"triggerId":   s.triggerID,
+		"address":     log.Address.Hex(),
+		"txHash":      log.TxHash.Hex(),
+		"blockNumber": log.BlockNumber,
+		"blockHash":   log.BlockHash.Hex(),
+		"logIndex":    log.LogIndex,
+		"data":        data,
+	})
+	if err != nil {
+		return capabilities.CapabilityResponse{}, err
+	}
+	return capabilities.CapabilityResponse{Value: event}, nil
+}
@@ -0,0 +1,76 @@
+package triggers
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/lib/pq"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/sqlutil"
+
+	ubig "github.com/smartcontractkit/chainlink/v2/core/chains/evm/utils/big"
+)
+
+// LogTriggerORM persists the last block delivered by each log trigger, so that
+// triggers resume where they left off after a node restart.
+type LogTriggerORM interface {
+	// LastDeliveredBlock returns the last block delivered by the trigger, or false if it never delivered one.
+	LastDeliveredBlock(ctx context.Context, chainID *big.Int, triggerID string) (int64, bool, error)
+	// SetLastDeliveredBlock records that all logs up to and including block were delivered by the trigger.
+	SetLastDeliveredBlock(ctx context.Context, chainID *big.Int, triggerID string, block int64) error
+	// UpdateLastDeliveredBlock is like SetLastDeliveredBlock, but does not record anything for a trigger
+	// whose last delivered block was deleted since it was set.
+	UpdateLastDeliveredBlock(ctx context.Context, chainID *big.Int, triggerID string, block int64) error
+	// DeleteLastDeliveredBlocks deletes the last blocks delivered by the triggers, on every chain.
+	DeleteLastDeliveredBlocks(ctx context.Context, triggerIDs []string) error
+}
+
+type logTriggerORM struct {
+	ds sqlutil.DataSource
+}
+
+var _ LogTriggerORM = (*logTriggerORM)(nil)
+
+func NewLogTriggerORM(ds sqlutil.DataSource) LogTriggerORM {
+	return &logTriggerORM{ds: ds}
+}
+
+func (o *logTriggerORM) LastDeliveredBlock(ctx context.Context, chainID *big.Int, triggerID string) (block int64, found bool, err error) {
+	err = o.ds.GetContext(ctx, &block, `SELECT block_number FROM evm.log_trigger_cursors WHERE evm_chain_id = $1 AND trigger_id = $2`, ubig.New(chainID), triggerID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return 0, false, nil
+	} else if err != nil {
+		return 0, false, fmt.Errorf("failed to load last delivered block of trigger %s: %w", triggerID, err)
+	}
+	return block, true, nil
+}
+
+func (o *logTriggerORM) SetLastDeliveredBlock(ctx context.Context, chainID *big.Int, triggerID string, block int64) error {
+	stmt := `INSERT INTO evm.log_trigger_cursors (evm_chain_id, trigger_id, block_number, updated_at)
+		VALUES ($1, $2, $3, NOW())
+		ON CONFLICT (evm_chain_id, trigger_id) DO UPDATE SET
+			block_number = EXCLUDED.block_number,
+			updated_at = EXCLUDED.updated_at`
+	if _, err := o.ds.ExecContext(ctx, stmt, ubig.New(chainID), triggerID, block); err != nil {
+		return fmt.Errorf("failed to save last delivered block of trigger %s: %w", triggerID, err)
+	}
+	return nil
+}
+
+func (o *logTriggerORM) UpdateLastDeliveredBlock(ctx context.Context, chainID *big.Int, triggerID string, block int64) error {
+	stmt := `UPDATE evm.log_trigger_cursors SET block_number = $3, updated_at = NOW() WHERE evm_chain_id = $1 AND trigger_id = $2`
+	if _, err := o.ds.ExecContext(ctx, stmt, ubig.New(chainID), triggerID, block); err != nil {
+		return fmt.Errorf("failed to save last delivered block of trigger %s: %w", triggerID, err)
+	}
+	return nil
+}
+
+func (o *logTriggerORM) DeleteLastDeliveredBlocks(ctx context.Context, triggerIDs []string) error {
+	if _, err := o.ds.ExecContext(ctx, `DELETE FROM evm.log_trigger_cursors WHERE trigger_id = ANY($1)`, pq.Array(triggerIDs)); err != nil {
+		return fmt.Errorf("failed to delete last delivered blocks of triggers %v: %w", triggerIDs, err)
+	}
+	return nil
+}
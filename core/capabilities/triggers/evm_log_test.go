@@ -0,0 +1,238 @@
+package triggers_test
+
+import (
+	"context"
+	"math/big"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/capabilities"
+	"github.com/smartcontractkit/chainlink-common/pkg/values"
+
+	"github.com/smartcontractkit/chainlink/v2/core/capabilities/triggers"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/logpoller"
+	evmutils "github.com/smartcontractkit/chainlink/v2/core/chains/evm/utils"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+)
+
+const transferABI = `[{"type":"event","name":"Transfer","anonymous":false,"inputs":[
+	{"name":"from","type":"address","indexed":true},
+	{"name":"to","type":"address","indexed":true},
+	{"name":"value","type":"uint256","indexed":false}
+]}]`
+
+// fakeLogPoller serves logs and blocks set by the test.
+type fakeLogPoller struct {
+	logpoller.LogPoller
+
+	mu       sync.Mutex
+	latest   int64
+	logs     []logpoller.Log
+	filters  map[string]logpoller.Filter
+	replayed []int64
+}
+
+func (lp *fakeLogPoller) RegisterFilter(ctx context.Context, filter logpoller.Filter) error {
+	lp.mu.Lock()
+	defer lp.mu.Unlock()
+	lp.filters[filter.Name] = filter
+	return nil
+}
+
+func (lp *fakeLogPoller) UnregisterFilter(ctx context.Context, name string) error {
+	lp.mu.Lock()
+	defer lp.mu.Unlock()
+	delete(lp.filters, name)
+	return nil
+}
+
+func (lp *fakeLogPoller) ReplayAsync(fromBlock int64) {
+	lp.mu.Lock()
+	defer lp.mu.Unlock()
+	lp.replayed = append(lp.replayed, fromBlock)
+}
+
+func (lp *fakeLogPoller) LatestBlock(ctx context.Context) (logpoller.LogPollerBlock, error) {
+	lp.mu.Lock()
+	defer lp.mu.Unlock()
+	return logpoller.LogPollerBlock{BlockNumber: lp.latest, FinalizedBlockNumber: lp.latest - 10}, nil
+}
+
+func (lp *fakeLogPoller) Logs(ctx context.Context, start, end int64, eventSig common.Hash, address common.Address) ([]logpoller.Log, error) {
+	lp.mu.Lock()
+	defer lp.mu.Unlock()
+	var logs []logpoller.Log
+	for _, l := range lp.logs {
+		if l.BlockNumber >= start && l.BlockNumber <= end && l.EventSig == eventSig && l.Address == address {
+			logs = append(logs, l)
+		}
+	}
+	return logs, nil
+}
+
+// set replaces the logs and advances the chain to the given block.
+func (lp *fakeLogPoller) set(latest int64, logs ...logpoller.Log) {
+	lp.mu.Lock()
+	defer lp.mu.Unlock()
+	lp.latest = latest
+	lp.logs = logs
+}
+
+type fakeLogTriggerORM struct {
+	mu     sync.Mutex
+	blocks map[string]int64
+}
+
+func (o *fakeLogTriggerORM) LastDeliveredBlock(ctx context.Context, chainID *big.Int, triggerID string) (int64, bool, error) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	block, ok := o.blocks[triggerID]
+	return block, ok, nil
+}
+
+func (o *fakeLogTriggerORM) SetLastDeliveredBlock(ctx context.Context, chainID *big.Int, triggerID string, block int64) error {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	o.blocks[triggerID] = block
+	return nil
+}
+
+func (o *fakeLogTriggerORM) UpdateLastDeliveredBlock(ctx context.Context, chainID *big.Int, triggerID string, block int64) error {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	if _, ok := o.blocks[triggerID]; ok {
+		o.blocks[triggerID] = block
+	}
+	return nil
+}
+
+func (o *fakeLogTriggerORM) DeleteLastDeliveredBlocks(ctx context.Context, triggerIDs []string) error {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	for _, triggerID := range triggerIDs {
+		delete(o.blocks, triggerID)
+	}
+	return nil
+}
+
+func (o *fakeLogTriggerORM) block(triggerID string) int64 {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	return o.blocks[triggerID]
+}
+
+func TestLogTrigger(t *testing.T) {
+	ctx := testutils.Context(t)
+	lggr := logger.TestLogger(t)
+	contractABI, err := abi.JSON(strings.NewReader(transferABI))
+	require.NoError(t, err)
+	event := contractABI.Events["Transfer"]
+
+	address := testutils.NewAddress()
+	from, to := testutils.NewAddress(), testutils.NewAddress()
+	data, err := event.Inputs.NonIndexed().Pack(big.NewInt(42))
+	require.NoError(t, err)
+	transfer := func(block int64, txHash common.Hash) logpoller.Log {
+		return logpoller.Log{
+			LogIndex:    block % 3,
+			BlockHash:   common.BigToHash(big.NewInt(block)),
+			BlockNumber: block,
+			Topics:      [][]byte{event.ID.Bytes(), common.BytesToHash(from.Bytes()).Bytes(), common.BytesToHash(to.Bytes()).Bytes()},
+			EventSig:    event.ID,
+			Address:     address,
+			TxHash:      txHash,
+			Data:        data,
+		}
+	}
+
+	lp := &fakeLogPoller{latest: 100, filters: map[string]logpoller.Filter{}}
+	orm := &fakeLogTriggerORM{blocks: map[string]int64{}}
+	trigger := triggers.NewLogTrigger(big.NewInt(11155111), lp, 10*time.Millisecond, orm, lggr)
+	assert.Equal(t, "log-trigger_ethereum-testnet-sepolia", trigger.ID)
+
+	request := newRequest(t, "a-workflow", "a-trigger", map[string]any{
+		"address":       address.Hex(),
+		"abi":           transferABI,
+		"event":         "Transfer",
+		"confirmations": 2,
+	})
+	invalid := newRequest(t, "a-workflow", "a-trigger", map[string]any{"address": address.Hex(), "abi": transferABI, "event": "Approval"})
+	require.ErrorContains(t, trigger.RegisterTrigger(ctx, make(chan capabilities.CapabilityResponse), invalid), `event "Approval" not found`)
+
+	events := make(chan capabilities.CapabilityResponse, 10)
+	require.NoError(t, trigger.RegisterTrigger(ctx, events, request))
+	require.Len(t, lp.filters, 1)
+	require.Eventually(t, func() bool { return orm.block("a-trigger") == 98 }, testutils.WaitTimeout(t), testutils.TestInterval)
+
+	// the log is delivered once its block has 2 confirmations
+	txHash := evmutils.NewHash()
+	lp.set(100, transfer(99, txHash))
+	time.Sleep(50 * time.Millisecond)
+	assert.Empty(t, events)
+	lp.set(101, transfer(99, txHash))
+
+	e := <-events
+	require.NoError(t, e.Err)
+	m := e.Value.(*values.Map)
+	assert.Equal(t, values.NewString(txHash.Hex()), m.Underlying["txHash"])
+	assert.Equal(t, values.NewInt64(99), m.Underlying["blockNumber"])
+	expected, err := values.NewMap(map[string]any{
+		"From":  from.Hex(),
+		"To":    to.Hex(),
+		"Value": *big.NewInt(42),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, expected, m.Underlying["data"])
+	require.Eventually(t, func() bool { return orm.block("a-trigger") == 99 }, testutils.WaitTimeout(t), testutils.TestInterval)
+
+	// a reorg moving the log to a later block does not deliver it again
+	lp.set(103, transfer(101, txHash))
+	require.Eventually(t, func() bool { return orm.block("a-trigger") == 101 }, testutils.WaitTimeout(t), testutils.TestInterval)
+	assert.Empty(t, events)
+
+	// a restarted trigger resumes from the last delivered block
+	require.NoError(t, trigger.UnregisterTrigger(ctx, request))
+	assert.Empty(t, lp.filters)
+	otherTxHash := evmutils.NewHash()
+	lp.set(104, transfer(101, txHash), transfer(102, otherTxHash))
+
+	trigger = triggers.NewLogTrigger(big.NewInt(11155111), lp, 10*time.Millisecond, orm, lggr)
+	require.NoError(t, trigger.RegisterTrigger(ctx, events, request))
+	t.Cleanup(func() { require.NoError(t, trigger.UnregisterTrigger(ctx, request)) })
+	assert.Equal(t, []int64{102}, lp.replayed)
+
+	e = <-events
+	require.NoError(t, e.Err)
+	assert.Equal(t, values.NewString(otherTxHash.Hex()), e.Value.(*values.Map).Underlying["txHash"])
+	require.Eventually(t, func() bool { return orm.block("a-trigger") == 102 }, testutils.WaitTimeout(t), testutils.TestInterval)
+	assert.Empty(t, events)
+
+	// identical logs of the same transaction are told apart by their index
+	twinTxHash := evmutils.NewHash()
+	first, second := transfer(103, twinTxHash), transfer(103, twinTxHash)
+	second.LogIndex++
+	lp.set(105, transfer(101, txHash), transfer(102, otherTxHash), first, second)
+	for i := 0; i < 2; i++ {
+		e = <-events
+		require.NoError(t, e.Err)
+		assert.Equal(t, values.NewString(twinTxHash.Hex()), e.Value.(*values.Map).Underlying["txHash"])
+	}
+	require.Eventually(t, func() bool { return orm.block("a-trigger") == 103 }, testutils.WaitTimeout(t), testutils.TestInterval)
+	assert.Empty(t, events)
+
+	// the last delivered block is not set again once deleted along with the workflow
+	require.NoError(t, orm.DeleteLastDeliveredBlocks(ctx, []string{"a-trigger"}))
+	lp.set(106)
+	time.Sleep(50 * time.Millisecond)
+	_, found, err := orm.LastDeliveredBlock(ctx, big.NewInt(11155111), "a-trigger")
+	require.NoError(t, err)
+	assert.False(t, found)
+}
@@ -0,0 +1,225 @@
+package triggers
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"sync"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/capabilities"
+	"github.com/smartcontractkit/chainlink-common/pkg/values"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+)
+
+const HTTPTriggerID = "http-trigger"
+
+var (
+	ErrNoHTTPTrigger = errors.New("workflow has no http trigger")
+	ErrNotAuthorized = errors.New("not authorized to trigger workflow")
+)
+
+var _ capabilities.TriggerCapability = (*HTTPTrigger)(nil)
+
+// HTTPTrigger emits an event for each request made to a workflow through the
+// web API, e.g.
+//
+//	triggers:
+//	  - type: http-trigger
+//	    config:
+//	      externalInitiators: ["my-ei"]
+//
+// Authenticated users may always trigger a workflow, while external initiators
+// must be listed in the config, in the same way as they must be listed in the
+// spec of a webhook job.
+type HTTPTrigger struct {
+	capabilities.CapabilityInfo
+	lggr logger.Logger
+
+	mu            sync.RWMutex
+	subscriptions map[string]*httpSubscription
+}
+
+type httpSubscription struct {
+	workflowID         string
+	externalInitiators []string
+	callback           chan<- capabilities.CapabilityResponse
+}
+
+func NewHTTPTrigger(lggr logger.Logger) *HTTPTrigger {
+	return &HTTPTrigger{
+		CapabilityInfo: capabilities.MustNewCapabilityInfo(
+			HTTPTriggerID,
+			capabilities.CapabilityTypeTrigger,
+			"HTTP trigger.",
+			"v1.0.0",
+		),
+		lggr:          lggr.Named("HTTPTrigger"),
+		subscriptions: map[string]*httpSubscription{},
+	}
+}
+
+func (h *HTTPTrigger) RegisterTrigger(ctx context.Context, callback chan<- capabilities.CapabilityResponse, request capabilities.CapabilityRequest) error {
+	triggerID, err := getTriggerID(request)
+	if err != nil {
+		return err
+	}
+	eis, err := getExternalInitiators(request)
+	if err != nil {
+		return err
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if _, ok := h.subscriptions[triggerID]; ok {
+		return fmt.Errorf("trigger %s is already registered", triggerID)
+	}
+	h.subscriptions[triggerID] = &httpSubscription{
+		workflowID:         request.Metadata.WorkflowID,
+		externalInitiators: eis,
+		callback:           callback,
+	}
+	return nil
+}
+
+func (h *HTTPTrigger) UnregisterTrigger(ctx context.Context, request capabilities.CapabilityRequest) error {
+	triggerID, err := getTriggerID(request)
+	if err != nil {
+		return err
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if _, ok := h.subscriptions[triggerID]; !ok {
+		return fmt.Errorf("trigger %s is not registered", triggerID)
+	}
+	delete(h.subscriptions, triggerID)
+	return nil
+}
+
+// Trigger sends an event carrying body, which must be empty or JSON, to the http triggers
+// of the workflow, and returns the ID of the request. initiator is the name of the external
+// initiator making the request, or empty for an authenticated user.
+func (h *HTTPTrigger) Trigger(ctx context.Context, workflowID string, initiator string, body []byte) (string, error) {
+	payload, err := decodeJSON(body)
+	if err != nil {
+		return "", fmt.Errorf("invalid request body: %w", err)
+	}
+	requestID := uuid.NewString()
+
+	h.mu.RLock()
+	var found bool
+	var triggered []string
+	var callbacks []chan<- capabilities.CapabilityResponse
+	for triggerID, sub := range h.subscriptions {
+		if sub.workflowID != workflowID {
+			continue
+		}
+		found = true
+		if initiator != "" && !slices.Contains(sub.externalInitiators, initiator) {
+			continue
+		}
+		triggered = append(triggered, triggerID)
+		callbacks = append(callbacks, sub.callback)
+	}
+	h.mu.RUnlock()
+	if !found {
+		return "", ErrNoHTTPTrigger
+	}
+	if len(triggered) == 0 {
+		return "", ErrNotAuthorized
+	}
+
+	for i, triggerID := range triggered {
+		fields := map[string]any{
+			"triggerId": triggerID,
+			"requestId": requestID,
+			"initiator": initiator,
+		}
+		if payload != nil {
+			fields["body"] = payload
+		}
+		event, err := values.NewMap(fields)
+		if err != nil {
+			return "", err
+		}
+		select {
+		case callbacks[i] <- capabilities.CapabilityResponse{Value: event}:
+		case <-ctx.Done():
+			return "", ctx.Err()
+		}
+	}
+	h.lggr.Debugw("Triggered workflow", "workflowID", workflowID, "requestID", requestID, "initiator", initiator)
+	return requestID, nil
+}
+
+func getExternalInitiators(request capabilities.CapabilityRequest) ([]string, error) {
+	if request.Config == nil {
+		return nil, nil
+	}
+	v, ok := request.Config.Underlying["externalInitiators"]
+	if !ok {
+		return nil, nil
+	}
+	l, ok := v.(*values.List)
+	if !ok {
+		return nil, errors.New("externalInitiators must be a list of names")
+	}
+	var names []string
+	for _, e := range l.Underlying {
+		s, ok := e.(*values.String)
+		if !ok {
+			return nil, errors.New("externalInitiators must be a list of names")
+		}
+		names = append(names, s.Underlying)
+	}
+	return names, nil
+}
+
+// decodeJSON decodes body into a value that can be wrapped by the values package,
+// keeping numbers as decimals.
+func decodeJSON(body []byte) (any, error) {
+	if len(bytes.TrimSpace(body)) == 0 {
+		return nil, nil
+	}
+	d := json.NewDecoder(bytes.NewReader(body))
+	d.UseNumber()
+	var v any
+	if err := d.Decode(&v); err != nil {
+		return nil, err
+	}
+	if d.More() {
+		return nil, errors.New("unexpected data after JSON value")
+	}
+	return convertNumbers(v)
+}
+
+func convertNumbers(v any) (any, error) {
+	switch t := v.(type) {
+	case json.Number:
+		return decimal.NewFromString(t.String())
+	case map[string]any:
+		for k, e := range t {
+			c, err := convertNumbers(e)
+			if err != nil {
+				return nil, err
+			}
+			t[k] = c
+		}
+	case []any:
+		for i, e := range t {
+			c, err := convertNumbers(e)
+			if err != nil {
+				return nil, err
+			}
+			t[i] = c
+		}
+	}
+	return v, nil
+}
@@ -0,0 +1,99 @@
+package triggers_test
+
+import (
+	"testing"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/capabilities"
+	"github.com/smartcontractkit/chainlink-common/pkg/values"
+
+	"github.com/smartcontractkit/chainlink/v2/core/capabilities/triggers"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+)
+
+func newRequest(t *testing.T, workflowID string, triggerID string, config map[string]any) capabilities.CapabilityRequest {
+	inputs, err := values.NewMap(map[string]any{"triggerId": triggerID})
+	require.NoError(t, err)
+	cfg, err := values.NewMap(config)
+	require.NoError(t, err)
+	return capabilities.CapabilityRequest{
+		Metadata: capabilities.RequestMetadata{WorkflowID: workflowID},
+		Config:   cfg,
+		Inputs:   inputs,
+	}
+}
+
+func TestCronTrigger(t *testing.T) {
+	ctx := testutils.Context(t)
+	trigger := triggers.NewCronTrigger(logger.TestLogger(t))
+	require.NoError(t, trigger.Start(ctx))
+	t.Cleanup(func() { require.NoError(t, trigger.Close()) })
+
+	invalid := newRequest(t, "a-workflow", "a-trigger", map[string]any{"schedule": "* * * * *"})
+	require.ErrorContains(t, trigger.RegisterTrigger(ctx, make(chan capabilities.CapabilityResponse), invalid), "CRON_TZ")
+
+	request := newRequest(t, "a-workflow", "a-trigger", map[string]any{"schedule": "@every 1s"})
+	events := make(chan capabilities.CapabilityResponse)
+	require.NoError(t, trigger.RegisterTrigger(ctx, events, request))
+	require.ErrorContains(t, trigger.RegisterTrigger(ctx, events, request), "already registered")
+
+	select {
+	case event := <-events:
+		require.NoError(t, event.Err)
+		m := event.Value.(*values.Map)
+		assert.Equal(t, values.NewString("a-trigger"), m.Underlying["triggerId"])
+		assert.Contains(t, m.Underlying, "scheduledTime")
+	case <-ctx.Done():
+		t.Fatal("no event")
+	}
+
+	require.NoError(t, trigger.UnregisterTrigger(ctx, request))
+	require.Error(t, trigger.UnregisterTrigger(ctx, request))
+}
+
+func TestHTTPTrigger(t *testing.T) {
+	ctx := testutils.Context(t)
+	trigger := triggers.NewHTTPTrigger(logger.TestLogger(t))
+
+	request := newRequest(t, "a-workflow", "a-trigger", map[string]any{"externalInitiators": []any{"an-ei"}})
+	events := make(chan capabilities.CapabilityResponse, 1)
+	require.NoError(t, trigger.RegisterTrigger(ctx, events, request))
+
+	_, err := trigger.Trigger(ctx, "another-workflow", "", nil)
+	assert.ErrorIs(t, err, triggers.ErrNoHTTPTrigger)
+	_, err = trigger.Trigger(ctx, "a-workflow", "another-ei", nil)
+	assert.ErrorIs(t, err, triggers.ErrNotAuthorized)
+	_, err = trigger.Trigger(ctx, "a-workflow", "", []byte(`{"a":`))
+	assert.ErrorContains(t, err, "invalid request body")
+	assert.Empty(t, events)
+
+	requestID, err := trigger.Trigger(ctx, "a-workflow", "an-ei", []byte(`{"price": 1.5, "assets": ["eth"]}`))
+	require.NoError(t, err)
+	event := <-events
+	require.NoError(t, event.Err)
+	expected, err := values.NewMap(map[string]any{
+		"triggerId": "a-trigger",
+		"requestId": requestID,
+		"initiator": "an-ei",
+		"body": map[string]any{
+			"price":  decimal.RequireFromString("1.5"),
+			"assets": []any{"eth"},
+		},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, expected, event.Value)
+
+	// users may trigger any workflow
+	_, err = trigger.Trigger(ctx, "a-workflow", "", nil)
+	require.NoError(t, err)
+	event = <-events
+	assert.NotContains(t, event.Value.(*values.Map).Underlying, "body")
+
+	require.NoError(t, trigger.UnregisterTrigger(ctx, request))
+	_, err = trigger.Trigger(ctx, "a-workflow", "", nil)
+	assert.ErrorIs(t, err, triggers.ErrNoHTTPTrigger)
+}
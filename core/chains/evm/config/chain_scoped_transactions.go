@@ -33,3 +33,7 @@ func (t *transactionsConfig) MaxInFlight() uint32 {
 func (t *transactionsConfig) MaxQueued() uint64 {
 	return uint64(*t.c.MaxQueued)
 }
+
+func (t *transactionsConfig) SimulateTransactions() bool {
+	return *t.c.SimulateTransactions
+}
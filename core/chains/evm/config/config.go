@@ -84,6 +84,7 @@ type Transactions interface {
 	ReaperThreshold() time.Duration
 	MaxInFlight() uint32
 	MaxQueued() uint64
+	SimulateTransactions() bool
 }
 
 //go:generate mockery --quiet --name GasEstimator --output ./mocks/ --case=underscore
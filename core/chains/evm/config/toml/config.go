@@ -410,6 +410,7 @@ type Transactions struct {
 	ReaperInterval       *commonconfig.Duration
 	ReaperThreshold      *commonconfig.Duration
 	ResendAfterThreshold *commonconfig.Duration
+	SimulateTransactions *bool
 }
 
 func (t *Transactions) setFrom(f *Transactions) {
@@ -431,6 +432,9 @@ func (t *Transactions) setFrom(f *Transactions) {
 	if v := f.ResendAfterThreshold; v != nil {
 		t.ResendAfterThreshold = v
 	}
+	if v := f.SimulateTransactions; v != nil {
+		t.SimulateTransactions = v
+	}
 }
 
 type OCR2 struct {
@@ -532,9 +536,9 @@ func (e *GasEstimator) ValidateConfig() (err error) {
 		err = multierr.Append(err, commonconfig.ErrInvalid{Name: "PriceMax", Value: e.PriceMin,
 			Msg: "must be greater than or equal to PriceDefault"})
 	}
-	if *e.Mode == "BlockHistory" && *e.BlockHistory.BlockHistorySize <= 0 {
+	if (*e.Mode == "BlockHistory" || *e.Mode == "FeeHistory") && *e.BlockHistory.BlockHistorySize <= 0 {
 		err = multierr.Append(err, commonconfig.ErrInvalid{Name: "BlockHistory.BlockHistorySize", Value: *e.BlockHistory.BlockHistorySize,
-			Msg: "must be greater than or equal to 1 with BlockHistory Mode"})
+			Msg: fmt.Sprintf("must be greater than or equal to 1 with %s Mode", *e.Mode)})
 	}
 
 	return
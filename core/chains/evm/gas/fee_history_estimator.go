@@ -0,0 +1,299 @@
+package gas
+
+import (
+	"context"
+	"math/big"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	pkgerrors "github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/services"
+	"github.com/smartcontractkit/chainlink-common/pkg/utils"
+
+	commonfee "github.com/smartcontractkit/chainlink/v2/common/fee"
+	feetypes "github.com/smartcontractkit/chainlink/v2/common/fee/types"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/assets"
+	evmclient "github.com/smartcontractkit/chainlink/v2/core/chains/evm/client"
+	evmtypes "github.com/smartcontractkit/chainlink/v2/core/chains/evm/types"
+)
+
+var (
+	_ EvmEstimator = &FeeHistoryEstimator{}
+)
+
+type feeHistoryEstimatorConfig interface {
+	EIP1559DynamicFees() bool
+	BumpThreshold() uint64
+	FeeCapDefault() *assets.Wei
+	LimitMultiplier() float32
+	PriceDefault() *assets.Wei
+	PriceMin() *assets.Wei
+	TipCapMin() *assets.Wei
+	bumpConfig
+}
+
+type feeHistoryEstimatorBlockHistoryConfig interface {
+	BlockHistorySize() uint16
+	EIP1559FeeCapBufferBlocks() uint16
+	TransactionPercentile() uint16
+}
+
+// feeHistoryResult is the response of eth_feeHistory.
+type feeHistoryResult struct {
+	OldestBlock hexutil.Big `json:"oldestBlock"`
+	// BaseFeePerGas has one more element than the requested blocks: the base fee of the next block.
+	BaseFeePerGas []hexutil.Big   `json:"baseFeePerGas"`
+	GasUsedRatio  []float64       `json:"gasUsedRatio"`
+	Reward        [][]hexutil.Big `json:"reward"`
+}
+
+// FeeHistoryEstimator is an Estimator which uses the reward percentiles and the next block base fee from eth_feeHistory.
+// It needs a single RPC call per refresh no matter how many blocks it looks at, where BlockHistoryEstimator downloads
+// every block. Until the first refresh, and whenever a refresh fails, it falls back to PriceDefault and TipCapDefault,
+// with FeeCapDefault as fee cap, like FixedPriceEstimator.
+type FeeHistoryEstimator struct {
+	services.StateMachine
+
+	cfg        feeHistoryEstimatorConfig
+	bhCfg      feeHistoryEstimatorBlockHistoryConfig
+	client     rpcClient
+	pollPeriod time.Duration
+	logger     logger.SugaredLogger
+
+	pricesMu sync.RWMutex
+	gasPrice *assets.Wei
+	tipCap   *assets.Wei
+	baseFee  *assets.Wei
+
+	chForceRefetch chan (chan struct{})
+	chInitialised  chan struct{}
+	chStop         services.StopChan
+	chDone         chan struct{}
+}
+
+// NewFeeHistoryEstimator returns a new Estimator which uses eth_feeHistory over the last BlockHistorySize blocks,
+// taking the TransactionPercentile of their rewards as tip.
+func NewFeeHistoryEstimator(lggr logger.Logger, client rpcClient, cfg feeHistoryEstimatorConfig, bhCfg feeHistoryEstimatorBlockHistoryConfig) EvmEstimator {
+	return &FeeHistoryEstimator{
+		client:         client,
+		pollPeriod:     10 * time.Second,
+		logger:         logger.Sugared(logger.Named(lggr, "FeeHistoryEstimator")),
+		cfg:            cfg,
+		bhCfg:          bhCfg,
+		gasPrice:       cfg.PriceDefault(),
+		tipCap:         cfg.TipCapDefault(),
+		chForceRefetch: make(chan (chan struct{})),
+		chInitialised:  make(chan struct{}),
+		chStop:         make(chan struct{}),
+		chDone:         make(chan struct{}),
+	}
+}
+
+func (f *FeeHistoryEstimator) Name() string {
+	return f.logger.Name()
+}
+
+func (f *FeeHistoryEstimator) Start(context.Context) error {
+	return f.StartOnce("FeeHistoryEstimator", func() error {
+		go f.run()
+		<-f.chInitialised
+		return nil
+	})
+}
+
+func (f *FeeHistoryEstimator) Close() error {
+	return f.StopOnce("FeeHistoryEstimator", func() error {
+		close(f.chStop)
+		<-f.chDone
+		return nil
+	})
+}
+
+func (f *FeeHistoryEstimator) HealthReport() map[string]error {
+	return map[string]error{f.Name(): f.Healthy()}
+}
+
+func (f *FeeHistoryEstimator) run() {
+	defer close(f.chDone)
+
+	t := f.refreshPrices()
+	close(f.chInitialised)
+
+	for {
+		select {
+		case <-f.chStop:
+			return
+		case ch := <-f.chForceRefetch:
+			t.Stop()
+			t = f.refreshPrices()
+			close(ch)
+		case <-t.C:
+			t = f.refreshPrices()
+		}
+	}
+}
+
+func (f *FeeHistoryEstimator) refreshPrices() (t *time.Timer) {
+	t = time.NewTimer(utils.WithJitter(f.pollPeriod))
+
+	ctx, cancel := f.chStop.CtxCancel(evmclient.ContextWithDefaultTimeout())
+	defer cancel()
+
+	percentile := f.bhCfg.TransactionPercentile()
+	var res feeHistoryResult
+	if err := f.client.CallContext(ctx, &res, "eth_feeHistory", hexutil.Uint(f.bhCfg.BlockHistorySize()), "latest", []float64{float64(percentile)}); err != nil {
+		f.logger.Warnf("Failed to refresh prices, got error: %s. Using EVM.GasEstimator.PriceDefault and TipCapDefault as fallback", err)
+		f.setDefaultPrices()
+		return
+	}
+	if len(res.BaseFeePerGas) == 0 {
+		f.logger.Warnw("Failed to refresh prices, eth_feeHistory returned no base fees. Using EVM.GasEstimator.PriceDefault and TipCapDefault as fallback", "oldestBlock", res.OldestBlock.String())
+		f.setDefaultPrices()
+		return
+	}
+
+	// empty blocks report a zero reward, which says nothing about the tip needed to be included
+	var rewards []*big.Int
+	for i, blockRewards := range res.Reward {
+		if len(blockRewards) == 0 || (i < len(res.GasUsedRatio) && res.GasUsedRatio[i] == 0) {
+			continue
+		}
+		rewards = append(rewards, blockRewards[0].ToInt())
+	}
+	tipCap := assets.NewWeiI(0)
+	if len(rewards) > 0 {
+		slices.SortFunc(rewards, func(a, b *big.Int) int { return a.Cmp(b) })
+		tipCap = assets.NewWei(rewards[(len(rewards)-1)*int(percentile)/100])
+	}
+	baseFee := assets.NewWei(res.BaseFeePerGas[len(res.BaseFeePerGas)-1].ToInt())
+	gasPrice := baseFee.Add(tipCap)
+
+	f.logger.Debugw("refreshPrices", "oldestBlock", res.OldestBlock.String(), "baseFee", baseFee, "tipCap", tipCap, "gasPrice", gasPrice)
+
+	f.pricesMu.Lock()
+	defer f.pricesMu.Unlock()
+	f.baseFee = baseFee
+	f.tipCap = f.clamp("gas tip cap", "TipCapMin", tipCap, f.cfg.TipCapMin())
+	f.gasPrice = f.clamp("gas price", "PriceMin", gasPrice, f.cfg.PriceMin())
+	return
+}
+
+// setDefaultPrices replaces the estimated prices with the configured defaults. Without a base fee, dynamic fees use
+// FeeCapDefault as fee cap.
+func (f *FeeHistoryEstimator) setDefaultPrices() {
+	f.pricesMu.Lock()
+	defer f.pricesMu.Unlock()
+	f.gasPrice = f.cfg.PriceDefault()
+	f.tipCap = f.cfg.TipCapDefault()
+	f.baseFee = nil
+}
+
+// clamp bounds price to [min, PriceMax].
+func (f *FeeHistoryEstimator) clamp(name, minName string, price, min *assets.Wei) *assets.Wei {
+	max := f.cfg.PriceMax()
+	if price.Cmp(max) > 0 {
+		f.logger.Warnf("Calculated %s of %s exceeds EVM.GasEstimator.PriceMax=%s, using the maximum instead", name, price, max)
+		return max
+	} else if price.Cmp(min) < 0 {
+		f.logger.Debugf("Calculated %s of %s falls below EVM.GasEstimator.%s=%s, using the minimum instead", name, price, minName, min)
+		return min
+	}
+	return price
+}
+
+// Uses the force refetch chan to trigger a price update and blocks until complete
+func (f *FeeHistoryEstimator) forceRefresh(ctx context.Context) (err error) {
+	ch := make(chan struct{})
+	select {
+	case f.chForceRefetch <- ch:
+	case <-f.chStop:
+		return pkgerrors.New("estimator stopped")
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+	select {
+	case <-ch:
+	case <-f.chStop:
+		return pkgerrors.New("estimator stopped")
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+	return
+}
+
+func (f *FeeHistoryEstimator) getPrices() (gasPrice, tipCap, baseFee *assets.Wei) {
+	f.pricesMu.RLock()
+	defer f.pricesMu.RUnlock()
+	return f.gasPrice, f.tipCap, f.baseFee
+}
+
+func (f *FeeHistoryEstimator) OnNewLongestChain(context.Context, *evmtypes.Head) {}
+
+func (f *FeeHistoryEstimator) GetLegacyGas(ctx context.Context, _ []byte, gasLimit uint64, maxGasPriceWei *assets.Wei, opts ...feetypes.Opt) (gasPrice *assets.Wei, chainSpecificGasLimit uint64, err error) {
+	ok := f.IfStarted(func() {
+		if slices.Contains(opts, feetypes.OptForceRefetch) {
+			err = f.forceRefresh(ctx)
+		}
+		gasPrice, _, _ = f.getPrices()
+	})
+	if !ok {
+		return nil, 0, pkgerrors.New("FeeHistoryEstimator is not started; cannot estimate gas")
+	} else if err != nil {
+		return nil, 0, err
+	}
+	if gasPrice == nil {
+		return nil, 0, pkgerrors.New("failed to estimate gas; gas price not set")
+	}
+	gasPrice = capGasPrice(gasPrice, maxGasPriceWei, f.cfg.PriceMax())
+	chainSpecificGasLimit, err = commonfee.ApplyMultiplier(gasLimit, f.cfg.LimitMultiplier())
+	return
+}
+
+func (f *FeeHistoryEstimator) BumpLegacyGas(_ context.Context, originalGasPrice *assets.Wei, gasLimit uint64, maxGasPriceWei *assets.Wei, _ []EvmPriorAttempt) (bumpedGasPrice *assets.Wei, chainSpecificGasLimit uint64, err error) {
+	gasPrice, _, _ := f.getPrices()
+	return BumpLegacyGasPriceOnly(f.cfg, f.logger, gasPrice, originalGasPrice, gasLimit, maxGasPriceWei)
+}
+
+func (f *FeeHistoryEstimator) GetDynamicFee(_ context.Context, gasLimit uint64, maxGasPriceWei *assets.Wei) (fee DynamicFee, chainSpecificGasLimit uint64, err error) {
+	if !f.cfg.EIP1559DynamicFees() {
+		return fee, 0, pkgerrors.New("Can't get dynamic fee, EIP1559 is disabled")
+	}
+
+	var tipCap, baseFee *assets.Wei
+	ok := f.IfStarted(func() {
+		_, tipCap, baseFee = f.getPrices()
+	})
+	if !ok {
+		return fee, 0, pkgerrors.New("FeeHistoryEstimator is not started; cannot estimate gas")
+	}
+	if tipCap == nil {
+		return fee, 0, pkgerrors.New("failed to estimate gas; fee history not set")
+	}
+	chainSpecificGasLimit, err = commonfee.ApplyMultiplier(gasLimit, f.cfg.LimitMultiplier())
+	if err != nil {
+		return fee, 0, err
+	}
+
+	maxGasPrice := getMaxGasPrice(maxGasPriceWei, f.cfg.PriceMax())
+	if f.cfg.BumpThreshold() == 0 {
+		// just use the max gas price if gas bumping is disabled
+		fee.FeeCap = maxGasPrice
+	} else if baseFee == nil {
+		// without fee history, leave headroom for bumping like FixedPriceEstimator
+		fee.FeeCap = f.cfg.FeeCapDefault()
+	} else {
+		// leave headroom for the base fee to rise until the transaction would be bumped, see calcFeeCap
+		fee.FeeCap = calcFeeCap(baseFee, int(f.bhCfg.EIP1559FeeCapBufferBlocks()), tipCap, maxGasPrice)
+	}
+	fee.TipCap = tipCap
+	return
+}
+
+func (f *FeeHistoryEstimator) BumpDynamicFee(_ context.Context, originalFee DynamicFee, originalGasLimit uint64, maxGasPriceWei *assets.Wei, _ []EvmPriorAttempt) (bumped DynamicFee, chainSpecificGasLimit uint64, err error) {
+	_, tipCap, baseFee := f.getPrices()
+	return BumpDynamicFeeOnly(f.cfg, f.bhCfg.EIP1559FeeCapBufferBlocks(), f.logger, tipCap, baseFee, originalFee, originalGasLimit, maxGasPriceWei)
+}
@@ -0,0 +1,166 @@
+package gas_test
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/services/servicetest"
+
+	feetypes "github.com/smartcontractkit/chainlink/v2/common/fee/types"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/assets"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/gas"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/gas/mocks"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+)
+
+// the second block is empty, so its reward is ignored
+const feeHistoryResponse = `{
+	"oldestBlock": "0x10",
+	"baseFeePerGas": ["0xa", "0xb", "0xc", "0xd", "0xe"],
+	"gasUsedRatio": [0.5, 0, 0.5, 0.5],
+	"reward": [["0x5"], ["0x0"], ["0x3"], ["0x7"]]
+}`
+
+func mockFeeHistory(client *mocks.RPCClient, response string) *mock.Call {
+	return client.On("CallContext", mock.Anything, mock.Anything, "eth_feeHistory", hexutil.Uint(4), "latest", []float64{50}).Return(nil).Run(func(args mock.Arguments) {
+		if err := json.Unmarshal([]byte(response), args.Get(1)); err != nil {
+			panic(err)
+		}
+	})
+}
+
+func TestFeeHistoryEstimator(t *testing.T) {
+	t.Parallel()
+
+	maxGasPrice := assets.NewWeiI(100)
+	const gasLimit uint64 = 80000
+
+	cfg := &gas.MockGasEstimatorConfig{
+		EIP1559DynamicFeesF: true,
+		BumpPercentF:        10,
+		BumpMinF:            assets.NewWeiI(1),
+		BumpThresholdF:      1,
+		LimitMultiplierF:    1,
+		PriceMaxF:           assets.NewWeiI(1000),
+		PriceMinF:           assets.NewWeiI(1),
+		TipCapMinF:          assets.NewWeiI(1),
+		TipCapDefaultF:      assets.NewWeiI(1),
+		PriceDefaultF:       assets.NewWeiI(30),
+		FeeCapDefaultF:      assets.NewWeiI(50),
+	}
+	bhCfg := &gas.MockBlockHistoryConfig{BlockHistorySizeF: 4, TransactionPercentileF: 50}
+
+	t.Run("calling GetLegacyGas on unstarted estimator returns error", func(t *testing.T) {
+		client := mocks.NewRPCClient(t)
+		o := gas.NewFeeHistoryEstimator(logger.Test(t), client, cfg, bhCfg)
+		_, _, err := o.GetLegacyGas(testutils.Context(t), nil, gasLimit, maxGasPrice)
+		assert.EqualError(t, err, "FeeHistoryEstimator is not started; cannot estimate gas")
+	})
+
+	t.Run("uses the percentile reward on top of the next block base fee", func(t *testing.T) {
+		client := mocks.NewRPCClient(t)
+		mockFeeHistory(client, feeHistoryResponse)
+
+		o := gas.NewFeeHistoryEstimator(logger.Test(t), client, cfg, bhCfg)
+		servicetest.RunHealthy(t, o)
+
+		gasPrice, chainSpecificGasLimit, err := o.GetLegacyGas(testutils.Context(t), nil, gasLimit, maxGasPrice)
+		require.NoError(t, err)
+		assert.Equal(t, assets.NewWeiI(19), gasPrice)
+		assert.Equal(t, gasLimit, chainSpecificGasLimit)
+
+		fee, chainSpecificGasLimit, err := o.GetDynamicFee(testutils.Context(t), gasLimit, maxGasPrice)
+		require.NoError(t, err)
+		assert.Equal(t, assets.NewWeiI(5), fee.TipCap)
+		assert.Equal(t, assets.NewWeiI(19), fee.FeeCap)
+		assert.Equal(t, gasLimit, chainSpecificGasLimit)
+
+		// caps the gas price to the user specified maximum
+		gasPrice, _, err = o.GetLegacyGas(testutils.Context(t), nil, gasLimit, assets.NewWeiI(15))
+		require.NoError(t, err)
+		assert.Equal(t, assets.NewWeiI(15), gasPrice)
+	})
+
+	t.Run("projects the fee cap over the buffer blocks", func(t *testing.T) {
+		client := mocks.NewRPCClient(t)
+		mockFeeHistory(client, feeHistoryResponse)
+
+		bhCfg := &gas.MockBlockHistoryConfig{BlockHistorySizeF: 4, TransactionPercentileF: 50, EIP1559FeeCapBufferBlocksF: 2}
+		o := gas.NewFeeHistoryEstimator(logger.Test(t), client, cfg, bhCfg)
+		servicetest.RunHealthy(t, o)
+
+		fee, _, err := o.GetDynamicFee(testutils.Context(t), gasLimit, maxGasPrice)
+		require.NoError(t, err)
+		// 14 * 1.125^2 + 5
+		assert.Equal(t, assets.NewWeiI(22), fee.FeeCap)
+	})
+
+	t.Run("uses TipCapMin when all blocks are empty", func(t *testing.T) {
+		client := mocks.NewRPCClient(t)
+		mockFeeHistory(client, `{"oldestBlock": "0x10", "baseFeePerGas": ["0xa", "0xa", "0xa", "0xa", "0xa"], "gasUsedRatio": [0, 0, 0, 0], "reward": [["0x0"], ["0x0"], ["0x0"], ["0x0"]]}`)
+
+		o := gas.NewFeeHistoryEstimator(logger.Test(t), client, cfg, bhCfg)
+		servicetest.RunHealthy(t, o)
+
+		fee, _, err := o.GetDynamicFee(testutils.Context(t), gasLimit, maxGasPrice)
+		require.NoError(t, err)
+		assert.Equal(t, assets.NewWeiI(1), fee.TipCap)
+	})
+
+	t.Run("falls back to the defaults if eth_feeHistory failed", func(t *testing.T) {
+		client := mocks.NewRPCClient(t)
+		client.On("CallContext", mock.Anything, mock.Anything, "eth_feeHistory", mock.Anything, mock.Anything, mock.Anything).Return(errors.New("kaboom"))
+
+		o := gas.NewFeeHistoryEstimator(logger.Test(t), client, cfg, bhCfg)
+		servicetest.RunHealthy(t, o)
+
+		gasPrice, _, err := o.GetLegacyGas(testutils.Context(t), nil, gasLimit, maxGasPrice)
+		require.NoError(t, err)
+		assert.Equal(t, assets.NewWeiI(30), gasPrice)
+		fee, _, err := o.GetDynamicFee(testutils.Context(t), gasLimit, maxGasPrice)
+		require.NoError(t, err)
+		assert.Equal(t, assets.NewWeiI(1), fee.TipCap)
+		assert.Equal(t, assets.NewWeiI(50), fee.FeeCap)
+	})
+
+	t.Run("falls back to the defaults if a later refresh failed", func(t *testing.T) {
+		client := mocks.NewRPCClient(t)
+		mockFeeHistory(client, feeHistoryResponse).Once()
+		client.On("CallContext", mock.Anything, mock.Anything, "eth_feeHistory", mock.Anything, mock.Anything, mock.Anything).Return(errors.New("kaboom"))
+
+		o := gas.NewFeeHistoryEstimator(logger.Test(t), client, cfg, bhCfg)
+		servicetest.RunHealthy(t, o)
+
+		gasPrice, _, err := o.GetLegacyGas(testutils.Context(t), nil, gasLimit, maxGasPrice)
+		require.NoError(t, err)
+		assert.Equal(t, assets.NewWeiI(19), gasPrice)
+
+		gasPrice, _, err = o.GetLegacyGas(testutils.Context(t), nil, gasLimit, maxGasPrice, feetypes.OptForceRefetch)
+		require.NoError(t, err)
+		assert.Equal(t, assets.NewWeiI(30), gasPrice)
+	})
+
+	t.Run("bumps from the latest estimate", func(t *testing.T) {
+		client := mocks.NewRPCClient(t)
+		mockFeeHistory(client, feeHistoryResponse)
+
+		o := gas.NewFeeHistoryEstimator(logger.Test(t), client, cfg, bhCfg)
+		servicetest.RunHealthy(t, o)
+
+		// the original price bumped by 10% is below the current estimate
+		gasPrice, _, err := o.BumpLegacyGas(testutils.Context(t), assets.NewWeiI(10), gasLimit, maxGasPrice, nil)
+		require.NoError(t, err)
+		assert.Equal(t, assets.NewWeiI(19), gasPrice)
+
+		fee, _, err := o.BumpDynamicFee(testutils.Context(t), gas.DynamicFee{TipCap: assets.NewWeiI(2), FeeCap: assets.NewWeiI(20)}, gasLimit, maxGasPrice, nil)
+		require.NoError(t, err)
+		assert.Equal(t, assets.NewWeiI(5), fee.TipCap)
+	})
+}
@@ -19,6 +19,7 @@ import (
 	evmtypes "github.com/smartcontractkit/chainlink/v2/core/chains/evm/types"
 )
 
+// TxAttemptSigner signs the transactions of attempts, e.g. the keystore or a RemoteSigner.
 type TxAttemptSigner[ADDR commontypes.Hashable] interface {
 	SignTx(ctx context.Context, fromAddress ADDR, tx *types.Transaction, chainID *big.Int) (*types.Transaction, error)
 }
@@ -28,7 +29,7 @@ var _ TxAttemptBuilder = (*evmTxAttemptBuilder)(nil)
 type evmTxAttemptBuilder struct {
 	chainID   big.Int
 	feeConfig evmTxAttemptBuilderFeeConfig
-	keystore  TxAttemptSigner[common.Address]
+	signer    TxAttemptSigner[common.Address]
 	gas.EvmFeeEstimator
 }
 
@@ -39,8 +40,8 @@ type evmTxAttemptBuilderFeeConfig interface {
 	PriceMaxKey(common.Address) *assets.Wei
 }
 
-func NewEvmTxAttemptBuilder(chainID big.Int, feeConfig evmTxAttemptBuilderFeeConfig, keystore TxAttemptSigner[common.Address], estimator gas.EvmFeeEstimator) *evmTxAttemptBuilder {
-	return &evmTxAttemptBuilder{chainID, feeConfig, keystore, estimator}
+func NewEvmTxAttemptBuilder(chainID big.Int, feeConfig evmTxAttemptBuilderFeeConfig, signer TxAttemptSigner[common.Address], estimator gas.EvmFeeEstimator) *evmTxAttemptBuilder {
+	return &evmTxAttemptBuilder{chainID, feeConfig, signer, estimator}
 }
 
 // NewTxAttempt builds an new attempt using the configured fee estimator + using the EIP1559 config to determine tx type
@@ -302,7 +303,7 @@ func newLegacyTransaction(nonce uint64, to common.Address, value *big.Int, gasLi
 }
 
 func (c *evmTxAttemptBuilder) SignTx(ctx context.Context, address common.Address, tx *types.Transaction) (common.Hash, []byte, error) {
-	signedTx, err := c.keystore.SignTx(ctx, address, tx, &c.chainID)
+	signedTx, err := c.signer.SignTx(ctx, address, tx, &c.chainID)
 	if err != nil {
 		return common.Hash{}, nil, fmt.Errorf("failed to sign tx: %w", err)
 	}
@@ -43,15 +43,16 @@ func NewTxm(
 	} else {
 		lggr.Info("EvmForwarderManager: Disabled")
 	}
-	checker := &CheckerFactory{Client: client}
+	contractABIs := NewContractABIs()
+	checker := &CheckerFactory{Client: client, SimulateTransactions: txConfig.SimulateTransactions(), ContractABIs: contractABIs}
 	// create tx attempt builder
 	txAttemptBuilder := NewEvmTxAttemptBuilder(*client.ConfiguredChainID(), fCfg, keyStore, estimator)
 	txStore := NewTxStore(sqlxDB, lggr, dbConfig)
 	txNonceSyncer := NewNonceSyncer(txStore, lggr, client)
 
-	txmCfg := NewEvmTxmConfig(chainConfig) // wrap Evm specific config
-	feeCfg := NewEvmTxmFeeConfig(fCfg)     // wrap Evm specific config
-	txmClient := NewEvmTxmClient(client)   // wrap Evm specific client
+	txmCfg := NewEvmTxmConfig(chainConfig)             // wrap Evm specific config
+	feeCfg := NewEvmTxmFeeConfig(fCfg)                 // wrap Evm specific config
+	txmClient := NewEvmTxmClient(client, contractABIs) // wrap Evm specific client
 	chainID := txmClient.ConfiguredChainID()
 	evmBroadcaster := NewEvmBroadcaster(txStore, txmClient, txmCfg, feeCfg, txConfig, listenerConfig, keyStore, txAttemptBuilder, txNonceSyncer, lggr, checker, chainConfig.NonceAutoSync())
 	evmTracker := NewEvmTracker(txStore, keyStore, chainID, lggr)
@@ -60,10 +61,23 @@ func NewTxm(
 	if txConfig.ResendAfterThreshold() > 0 {
 		evmResender = NewEvmResender(lggr, txStore, txmClient, evmTracker, keyStore, txmgr.DefaultResenderPollInterval, chainConfig, txConfig)
 	}
-	txm = NewEvmTxm(chainID, txmCfg, txConfig, keyStore, lggr, checker, fwdMgr, txAttemptBuilder, txStore, txNonceSyncer, evmBroadcaster, evmConfirmer, evmResender, evmTracker)
+	txm = &evmTxm{
+		Txm:          NewEvmTxm(chainID, txmCfg, txConfig, keyStore, lggr, checker, fwdMgr, txAttemptBuilder, txStore, txNonceSyncer, evmBroadcaster, evmConfirmer, evmResender, evmTracker),
+		contractABIs: contractABIs,
+	}
 	return txm, nil
 }
 
+// evmTxm is a Txm which decodes the custom errors of the contracts registered in its ContractABIs.
+type evmTxm struct {
+	*Txm
+	contractABIs *ContractABIs
+}
+
+var _ ContractABIRegistry = &evmTxm{}
+
+func (t *evmTxm) ContractABIs() *ContractABIs { return t.contractABIs }
+
 // NewEvmTxm creates a new concrete EvmTxm
 func NewEvmTxm(
 	chainId *big.Int,
@@ -17,6 +17,7 @@ import (
 	"github.com/smartcontractkit/chainlink-common/pkg/utils"
 
 	commonclient "github.com/smartcontractkit/chainlink/v2/common/client"
+	"github.com/smartcontractkit/chainlink/v2/common/txmgr"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/client"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/gas"
 	evmtypes "github.com/smartcontractkit/chainlink/v2/core/chains/evm/types"
@@ -25,11 +26,13 @@ import (
 var _ TxmClient = (*evmTxmClient)(nil)
 
 type evmTxmClient struct {
-	client client.Client
+	client       client.Client
+	contractABIs *ContractABIs
 }
 
-func NewEvmTxmClient(c client.Client) *evmTxmClient {
-	return &evmTxmClient{client: c}
+// NewEvmTxmClient wraps c for the Txm. Reverts are decoded with the ABIs registered in contractABIs, which may be nil.
+func NewEvmTxmClient(c client.Client, contractABIs *ContractABIs) *evmTxmClient {
+	return &evmTxmClient{client: c, contractABIs: contractABIs}
 }
 
 func (c *evmTxmClient) PendingSequenceAt(ctx context.Context, addr common.Address) (evmtypes.Nonce, error) {
@@ -179,5 +182,9 @@ func (c *evmTxmClient) CallContract(ctx context.Context, a TxAttempt, blockNumbe
 		Data:       a.Tx.EncodedPayload,
 		AccessList: nil,
 	}, blockNumber)
-	return client.ExtractRPCError(errCall)
+	jErr, err := client.ExtractRPCError(errCall)
+	if err != nil {
+		return nil, err
+	}
+	return &txmgr.RevertError{Reason: revertReason(jErr, a.Tx.ToAddress, c.contractABIs), Err: jErr}, nil
 }
@@ -167,8 +167,9 @@ type DbEthTx struct {
 	Value          assets.Eth
 	// GasLimit on the EthTx is always the conceptual gas limit, which is not
 	// necessarily the same as the on-chain encoded value (i.e. Optimism)
-	GasLimit uint64
-	Error    nullv4.String
+	GasLimit     uint64
+	Error        nullv4.String
+	RevertReason nullv4.String
 	// BroadcastAt is updated every time an attempt for this eth_tx is re-sent
 	// In almost all cases it will be within a second or so of the actual send time.
 	BroadcastAt *time.Time
@@ -202,6 +203,7 @@ func (db *DbEthTx) FromTx(tx *Tx) {
 	db.Value = assets.Eth(tx.Value)
 	db.GasLimit = tx.FeeLimit
 	db.Error = tx.Error
+	db.RevertReason = tx.RevertReason
 	db.BroadcastAt = tx.BroadcastAt
 	db.CreatedAt = tx.CreatedAt
 	db.State = tx.State
@@ -236,6 +238,7 @@ func (db DbEthTx) ToTx(tx *Tx) {
 	tx.Value = *db.Value.ToInt()
 	tx.FeeLimit = db.GasLimit
 	tx.Error = db.Error
+	tx.RevertReason = db.RevertReason
 	tx.BroadcastAt = db.BroadcastAt
 	tx.CreatedAt = db.CreatedAt
 	tx.State = db.State
@@ -527,8 +530,8 @@ func (o *evmTxStore) InsertTx(etx *Tx) error {
 	if etx.CreatedAt == (time.Time{}) {
 		etx.CreatedAt = time.Now()
 	}
-	const insertEthTxSQL = `INSERT INTO evm.txes (nonce, from_address, to_address, encoded_payload, value, gas_limit, error, broadcast_at, initial_broadcast_at, created_at, state, meta, subject, pipeline_task_run_id, min_confirmations, evm_chain_id, transmit_checker, idempotency_key, signal_callback, callback_completed) VALUES (
-:nonce, :from_address, :to_address, :encoded_payload, :value, :gas_limit, :error, :broadcast_at, :initial_broadcast_at, :created_at, :state, :meta, :subject, :pipeline_task_run_id, :min_confirmations, :evm_chain_id, :transmit_checker, :idempotency_key, :signal_callback, :callback_completed
+	const insertEthTxSQL = `INSERT INTO evm.txes (nonce, from_address, to_address, encoded_payload, value, gas_limit, error, revert_reason, broadcast_at, initial_broadcast_at, created_at, state, meta, subject, pipeline_task_run_id, min_confirmations, evm_chain_id, transmit_checker, idempotency_key, signal_callback, callback_completed) VALUES (
+:nonce, :from_address, :to_address, :encoded_payload, :value, :gas_limit, :error, :revert_reason, :broadcast_at, :initial_broadcast_at, :created_at, :state, :meta, :subject, :pipeline_task_run_id, :min_confirmations, :evm_chain_id, :transmit_checker, :idempotency_key, :signal_callback, :callback_completed
 ) RETURNING *`
 	var dbTx DbEthTx
 	dbTx.FromTx(etx)
@@ -905,6 +908,16 @@ func (o *evmTxStore) SaveFetchedReceipts(ctx context.Context, r []*evmtypes.Rece
 	return pkgerrors.Wrap(err, "SaveFetchedReceipts failed to save receipts")
 }
 
+// UpdateTxRevertReason saves the decoded reason a mined transaction reverted with
+func (o *evmTxStore) UpdateTxRevertReason(ctx context.Context, etxID int64, revertReason string) error {
+	var cancel context.CancelFunc
+	ctx, cancel = o.mergeContexts(ctx)
+	defer cancel()
+	qq := o.q.WithOpts(pg.WithParentCtx(ctx))
+	_, err := qq.Exec(`UPDATE evm.txes SET revert_reason = $1 WHERE id = $2`, revertReason, etxID)
+	return pkgerrors.Wrap(err, "UpdateTxRevertReason failed to update evm.txes")
+}
+
 // MarkAllConfirmedMissingReceipt
 // It is possible that we can fail to get a receipt for all evm.tx_attempts
 // even though a transaction with this nonce has long since been confirmed (we
@@ -1582,7 +1595,7 @@ func (o *evmTxStore) UpdateTxFatalError(ctx context.Context, etx *Tx) error {
 		}
 		var dbEtx DbEthTx
 		dbEtx.FromTx(etx)
-		err := pkgerrors.Wrap(tx.Get(&dbEtx, `UPDATE evm.txes SET state=$1, error=$2, broadcast_at=NULL, initial_broadcast_at=NULL, nonce=NULL WHERE id=$3 RETURNING *`, etx.State, etx.Error, etx.ID), "saveFatallyErroredTransaction failed to save eth_tx")
+		err := pkgerrors.Wrap(tx.Get(&dbEtx, `UPDATE evm.txes SET state=$1, error=$2, revert_reason=$3, broadcast_at=NULL, initial_broadcast_at=NULL, nonce=NULL WHERE id=$4 RETURNING *`, etx.State, etx.Error, etx.RevertReason, etx.ID), "saveFatallyErroredTransaction failed to save eth_tx")
 		dbEtx.ToTx(etx)
 		return err
 	})
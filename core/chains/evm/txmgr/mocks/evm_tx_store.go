@@ -1444,6 +1444,24 @@ func (_m *EvmTxStore) UpdateTxForRebroadcast(ctx context.Context, etx types.Tx[*
 	return r0
 }
 
+// UpdateTxRevertReason provides a mock function with given fields: ctx, etxID, revertReason
+func (_m *EvmTxStore) UpdateTxRevertReason(ctx context.Context, etxID int64, revertReason string) error {
+	ret := _m.Called(ctx, etxID, revertReason)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateTxRevertReason")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, string) error); ok {
+		r0 = rf(ctx, etxID, revertReason)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // UpdateTxUnstartedToInProgress provides a mock function with given fields: ctx, etx, attempt
 func (_m *EvmTxStore) UpdateTxUnstartedToInProgress(ctx context.Context, etx *types.Tx[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee], attempt *types.TxAttempt[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee]) error {
 	ret := _m.Called(ctx, etx, attempt)
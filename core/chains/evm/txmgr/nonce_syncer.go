@@ -64,7 +64,7 @@ func NewNonceSyncer(
 	lggr = logger.Named(lggr, "NonceSyncer")
 	return &nonceSyncerImpl{
 		txStore: txStore,
-		client:  NewEvmTxmClient(ethClient),
+		client:  NewEvmTxmClient(ethClient, nil),
 		chainID: ethClient.ConfiguredChainID(),
 		logger:  lggr,
 	}
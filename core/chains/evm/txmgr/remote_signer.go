@@ -0,0 +1,154 @@
+package txmgr
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"net/url"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rpc"
+	pkgerrors "github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/keystore"
+)
+
+var _ keystore.Eth = (*RemoteSigner)(nil)
+
+// remoteSignerAccountsTTL is how long the accounts of a remote signer are cached for.
+const remoteSignerAccountsTTL = time.Minute
+
+// RemoteSigner is the keystore of the transactions of a chain whose keys are held by a remote signer, keeping the
+// private keys out of the node. It speaks the Web3Signer compatible JSON-RPC eth_accounts and eth_signTransaction
+// methods over HTTP: the sending keys are the accounts of the remote signer, which signs with them.
+//
+// The accounts are cached for remoteSignerAccountsTTL, and listed again early when asked for an account the remote
+// signer did not have, so that keys added to the remote signer are picked up without a round-trip per transaction.
+type RemoteSigner struct {
+	client *rpc.Client
+
+	mu       sync.Mutex
+	accounts []common.Address
+	listedAt time.Time
+}
+
+// NewRemoteSigner returns a RemoteSigner for the remote signer at u.
+func NewRemoteSigner(u *url.URL) (*RemoteSigner, error) {
+	client, err := rpc.DialHTTP(u.String())
+	if err != nil {
+		return nil, pkgerrors.Wrap(err, "failed to dial remote signer")
+	}
+	return &RemoteSigner{client: client}, nil
+}
+
+// EnabledAddressesForChain returns the accounts of the remote signer, which only signs for its own chain.
+func (s *RemoteSigner) EnabledAddressesForChain(ctx context.Context, chainID *big.Int) ([]common.Address, error) {
+	return s.listAccounts(ctx, false)
+}
+
+// CheckEnabled returns an error unless address is one of the accounts of the remote signer.
+func (s *RemoteSigner) CheckEnabled(ctx context.Context, address common.Address, chainID *big.Int) error {
+	accounts, err := s.listAccounts(ctx, false)
+	if err != nil {
+		return err
+	}
+	if slices.Contains(accounts, address) {
+		return nil
+	}
+	// the account may have been added since the accounts were listed
+	accounts, err = s.listAccounts(ctx, true)
+	if err != nil {
+		return err
+	}
+	if !slices.Contains(accounts, address) {
+		return fmt.Errorf("remote signer has no key for address %s", address)
+	}
+	return nil
+}
+
+// listAccounts returns the cached accounts of the remote signer, listing them again if they are stale or if refresh is
+// set.
+func (s *RemoteSigner) listAccounts(ctx context.Context, refresh bool) ([]common.Address, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if refresh || s.accounts == nil || time.Since(s.listedAt) > remoteSignerAccountsTTL {
+		var accounts []common.Address
+		if err := s.client.CallContext(ctx, &accounts, "eth_accounts"); err != nil {
+			return nil, pkgerrors.Wrap(err, "failed to list the accounts of the remote signer")
+		}
+		if accounts == nil {
+			accounts = []common.Address{}
+		}
+		s.accounts, s.listedAt = accounts, time.Now()
+	}
+	return slices.Clone(s.accounts), nil
+}
+
+// SubscribeToKeyChanges returns a channel which is never signalled: the remote signer does not notify of changes to
+// its accounts, which are picked up once the cached ones expire.
+func (s *RemoteSigner) SubscribeToKeyChanges(ctx context.Context) (ch chan struct{}, unsub func()) {
+	return make(chan struct{}), func() {}
+}
+
+// signTransactionArgs is the unsigned transaction parameter of eth_signTransaction.
+type signTransactionArgs struct {
+	From                 common.Address  `json:"from"`
+	To                   *common.Address `json:"to"`
+	Gas                  hexutil.Uint64  `json:"gas"`
+	GasPrice             *hexutil.Big    `json:"gasPrice,omitempty"`
+	MaxFeePerGas         *hexutil.Big    `json:"maxFeePerGas,omitempty"`
+	MaxPriorityFeePerGas *hexutil.Big    `json:"maxPriorityFeePerGas,omitempty"`
+	Value                *hexutil.Big    `json:"value"`
+	Data                 hexutil.Bytes   `json:"data"`
+	Nonce                hexutil.Uint64  `json:"nonce"`
+	ChainID              *hexutil.Big    `json:"chainId"`
+}
+
+func newSignTransactionArgs(fromAddress common.Address, tx *types.Transaction, chainID *big.Int) signTransactionArgs {
+	args := signTransactionArgs{
+		From:    fromAddress,
+		To:      tx.To(),
+		Gas:     hexutil.Uint64(tx.Gas()),
+		Value:   (*hexutil.Big)(tx.Value()),
+		Data:    tx.Data(),
+		Nonce:   hexutil.Uint64(tx.Nonce()),
+		ChainID: (*hexutil.Big)(chainID),
+	}
+	if tx.Type() == types.DynamicFeeTxType {
+		args.MaxFeePerGas = (*hexutil.Big)(tx.GasFeeCap())
+		args.MaxPriorityFeePerGas = (*hexutil.Big)(tx.GasTipCap())
+	} else {
+		args.GasPrice = (*hexutil.Big)(tx.GasPrice())
+	}
+	return args
+}
+
+// SignTx has the remote signer sign tx with the key of fromAddress, and checks that it signed tx and nothing else.
+func (s *RemoteSigner) SignTx(ctx context.Context, fromAddress common.Address, tx *types.Transaction, chainID *big.Int) (*types.Transaction, error) {
+	var raw hexutil.Bytes
+	if err := s.client.CallContext(ctx, &raw, "eth_signTransaction", newSignTransactionArgs(fromAddress, tx, chainID)); err != nil {
+		return nil, pkgerrors.Wrap(err, "remote signer failed to sign transaction")
+	}
+	signedTx := new(types.Transaction)
+	if err := signedTx.UnmarshalBinary(raw); err != nil {
+		return nil, pkgerrors.Wrap(err, "remote signer returned an invalid transaction")
+	}
+
+	signer := types.LatestSignerForChainID(chainID)
+	if signer.Hash(signedTx) != signer.Hash(tx) {
+		return nil, fmt.Errorf("remote signer returned transaction %s which differs from the transaction to sign", signedTx.Hash())
+	}
+	sender, err := types.Sender(signer, signedTx)
+	if err != nil {
+		return nil, pkgerrors.Wrap(err, "remote signer returned a transaction with an invalid signature")
+	}
+	if sender != fromAddress {
+		return nil, fmt.Errorf("remote signer signed transaction with key %s, expected %s", sender, fromAddress)
+	}
+	return signedTx, nil
+}
@@ -0,0 +1,104 @@
+package txmgr_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	gethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/assets"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/gas"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/txmgr"
+	evmtypes "github.com/smartcontractkit/chainlink/v2/core/chains/evm/types"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+)
+
+func TestRemoteSigner_SignTx(t *testing.T) {
+	t.Parallel()
+
+	chainID := big.NewInt(1337)
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	from := crypto.PubkeyToAddress(key.PublicKey)
+	to := testutils.NewAddress()
+
+	u, fakeSigner := txmgr.NewFakeRemoteSigner(t, chainID, key)
+	signer, err := txmgr.NewRemoteSigner(u)
+	require.NoError(t, err)
+
+	for _, tx := range []*gethtypes.Transaction{
+		gethtypes.NewTx(&gethtypes.LegacyTx{Nonce: 42, To: &to, Value: big.NewInt(142), Gas: 242, GasPrice: big.NewInt(342), Data: []byte{1, 2, 3}}),
+		gethtypes.NewTx(&gethtypes.DynamicFeeTx{ChainID: chainID, Nonce: 42, To: &to, Value: big.NewInt(142), Gas: 242, GasTipCap: big.NewInt(1), GasFeeCap: big.NewInt(342), Data: []byte{1, 2, 3}}),
+	} {
+		signedTx, err := signer.SignTx(testutils.Context(t), from, tx, chainID)
+		require.NoError(t, err)
+		gethSigner := gethtypes.LatestSignerForChainID(chainID)
+		assert.Equal(t, gethSigner.Hash(tx), gethSigner.Hash(signedTx))
+		sender, err := gethtypes.Sender(gethSigner, signedTx)
+		require.NoError(t, err)
+		assert.Equal(t, from, sender)
+	}
+
+	t.Run("lists accounts", func(t *testing.T) {
+		accounts, err := signer.EnabledAddressesForChain(testutils.Context(t), chainID)
+		require.NoError(t, err)
+		assert.Equal(t, []common.Address{from}, accounts)
+		require.NoError(t, signer.CheckEnabled(testutils.Context(t), from, chainID))
+		require.ErrorContains(t, signer.CheckEnabled(testutils.Context(t), to, chainID), "remote signer has no key for address")
+	})
+
+	t.Run("caches accounts", func(t *testing.T) {
+		ctx := testutils.Context(t)
+		_, err := signer.EnabledAddressesForChain(ctx, chainID)
+		require.NoError(t, err)
+		calls := fakeSigner.AccountsCalls()
+		require.NoError(t, signer.CheckEnabled(ctx, from, chainID))
+		_, err = signer.EnabledAddressesForChain(ctx, chainID)
+		require.NoError(t, err)
+		assert.Equal(t, calls, fakeSigner.AccountsCalls())
+
+		// an account added to the remote signer is listed again when asked for
+		newKey, err := crypto.GenerateKey()
+		require.NoError(t, err)
+		fakeSigner.AddKey(newKey)
+		require.NoError(t, signer.CheckEnabled(ctx, crypto.PubkeyToAddress(newKey.PublicKey), chainID))
+		assert.Equal(t, calls+1, fakeSigner.AccountsCalls())
+		accounts, err := signer.EnabledAddressesForChain(ctx, chainID)
+		require.NoError(t, err)
+		assert.Equal(t, []common.Address{from, crypto.PubkeyToAddress(newKey.PublicKey)}, accounts)
+	})
+
+	t.Run("unknown key", func(t *testing.T) {
+		tx := gethtypes.NewTx(&gethtypes.LegacyTx{Nonce: 42, To: &to, Gas: 242, GasPrice: big.NewInt(342)})
+		_, err := signer.SignTx(testutils.Context(t), to, tx, chainID)
+		require.ErrorContains(t, err, "no key for account")
+	})
+
+	t.Run("different chain", func(t *testing.T) {
+		// the remote signer signs for its own chain, which must not be accepted for another one
+		tx := gethtypes.NewTx(&gethtypes.LegacyTx{Nonce: 42, To: &to, Gas: 242, GasPrice: big.NewInt(342)})
+		_, err := signer.SignTx(testutils.Context(t), from, tx, big.NewInt(1))
+		require.Error(t, err)
+	})
+
+	t.Run("builds attempts", func(t *testing.T) {
+		feeCfg := newFeeConfig()
+		feeCfg.priceMax = assets.NewWeiI(342)
+		cks := txmgr.NewEvmTxAttemptBuilder(*chainID, feeCfg, signer, nil)
+		n := evmtypes.Nonce(7)
+		tx := txmgr.Tx{FromAddress: from, ToAddress: to, EncodedPayload: []byte{1, 2, 3}, Sequence: &n}
+		attempt, _, err := cks.NewCustomTxAttempt(testutils.Context(t), tx, gas.EvmFee{DynamicTipCap: assets.NewWeiI(1), DynamicFeeCap: assets.NewWeiI(342)}, 242, 0x2, logger.Test(t))
+		require.NoError(t, err)
+		signedTx, err := txmgr.GetGethSignedTx(attempt.SignedRawTx)
+		require.NoError(t, err)
+		assert.Equal(t, attempt.Hash, signedTx.Hash())
+		sender, err := gethtypes.Sender(gethtypes.LatestSignerForChainID(chainID), signedTx)
+		require.NoError(t, err)
+		assert.Equal(t, from, sender)
+	})
+}
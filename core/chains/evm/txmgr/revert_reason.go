@@ -0,0 +1,153 @@
+package txmgr
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	evmclient "github.com/smartcontractkit/chainlink/v2/core/chains/evm/client"
+)
+
+// ContractABIRegistry is implemented by the TxManagers which decode the custom errors of the contracts registered
+// with them, like the ones built by NewTxm.
+type ContractABIRegistry interface {
+	ContractABIs() *ContractABIs
+}
+
+// ContractABIs holds the ABIs of the contracts deployed on a chain, so that the custom errors transactions to them
+// revert with can be decoded into revert reasons. Every Txm has its own.
+type ContractABIs struct {
+	mu        sync.RWMutex
+	byAddress map[common.Address]*registeredABI
+}
+
+type registeredABI struct {
+	abi  abi.ABI
+	refs int
+}
+
+func NewContractABIs() *ContractABIs {
+	return &ContractABIs{byAddress: make(map[common.Address]*registeredABI)}
+}
+
+// Register registers the ABI of the contract deployed at address. Registering an address again replaces its ABI, and
+// must be matched by another call to Unregister.
+func (c *ContractABIs) Register(address common.Address, contractABI abi.ABI) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	r, ok := c.byAddress[address]
+	if !ok {
+		r = &registeredABI{}
+		c.byAddress[address] = r
+	}
+	r.abi = contractABI
+	r.refs++
+}
+
+// Unregister forgets the ABI of the contract deployed at address, once every registration of it is unregistered.
+func (c *ContractABIs) Unregister(address common.Address) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	r, ok := c.byAddress[address]
+	if !ok {
+		return
+	}
+	if r.refs--; r.refs <= 0 {
+		delete(c.byAddress, address)
+	}
+}
+
+func (c *ContractABIs) get(address common.Address) (abi.ABI, bool) {
+	if c == nil {
+		return abi.ABI{}, false
+	}
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	r, ok := c.byAddress[address]
+	if !ok {
+		return abi.ABI{}, false
+	}
+	return r.abi, true
+}
+
+// executionReverted tells whether jErr is the error of an execution which reverted: it carries revert data, or the
+// execution reverted code or message, as opposed to another error of the RPC, e.g. a rate limit or "header not found",
+// which tells nothing about the outcome of the transaction.
+func executionReverted(jErr *evmclient.JsonError) bool {
+	if _, ok := revertData(jErr); ok {
+		return true
+	}
+	// geth's code of execution reverted errors
+	if jErr.Code == 3 {
+		return true
+	}
+	message := strings.ToLower(jErr.Message)
+	return strings.Contains(message, "revert") || strings.Contains(message, "vm execution error")
+}
+
+// revertData returns the data carried by a revert, if any.
+func revertData(jErr *evmclient.JsonError) ([]byte, bool) {
+	data, ok := jErr.Data.(string)
+	if !ok {
+		return nil, false
+	}
+	// some RPCs prefix the data, e.g. "Reverted 0x..."
+	i := strings.Index(data, "0x")
+	if i < 0 {
+		return nil, false
+	}
+	b, err := hexutil.Decode(data[i:])
+	if err != nil || len(b) == 0 {
+		return nil, false
+	}
+	return b, true
+}
+
+// revertReason decodes the Error(string), Panic(uint256) or custom error of the contract at to carried in the data of
+// a revert, falling back to the message of the error, followed by the undecoded data if there is any. Custom errors are
+// decoded with the ABI registered in contractABIs, which may be nil.
+func revertReason(jErr *evmclient.JsonError, to common.Address, contractABIs *ContractABIs) string {
+	b, ok := revertData(jErr)
+	if !ok {
+		return jErr.Message
+	}
+	if reason, err := abi.UnpackRevert(b); err == nil {
+		return reason
+	}
+	if reason, ok := customErrorReason(contractABIs, to, b); ok {
+		return reason
+	}
+	return fmt.Sprintf("%s: %s", jErr.Message, hexutil.Encode(b))
+}
+
+// customErrorReason decodes data as one of the errors of the ABI registered for the contract at to, e.g.
+// "InsufficientBalance(10, 20)".
+func customErrorReason(contractABIs *ContractABIs, to common.Address, data []byte) (string, bool) {
+	if len(data) < 4 {
+		return "", false
+	}
+	contractABI, ok := contractABIs.get(to)
+	if !ok {
+		return "", false
+	}
+	for _, abiErr := range contractABI.Errors {
+		if !bytes.Equal(data[:4], abiErr.ID[:4]) {
+			continue
+		}
+		values, err := abiErr.Inputs.Unpack(data[4:])
+		if err != nil {
+			return "", false
+		}
+		args := make([]string, len(values))
+		for i, v := range values {
+			args[i] = fmt.Sprint(v)
+		}
+		return fmt.Sprintf("%s(%s)", abiErr.Name, strings.Join(args, ", ")), true
+	}
+	return "", false
+}
@@ -1,10 +1,22 @@
 package txmgr
 
 import (
+	"crypto/ecdsa"
+	"fmt"
+	"math/big"
+	"net/http/httptest"
+	"net/url"
+	"slices"
+	"sync"
 	"testing"
 	"time"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/stretchr/testify/require"
 
 	commonconfig "github.com/smartcontractkit/chainlink/v2/common/config"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/assets"
@@ -125,6 +137,7 @@ func (t *transactionsConfig) MaxQueued() uint64                   { return t.e.M
 func (t *transactionsConfig) ReaperInterval() time.Duration       { return t.e.ReaperInterval }
 func (t *transactionsConfig) ReaperThreshold() time.Duration      { return t.e.ReaperThreshold }
 func (t *transactionsConfig) ResendAfterThreshold() time.Duration { return t.e.ResendAfterThreshold }
+func (*transactionsConfig) SimulateTransactions() bool            { return false }
 
 type MockConfig struct {
 	EvmConfig           *TestEvmConfig
@@ -150,3 +163,91 @@ func MakeTestConfigs(t *testing.T) (*MockConfig, *TestDatabaseConfig, *TestEvmCo
 	config := &MockConfig{EvmConfig: ec}
 	return config, db, ec
 }
+
+// NewFakeRemoteSigner starts a remote signer speaking eth_accounts and eth_signTransaction, like Web3Signer, which
+// signs with keys and returns its URL.
+func NewFakeRemoteSigner(t testing.TB, chainID *big.Int, keys ...*ecdsa.PrivateKey) (*url.URL, *FakeRemoteSigner) {
+	s := &FakeRemoteSigner{chainID: chainID, keys: make(map[common.Address]*ecdsa.PrivateKey, len(keys))}
+	for _, key := range keys {
+		s.AddKey(key)
+	}
+	rpcSrv := rpc.NewServer()
+	require.NoError(t, rpcSrv.RegisterName("eth", s))
+	t.Cleanup(rpcSrv.Stop)
+	srv := httptest.NewServer(rpcSrv)
+	t.Cleanup(srv.Close)
+	u, err := url.Parse(srv.URL)
+	require.NoError(t, err)
+	return u, s
+}
+
+// FakeRemoteSigner is the state of a remote signer started by NewFakeRemoteSigner.
+type FakeRemoteSigner struct {
+	chainID *big.Int
+
+	mu            sync.Mutex
+	accounts      []common.Address
+	keys          map[common.Address]*ecdsa.PrivateKey
+	accountsCalls int
+}
+
+// AddKey adds an account to the remote signer.
+func (s *FakeRemoteSigner) AddKey(key *ecdsa.PrivateKey) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	address := crypto.PubkeyToAddress(key.PublicKey)
+	s.accounts = append(s.accounts, address)
+	s.keys[address] = key
+}
+
+// AccountsCalls returns how many times eth_accounts was called.
+func (s *FakeRemoteSigner) AccountsCalls() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.accountsCalls
+}
+
+// Accounts serves eth_accounts.
+func (s *FakeRemoteSigner) Accounts() []common.Address {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.accountsCalls++
+	return slices.Clone(s.accounts)
+}
+
+// SignTransaction serves eth_signTransaction.
+func (s *FakeRemoteSigner) SignTransaction(args signTransactionArgs) (hexutil.Bytes, error) {
+	s.mu.Lock()
+	key, ok := s.keys[args.From]
+	s.mu.Unlock()
+	if !ok {
+		return nil, fmt.Errorf("no key for account %s", args.From)
+	}
+	var tx *types.Transaction
+	if args.MaxFeePerGas != nil {
+		tx = types.NewTx(&types.DynamicFeeTx{
+			ChainID:   s.chainID,
+			Nonce:     uint64(args.Nonce),
+			GasTipCap: args.MaxPriorityFeePerGas.ToInt(),
+			GasFeeCap: args.MaxFeePerGas.ToInt(),
+			Gas:       uint64(args.Gas),
+			To:        args.To,
+			Value:     args.Value.ToInt(),
+			Data:      args.Data,
+		})
+	} else {
+		tx = types.NewTx(&types.LegacyTx{
+			Nonce:    uint64(args.Nonce),
+			GasPrice: args.GasPrice.ToInt(),
+			Gas:      uint64(args.Gas),
+			To:       args.To,
+			Value:    args.Value.ToInt(),
+			Data:     args.Data,
+		})
+	}
+	signedTx, err := types.SignTx(tx, types.LatestSignerForChainID(s.chainID), key)
+	if err != nil {
+		return nil, err
+	}
+	return signedTx.MarshalBinary()
+}
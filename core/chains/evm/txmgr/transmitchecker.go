@@ -37,6 +37,7 @@ var (
 
 	_ TransmitCheckerFactory = &CheckerFactory{}
 	_ TransmitChecker        = &SimulateChecker{}
+	_ TransmitChecker        = ChainedChecker{}
 	_ TransmitChecker        = &VRFV1Checker{}
 	_ TransmitChecker        = &VRFV2Checker{}
 )
@@ -44,13 +45,28 @@ var (
 // CheckerFactory is a real implementation of TransmitCheckerFactory.
 type CheckerFactory struct {
 	Client evmclient.Client
+	// SimulateTransactions makes every transaction use the SimulateChecker, after its own checker if it has one.
+	SimulateTransactions bool
+	// ContractABIs decode the custom errors of simulations reverting. May be nil.
+	ContractABIs *ContractABIs
 }
 
 // BuildChecker satisfies the TransmitCheckerFactory interface.
 func (c *CheckerFactory) BuildChecker(spec TransmitCheckerSpec) (TransmitChecker, error) {
+	checker, err := c.buildChecker(spec)
+	if err != nil || !(spec.Simulate || c.SimulateTransactions) {
+		return checker, err
+	}
+	if _, ok := checker.(*SimulateChecker); ok {
+		return checker, nil
+	}
+	return ChainedChecker{checker, &SimulateChecker{Client: c.Client, ContractABIs: c.ContractABIs}}, nil
+}
+
+func (c *CheckerFactory) buildChecker(spec TransmitCheckerSpec) (TransmitChecker, error) {
 	switch spec.CheckerType {
 	case TransmitCheckerTypeSimulate:
-		return &SimulateChecker{c.Client}, nil
+		return &SimulateChecker{Client: c.Client, ContractABIs: c.ContractABIs}, nil
 	case TransmitCheckerTypeVRFV1:
 		if spec.VRFCoordinatorAddress == nil {
 			return nil, pkgerrors.Errorf("malformed checker, expected non-nil VRFCoordinatorAddress, got: %v", spec)
@@ -99,6 +115,9 @@ func (c *CheckerFactory) BuildChecker(spec TransmitCheckerSpec) (TransmitChecker
 			RequestBlockNumber: spec.VRFRequestBlockNumber,
 		}, nil
 	case "":
+		if c.SimulateTransactions || spec.Simulate {
+			return &SimulateChecker{Client: c.Client, ContractABIs: c.ContractABIs}, nil
+		}
 		return NoChecker, nil
 	default:
 		return nil, pkgerrors.Errorf("unrecognized checker type: %s", spec.CheckerType)
@@ -117,9 +136,30 @@ func (noChecker) Check(
 	return nil
 }
 
-// SimulateChecker simulates transactions, producing an error if they revert on chain.
+// ChainedChecker runs its checkers in order, producing the error of the first one which fails.
+type ChainedChecker []TransmitChecker
+
+// Check satisfies the TransmitChecker interface.
+func (c ChainedChecker) Check(
+	ctx context.Context,
+	l logger.SugaredLogger,
+	tx Tx,
+	a TxAttempt,
+) error {
+	for _, checker := range c {
+		if err := checker.Check(ctx, l, tx, a); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// SimulateChecker simulates transactions, producing an error if they revert on chain. Other errors of the simulation,
+// e.g. rate limits of the RPC, do not prevent the transaction from being sent.
 type SimulateChecker struct {
 	Client evmclient.Client
+	// ContractABIs decode the custom errors of simulations reverting. May be nil.
+	ContractABIs *ContractABIs
 }
 
 // Check satisfies the TransmitChecker interface.
@@ -147,10 +187,13 @@ func (s *SimulateChecker) Check(
 	// always run simulation on "latest" block
 	err := s.Client.CallContext(ctx, &b, "eth_call", callArg, evmclient.ToBlockNumArg(nil))
 	if err != nil {
-		if jErr := evmclient.ExtractRPCErrorOrNil(err); jErr != nil {
+		if jErr := evmclient.ExtractRPCErrorOrNil(err); jErr != nil && executionReverted(jErr) {
 			l.Criticalw("Transaction reverted during simulation",
 				"ethTxAttemptID", a.ID, "txHash", a.Hash, "err", err, "rpcErr", jErr.String(), "returnValue", b.String())
-			return pkgerrors.Errorf("transaction reverted during simulation: %s", jErr.String())
+			return &txmgr.RevertError{
+				Reason: revertReason(jErr, tx.ToAddress, s.ContractABIs),
+				Err:    pkgerrors.Errorf("transaction reverted during simulation: %s", jErr.String()),
+			}
 		}
 		l.Warnw("Transaction simulation failed, will attempt to send anyway",
 			"ethTxAttemptID", a.ID, "txHash", a.Hash, "err", err, "returnValue", b.String())
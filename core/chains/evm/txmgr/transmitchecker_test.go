@@ -5,14 +5,18 @@ import (
 	"encoding/json"
 	"fmt"
 	"math/big"
+	"strings"
 	"testing"
 	"time"
 
+	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/rpc"
 	pkgerrors "github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 
 	"github.com/smartcontractkit/chainlink-common/pkg/logger"
@@ -43,6 +47,13 @@ func TestFactory(t *testing.T) {
 		require.Equal(t, txmgr.NoChecker, c)
 	})
 
+	t.Run("no checker, simulating transactions", func(t *testing.T) {
+		factory := &txmgr.CheckerFactory{Client: client, SimulateTransactions: true}
+		c, err := factory.BuildChecker(txmgr.TransmitCheckerSpec{})
+		require.NoError(t, err)
+		require.Equal(t, &txmgr.SimulateChecker{Client: client}, c)
+	})
+
 	t.Run("vrf v1 checker", func(t *testing.T) {
 		c, err := factory.BuildChecker(txmgr.TransmitCheckerSpec{
 			CheckerType:           txmgr.TransmitCheckerTypeVRFV1,
@@ -96,6 +107,31 @@ func TestFactory(t *testing.T) {
 		require.Equal(t, &txmgr.SimulateChecker{Client: client}, c)
 	})
 
+	t.Run("simulating", func(t *testing.T) {
+		c, err := factory.BuildChecker(txmgr.TransmitCheckerSpec{Simulate: true})
+		require.NoError(t, err)
+		require.Equal(t, &txmgr.SimulateChecker{Client: client}, c)
+
+		c, err = factory.BuildChecker(txmgr.TransmitCheckerSpec{
+			CheckerType: txmgr.TransmitCheckerTypeSimulate,
+			Simulate:    true,
+		})
+		require.NoError(t, err)
+		require.Equal(t, &txmgr.SimulateChecker{Client: client}, c)
+
+		c, err = factory.BuildChecker(txmgr.TransmitCheckerSpec{
+			CheckerType:           txmgr.TransmitCheckerTypeVRFV1,
+			VRFCoordinatorAddress: testutils.NewAddressPtr(),
+			Simulate:              true,
+		})
+		require.NoError(t, err)
+		require.IsType(t, txmgr.ChainedChecker{}, c)
+		chained := c.(txmgr.ChainedChecker)
+		require.Len(t, chained, 2)
+		require.IsType(t, &txmgr.VRFV1Checker{}, chained[0])
+		require.Equal(t, &txmgr.SimulateChecker{Client: client}, chained[1])
+	})
+
 	t.Run("invalid checker type", func(t *testing.T) {
 		_, err := factory.BuildChecker(txmgr.TransmitCheckerSpec{
 			CheckerType: "invalid",
@@ -158,6 +194,61 @@ func TestTransmitCheckers(t *testing.T) {
 			err := checker.Check(ctx, log, tx, attempt)
 			expErrMsg := "transaction reverted during simulation: json-rpc error { Code = 42, Message = 'oh no, it reverted', Data = 'KqYi' }"
 			require.EqualError(t, err, expErrMsg)
+			var revertErr *txmgrcommon.RevertError
+			require.ErrorAs(t, err, &revertErr)
+			assert.Equal(t, "oh no, it reverted", revertErr.Reason)
+		})
+
+		t.Run("revert with reason", func(t *testing.T) {
+			contractABI, err := abi.JSON(strings.NewReader(`[{"type":"error","name":"InsufficientBalance","inputs":[{"name":"available","type":"uint256"},{"name":"required","type":"uint256"}]}]`))
+			require.NoError(t, err)
+			contractABIs := txmgr.NewContractABIs()
+			// registered by two jobs
+			contractABIs.Register(tx.ToAddress, contractABI)
+			contractABIs.Register(tx.ToAddress, contractABI)
+			checker := txmgr.SimulateChecker{Client: client, ContractABIs: contractABIs}
+			insufficientBalance := contractABI.Errors["InsufficientBalance"]
+			args, err := insufficientBalance.Inputs.Pack(big.NewInt(10), big.NewInt(20))
+			require.NoError(t, err)
+			customErr := hexutil.Encode(append(insufficientBalance.ID[:4], args...))
+			checkReason := func(t *testing.T, data string, reason string) {
+				jerr := evmclient.JsonError{
+					Code:    3,
+					Message: "execution reverted",
+					Data:    data,
+				}
+				client.On("CallContext", mock.Anything,
+					mock.AnythingOfType("*hexutil.Bytes"), "eth_call",
+					mock.Anything, "latest").Return(&jerr).Once()
+
+				err := checker.Check(ctx, log, tx, attempt)
+				var revertErr *txmgrcommon.RevertError
+				require.ErrorAs(t, err, &revertErr)
+				assert.Equal(t, reason, revertErr.Reason)
+			}
+
+			for _, tt := range []struct {
+				name   string
+				data   string
+				reason string
+			}{
+				{"error", "0x08c379a00000000000000000000000000000000000000000000000000000000000000020000000000000000000000000000000000000000000000000000000000000000f6e6f7420656e6f756768204c494e4b0000000000000000000000000000000000", "not enough LINK"},
+				{"panic", "0x4e487b710000000000000000000000000000000000000000000000000000000000000011", "arithmetic underflow or overflow"},
+				{"prefixed error", "Reverted 0x08c379a00000000000000000000000000000000000000000000000000000000000000020000000000000000000000000000000000000000000000000000000000000000f6e6f7420656e6f756768204c494e4b0000000000000000000000000000000000", "not enough LINK"},
+				{"registered custom error", customErr, "InsufficientBalance(10, 20)"},
+				{"unknown custom error", "0xdeadbeef", "execution reverted: 0xdeadbeef"},
+			} {
+				t.Run(tt.name, func(t *testing.T) {
+					checkReason(t, tt.data, tt.reason)
+				})
+			}
+
+			t.Run("unregistered custom error", func(t *testing.T) {
+				contractABIs.Unregister(tx.ToAddress)
+				checkReason(t, customErr, "InsufficientBalance(10, 20)")
+				contractABIs.Unregister(tx.ToAddress)
+				checkReason(t, customErr, "execution reverted: "+customErr)
+			})
 		})
 
 		t.Run("non revert error", func(t *testing.T) {
@@ -171,6 +262,20 @@ func TestTransmitCheckers(t *testing.T) {
 			// to be passed to the caller
 			require.NoError(t, checker.Check(ctx, log, tx, attempt))
 		})
+
+		t.Run("non revert rpc error", func(t *testing.T) {
+			// errors of the RPC which are not execution reverts do not tell the transaction would revert
+			for _, jerr := range []evmclient.JsonError{
+				{Code: -32005, Message: "rate limit exceeded"},
+				{Code: -32000, Message: "header not found"},
+			} {
+				client.On("CallContext", mock.Anything,
+					mock.AnythingOfType("*hexutil.Bytes"), "eth_call",
+					mock.Anything, "latest").Return(&jerr).Once()
+
+				require.NoError(t, checker.Check(ctx, log, tx, attempt))
+			}
+		})
 	})
 
 	t.Run("VRF V1", func(t *testing.T) {
@@ -50,6 +50,28 @@ func initJobsSubCmds(s *Shell) []cli.Command {
 			Usage:  "Trigger a job run",
 			Action: s.TriggerPipelineRun,
 		},
+		{
+			Name:  "runs",
+			Usage: "Commands for managing job runs",
+			Subcommands: []cli.Command{
+				{
+					Name:   "cancel",
+					Usage:  "Cancel a job run which is suspended awaiting an async task",
+					Action: s.CancelPipelineRun,
+				},
+			},
+		},
+		{
+			Name:   "simulate",
+			Usage:  "Simulate a run of a job's pipeline, without saving the job or sending transactions",
+			Action: s.SimulateJob,
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  "vars",
+					Usage: "JSON string or path to a JSON file with the vars of the run",
+				},
+			},
+		},
 	}
 }
 
@@ -201,6 +223,57 @@ func (ps JobPresenters) RenderTable(rt RendererTable) error {
 	return nil
 }
 
+// JobSimulationPresenter wraps the JSONAPI Job Simulation Resource and adds rendering functionality
+type JobSimulationPresenter struct {
+	JAID // This is needed to render the id for a JSONAPI Resource as normal JSON
+	presenters.JobSimulationResource
+}
+
+// RenderTable implements TableRenderer
+func (p *JobSimulationPresenter) RenderTable(rt RendererTable) error {
+	table := rt.newTable([]string{"Task", "Type", "Inputs", "Output", "Error", "Duration"})
+	for _, tr := range p.TaskRuns {
+		var inputs []string
+		for _, input := range tr.Inputs {
+			inputs = append(inputs, stringOrNull(input))
+		}
+		table.Append([]string{
+			tr.DotID,
+			string(tr.Type),
+			strings.Join(inputs, "\n"),
+			stringOrNull(tr.Output),
+			stringOrEmpty(tr.Error),
+			tr.Duration,
+		})
+	}
+	render("Simulated Task Runs", table)
+
+	table = rt.newTable([]string{"Outputs", "Fatal Errors"})
+	for i := range p.Outputs {
+		var fatalError *string
+		if i < len(p.FatalErrors) {
+			fatalError = p.FatalErrors[i]
+		}
+		table.Append([]string{stringOrNull(p.Outputs[i]), stringOrEmpty(fatalError)})
+	}
+	render("Simulation Result", table)
+	return nil
+}
+
+func stringOrNull(s *string) string {
+	if s == nil {
+		return "null"
+	}
+	return *s
+}
+
+func stringOrEmpty(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
+
 // ListJobs lists all jobs
 func (s *Shell) ListJobs(c *cli.Context) (err error) {
 	return s.getPage("/v2/jobs", c.Int("page"), &JobPresenters{})
@@ -286,6 +359,50 @@ func (s *Shell) DeleteJob(c *cli.Context) error {
 	return nil
 }
 
+// SimulateJob runs the pipeline of a job on the node without saving the job
+// Valid input is a TOML string or a path to TOML file
+func (s *Shell) SimulateJob(c *cli.Context) (err error) {
+	if !c.Args().Present() {
+		return s.errorOut(errors.New("must pass in TOML or filepath"))
+	}
+
+	tomlString, err := getTOMLString(c.Args().First())
+	if err != nil {
+		return s.errorOut(err)
+	}
+
+	var vars map[string]interface{}
+	if c.IsSet("vars") {
+		buf, err2 := getBufferFromJSON(c.String("vars"))
+		if err2 != nil {
+			return s.errorOut(err2)
+		}
+		if err2 = json.Unmarshal(buf.Bytes(), &vars); err2 != nil {
+			return s.errorOut(errors.Wrap(err2, "invalid vars"))
+		}
+	}
+
+	request, err := json.Marshal(web.SimulateJobRequest{
+		TOML: tomlString,
+		Vars: vars,
+	})
+	if err != nil {
+		return s.errorOut(err)
+	}
+
+	resp, err := s.HTTP.Post(s.ctx(), "/v2/jobs/simulate", bytes.NewReader(request))
+	if err != nil {
+		return s.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return s.renderAPIResponse(resp, &JobSimulationPresenter{})
+}
+
 // TriggerPipelineRun triggers a job run based on a job ID
 func (s *Shell) TriggerPipelineRun(c *cli.Context) error {
 	if !c.Args().Present() {
@@ -305,3 +422,23 @@ func (s *Shell) TriggerPipelineRun(c *cli.Context) error {
 	err = s.renderAPIResponse(resp, &run, "Pipeline run successfully triggered")
 	return err
 }
+
+// CancelPipelineRun cancels a suspended job run
+func (s *Shell) CancelPipelineRun(c *cli.Context) (err error) {
+	if !c.Args().Present() {
+		return s.errorOut(errors.New("must pass the run id to be cancelled"))
+	}
+	resp, err := s.HTTP.Post(s.ctx(), "/v2/pipeline/runs/"+c.Args().First()+"/cancel", nil)
+	if err != nil {
+		return s.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	var run presenters.PipelineRunResource
+	err = s.renderAPIResponse(resp, &run, "Pipeline run cancelled")
+	return err
+}
@@ -162,7 +162,7 @@ func (rt RendererTable) newTable(headers []string) *tablewriter.Table {
 }
 
 func (rt RendererTable) renderPipelineRun(run webpresenters.PipelineRunResource) error {
-	table := rt.newTable([]string{"ID", "Created At", "Finished At"})
+	table := rt.newTable([]string{"ID", "State", "Created At", "Finished At"})
 
 	var finishedAt string
 	if !run.FinishedAt.IsZero() {
@@ -171,6 +171,7 @@ func (rt RendererTable) renderPipelineRun(run webpresenters.PipelineRunResource)
 
 	row := []string{
 		run.GetID(),
+		string(run.State),
 		run.CreatedAt.String(),
 		finishedAt,
 	}
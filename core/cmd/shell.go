@@ -38,6 +38,7 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/services/pg"
 
 	"github.com/smartcontractkit/chainlink/v2/core/build"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/txmgr"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/legacyevm"
 	"github.com/smartcontractkit/chainlink/v2/core/config"
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
@@ -163,7 +164,11 @@ func (n ChainlinkAppFactory) NewApplication(ctx context.Context, cfg chainlink.G
 		return nil, err
 	}
 
-	keyStore := keystore.New(sqlxDB, utils.GetScryptParams(cfg), appLggr, cfg.Database())
+	ethSigners, err := newEthSigners(cfg)
+	if err != nil {
+		return nil, err
+	}
+	keyStore := keystore.NewWithEthSigners(keystore.New(sqlxDB, utils.GetScryptParams(cfg), appLggr, cfg.Database()), ethSigners)
 	mailMon := mailbox.NewMonitor(cfg.AppID().String(), appLggr.Named("Mailbox"))
 
 	loopRegistry := plugins.NewLoopRegistry(appLggr, cfg.Tracing())
@@ -250,6 +255,24 @@ func (n ChainlinkAppFactory) NewApplication(ctx context.Context, cfg chainlink.G
 }
 
 // handleNodeVersioning is a setup-time helper to encapsulate version changes and db migration
+// newEthSigners returns the remote signers of the EVM chains which have one, keyed by chain ID.
+func newEthSigners(cfg chainlink.GeneralConfig) (map[string]keystore.EthSigner, error) {
+	signers := make(map[string]keystore.EthSigner)
+	for _, c := range cfg.EVMConfigs() {
+		chainID := c.ChainID.String()
+		u := cfg.RemoteSigner().EVMURL(chainID)
+		if u == nil {
+			continue
+		}
+		signer, err := txmgr.NewRemoteSigner(u)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to create the remote signer of chain %s", chainID)
+		}
+		signers[chainID] = signer
+	}
+	return signers, nil
+}
+
 func handleNodeVersioning(ctx context.Context, db *sqlx.DB, appLggr logger.Logger, rootDir string, cfg config.Database, healthReportPort uint16) error {
 	var err error
 	// Set up the versioning Configs
@@ -53,6 +53,7 @@ type AppConfig interface {
 	Sentry() Sentry
 	TelemetryIngress() TelemetryIngress
 	Threshold() Threshold
+	RemoteSigner() RemoteSigner
 	WebServer() WebServer
 	Tracing() Tracing
 }
@@ -1,6 +1,23 @@
 package config
 
+import (
+	"github.com/smartcontractkit/chainlink-common/pkg/capabilities"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/p2pkey"
+)
+
 type Capabilities interface {
 	Peering() P2P
+	RemoteCapabilities() []RemoteCapability
 	// NOTE: RegistrySyncer will need config with relay ID, chain ID and contract address when implemented
 }
+
+// RemoteCapability is a capability hosted by the nodes of one DON and used by the workflows of another.
+type RemoteCapability interface {
+	ID() string
+	Type() capabilities.CapabilityType
+	CapabilityDONMembers() []p2pkey.PeerID
+	CapabilityDONF() uint8
+	WorkflowDONMembers() []p2pkey.PeerID
+	WorkflowDONF() uint8
+}
@@ -24,5 +24,6 @@ func CoreDefaults() (c toml.Core) {
 	c.SetFrom(&defaults)
 	c.Database.Dialect = dialects.Postgres // not user visible - overridden for tests only
 	c.Tracing.Attributes = make(map[string]string)
+	c.JobPipeline.ReaperThresholdByJobType = make(map[string]*config.Duration)
 	return
 }
@@ -13,6 +13,7 @@ type JobPipeline interface {
 	MaxSuccessfulRuns() uint64
 	ReaperInterval() time.Duration
 	ReaperThreshold() time.Duration
+	ReaperThresholdByJobType() map[string]time.Duration
 	ResultWriteQueueDepth() uint64
 	ExternalInitiatorsEnabled() bool
 }
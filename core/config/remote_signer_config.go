@@ -0,0 +1,8 @@
+package config
+
+import "net/url"
+
+type RemoteSigner interface {
+	// EVMURL returns the URL of the remote signer of the EVM chain with the given ID, or nil if it has none.
+	EVMURL(chainID string) *url.URL
+}
@@ -3,6 +3,7 @@ package toml
 import (
 	"errors"
 	"fmt"
+	"math/big"
 	"net"
 	"net/url"
 	"regexp"
@@ -14,6 +15,7 @@ import (
 
 	ocrcommontypes "github.com/smartcontractkit/libocr/commontypes"
 
+	"github.com/smartcontractkit/chainlink-common/pkg/capabilities"
 	commonconfig "github.com/smartcontractkit/chainlink-common/pkg/config"
 	"github.com/smartcontractkit/chainlink/v2/core/build"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/types"
@@ -115,6 +117,8 @@ type Secrets struct {
 	Prometheus PrometheusSecrets        `toml:",omitempty"`
 	Mercury    MercurySecrets           `toml:",omitempty"`
 	Threshold  ThresholdKeyShareSecrets `toml:",omitempty"`
+
+	RemoteSigner RemoteSignerSecrets `toml:",omitempty"`
 }
 
 func dbURLPasswordComplexity(err error) string {
@@ -854,7 +858,8 @@ type JobPipeline struct {
 	ReaperThreshold           *commonconfig.Duration
 	ResultWriteQueueDepth     *uint32
 
-	HTTPRequest JobPipelineHTTPRequest `toml:",omitempty"`
+	HTTPRequest              JobPipelineHTTPRequest            `toml:",omitempty"`
+	ReaperThresholdByJobType map[string]*commonconfig.Duration `toml:",omitempty"`
 }
 
 func (j *JobPipeline) setFrom(f *JobPipeline) {
@@ -877,7 +882,9 @@ func (j *JobPipeline) setFrom(f *JobPipeline) {
 		j.ResultWriteQueueDepth = v
 	}
 	j.HTTPRequest.setFrom(&f.HTTPRequest)
-
+	if v := f.ReaperThresholdByJobType; v != nil {
+		j.ReaperThresholdByJobType = v
+	}
 }
 
 type JobPipelineHTTPRequest struct {
@@ -1388,12 +1395,124 @@ func (m *MercurySecrets) ValidateConfig() (err error) {
 	return err
 }
 
+// RemoteSignerSecrets holds the remote signers of the transactions of the EVM chains, by chain ID.
+type RemoteSignerSecrets struct {
+	EVM map[string]EVMRemoteSignerSecrets
+}
+
+type EVMRemoteSignerSecrets struct {
+	// URL is the URL of the remote signer, which may hold its credentials
+	URL *models.SecretURL
+}
+
+func (r *RemoteSignerSecrets) SetFrom(f *RemoteSignerSecrets) (err error) {
+	err = r.validateMerge(f)
+	if err != nil {
+		return err
+	}
+
+	if r.EVM != nil && f.EVM != nil {
+		for k, v := range f.EVM {
+			r.EVM[k] = v
+		}
+	} else if v := f.EVM; v != nil {
+		r.EVM = v
+	}
+
+	return nil
+}
+
+func (r *RemoteSignerSecrets) validateMerge(f *RemoteSignerSecrets) (err error) {
+	if r.EVM != nil && f.EVM != nil {
+		for k := range f.EVM {
+			if _, exists := r.EVM[k]; exists {
+				err = multierr.Append(err, configutils.ErrOverride{Name: fmt.Sprintf("EVM[\"%s\"]", k)})
+			}
+		}
+	}
+
+	return err
+}
+
+func (r *RemoteSignerSecrets) ValidateConfig() (err error) {
+	for chainID, s := range r.EVM {
+		if _, ok := new(big.Int).SetString(chainID, 10); !ok {
+			err = multierr.Append(err, configutils.ErrInvalid{Name: "EVM", Value: chainID, Msg: "must be keyed by chain ID"})
+		}
+		if s.URL == nil || s.URL.URL() == nil {
+			err = multierr.Append(err, configutils.ErrMissing{Name: "URL", Msg: "must be provided and non-empty"})
+		}
+	}
+	return err
+}
+
 type Capabilities struct {
-	Peering P2P `toml:",omitempty"`
+	Peering            P2P                `toml:",omitempty"`
+	RemoteCapabilities []RemoteCapability `toml:",omitempty"`
 }
 
 func (c *Capabilities) setFrom(f *Capabilities) {
 	c.Peering.setFrom(&f.Peering)
+	if v := f.RemoteCapabilities; v != nil {
+		c.RemoteCapabilities = v
+	}
+}
+
+// RemoteCapability is a capability hosted by the nodes of one DON and used by the workflows of another.
+type RemoteCapability struct {
+	ID                   *string
+	Type                 *string
+	CapabilityDONMembers []p2pkey.PeerID
+	CapabilityDONF       *uint8
+	WorkflowDONMembers   []p2pkey.PeerID
+	WorkflowDONF         *uint8
+}
+
+func (r *RemoteCapability) ValidateConfig() (err error) {
+	if r.ID == nil || *r.ID == "" {
+		err = multierr.Append(err, configutils.ErrMissing{Name: "ID", Msg: "must be set"})
+	}
+	if r.Type == nil {
+		err = multierr.Append(err, configutils.ErrMissing{Name: "Type", Msg: "must be set"})
+	} else if _, terr := ParseCapabilityType(*r.Type); terr != nil {
+		err = multierr.Append(err, configutils.ErrInvalid{Name: "Type", Value: *r.Type, Msg: terr.Error()})
+	}
+	err = multierr.Append(err, validateDON("CapabilityDON", r.CapabilityDONMembers, r.CapabilityDONF))
+	err = multierr.Append(err, validateDON("WorkflowDON", r.WorkflowDONMembers, r.WorkflowDONF))
+	return
+}
+
+// validateDON checks that a DON of members tolerating f faulty ones is byzantine fault tolerant.
+func validateDON(name string, members []p2pkey.PeerID, f *uint8) (err error) {
+	if f == nil {
+		return configutils.ErrMissing{Name: name + "F", Msg: "must be set"}
+	}
+	if len(members) < 3*int(*f)+1 {
+		err = multierr.Append(err, configutils.ErrInvalid{Name: name + "Members", Value: len(members), Msg: fmt.Sprintf("must have at least 3*%sF+1 members", name)})
+	}
+	seen := make(map[p2pkey.PeerID]struct{}, len(members))
+	for _, m := range members {
+		if _, ok := seen[m]; ok {
+			err = multierr.Append(err, configutils.NewErrDuplicate(name+"Members", m.Raw()))
+		}
+		seen[m] = struct{}{}
+	}
+	return
+}
+
+// ParseCapabilityType parses the name of a capability type, e.g. "trigger".
+func ParseCapabilityType(s string) (capabilities.CapabilityType, error) {
+	for _, t := range []capabilities.CapabilityType{
+		capabilities.CapabilityTypeTrigger,
+		capabilities.CapabilityTypeAction,
+		capabilities.CapabilityTypeConsensus,
+		capabilities.CapabilityTypeTarget,
+	} {
+		if t.String() == s {
+			return t, nil
+		}
+	}
+	return 0, fmt.Errorf("must be one of trigger, action, consensus or target")
 }
 
 type ThresholdKeyShareSecrets struct {
@@ -25,6 +25,8 @@ import (
 
 	pipeline "github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
 
+	pkgtypes "github.com/smartcontractkit/chainlink-common/pkg/types"
+
 	plugins "github.com/smartcontractkit/chainlink/v2/plugins"
 
 	services "github.com/smartcontractkit/chainlink/v2/core/services"
@@ -129,6 +131,24 @@ func (_m *Application) BridgeORM() bridges.ORM {
 	return r0
 }
 
+// CancelJobRunV2 provides a mock function with given fields: ctx, runID
+func (_m *Application) CancelJobRunV2(ctx context.Context, runID int64) error {
+	ret := _m.Called(ctx, runID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CancelJobRunV2")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) error); ok {
+		r0 = rf(ctx, runID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // DeleteJob provides a mock function with given fields: ctx, jobID
 func (_m *Application) DeleteJob(ctx context.Context, jobID int32) error {
 	ret := _m.Called(ctx, jobID)
@@ -187,6 +207,26 @@ func (_m *Application) GetAuditLogger() audit.AuditLogger {
 	return r0
 }
 
+// GetCapabilitiesRegistry provides a mock function with given fields:
+func (_m *Application) GetCapabilitiesRegistry() pkgtypes.CapabilitiesRegistry {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCapabilitiesRegistry")
+	}
+
+	var r0 pkgtypes.CapabilitiesRegistry
+	if rf, ok := ret.Get(0).(func() pkgtypes.CapabilitiesRegistry); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(pkgtypes.CapabilitiesRegistry)
+		}
+	}
+
+	return r0
+}
+
 // GetConfig provides a mock function with given fields:
 func (_m *Application) GetConfig() chainlink.GeneralConfig {
 	ret := _m.Called()
@@ -485,6 +525,26 @@ func (_m *Application) PipelineORM() pipeline.ORM {
 	return r0
 }
 
+// PipelineRunBroadcaster provides a mock function with given fields:
+func (_m *Application) PipelineRunBroadcaster() *pipeline.RunBroadcaster {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for PipelineRunBroadcaster")
+	}
+
+	var r0 *pipeline.RunBroadcaster
+	if rf, ok := ret.Get(0).(func() *pipeline.RunBroadcaster); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pipeline.RunBroadcaster)
+		}
+	}
+
+	return r0
+}
+
 // ReplayFromBlock provides a mock function with given fields: chainID, number, forceBroadcast
 func (_m *Application) ReplayFromBlock(chainID *big.Int, number uint64, forceBroadcast bool) error {
 	ret := _m.Called(chainID, number, forceBroadcast)
@@ -615,6 +675,45 @@ func (_m *Application) SetLogLevel(lvl zapcore.Level) error {
 	return r0
 }
 
+// SimulateJobV2 provides a mock function with given fields: ctx, jb, vars
+func (_m *Application) SimulateJobV2(ctx context.Context, jb job.Job, vars map[string]interface{}) (*pipeline.Run, pipeline.TaskRunResults, error) {
+	ret := _m.Called(ctx, jb, vars)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SimulateJobV2")
+	}
+
+	var r0 *pipeline.Run
+	var r1 pipeline.TaskRunResults
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, job.Job, map[string]interface{}) (*pipeline.Run, pipeline.TaskRunResults, error)); ok {
+		return rf(ctx, jb, vars)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, job.Job, map[string]interface{}) *pipeline.Run); ok {
+		r0 = rf(ctx, jb, vars)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pipeline.Run)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, job.Job, map[string]interface{}) pipeline.TaskRunResults); ok {
+		r1 = rf(ctx, jb, vars)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(pipeline.TaskRunResults)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, job.Job, map[string]interface{}) error); ok {
+		r2 = rf(ctx, jb, vars)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
 // Start provides a mock function with given fields: ctx
 func (_m *Application) Start(ctx context.Context) error {
 	ret := _m.Called(ctx)
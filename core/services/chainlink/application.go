@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"math/big"
 	"net/http"
+	"strings"
 	"sync"
 
 	"github.com/ethereum/go-ethereum/common"
@@ -21,9 +22,12 @@ import (
 	"github.com/smartcontractkit/chainlink-common/pkg/loop"
 	commonservices "github.com/smartcontractkit/chainlink-common/pkg/services"
 	"github.com/smartcontractkit/chainlink-common/pkg/sqlutil"
+	coretypes "github.com/smartcontractkit/chainlink-common/pkg/types"
 	"github.com/smartcontractkit/chainlink-common/pkg/utils"
 	"github.com/smartcontractkit/chainlink-common/pkg/utils/mailbox"
 	"github.com/smartcontractkit/chainlink/v2/core/capabilities"
+	"github.com/smartcontractkit/chainlink/v2/core/capabilities/remote"
+	"github.com/smartcontractkit/chainlink/v2/core/capabilities/triggers"
 	"github.com/smartcontractkit/chainlink/v2/core/static"
 
 	"github.com/smartcontractkit/chainlink/v2/core/bridges"
@@ -85,6 +89,7 @@ type Application interface {
 	GetWebAuthnConfiguration() sessions.WebAuthnConfiguration
 
 	GetExternalInitiatorManager() webhook.ExternalInitiatorManager
+	GetCapabilitiesRegistry() coretypes.CapabilitiesRegistry
 	GetRelayers() RelayerChainInteroperators
 	GetLoopRegistry() *plugins.LoopRegistry
 
@@ -93,6 +98,8 @@ type Application interface {
 	JobORM() job.ORM
 	EVMORM() evmtypes.Configs
 	PipelineORM() pipeline.ORM
+	// PipelineRunBroadcaster streams the pipeline runs finished by this node.
+	PipelineRunBroadcaster() *pipeline.RunBroadcaster
 	BridgeORM() bridges.ORM
 	BasicAdminUsersORM() sessions.BasicAdminUsersORM
 	AuthenticationProvider() sessions.AuthenticationProvider
@@ -101,6 +108,10 @@ type Application interface {
 	DeleteJob(ctx context.Context, jobID int32) error
 	RunWebhookJobV2(ctx context.Context, jobUUID uuid.UUID, requestBody string, meta pipeline.JSONSerializable) (int64, error)
 	ResumeJobV2(ctx context.Context, taskID uuid.UUID, result pipeline.Result) error
+	// CancelJobRunV2 finishes a suspended pipeline run as errored, without waiting for its pending tasks.
+	CancelJobRunV2(ctx context.Context, runID int64) error
+	// SimulateJobV2 runs the pipeline of a job in-memory, without persisting the run or sending transactions.
+	SimulateJobV2(ctx context.Context, jb job.Job, vars map[string]interface{}) (*pipeline.Run, pipeline.TaskRunResults, error)
 	// Testing only
 	RunJobV2(ctx context.Context, jobID int32, meta map[string]interface{}) (int64, error)
 
@@ -126,6 +137,7 @@ type ChainlinkApplication struct {
 	jobSpawner               job.Spawner
 	pipelineORM              pipeline.ORM
 	pipelineRunner           pipeline.Runner
+	runBroadcaster           *pipeline.RunBroadcaster
 	bridgeORM                bridges.ORM
 	localAdminUsersORM       sessions.BasicAdminUsersORM
 	authenticationProvider   sessions.AuthenticationProvider
@@ -135,6 +147,7 @@ type ChainlinkApplication struct {
 	Config                   GeneralConfig
 	KeyStore                 keystore.Master
 	ExternalInitiatorManager webhook.ExternalInitiatorManager
+	capabilitiesRegistry     coretypes.CapabilitiesRegistry
 	SessionReaper            *utils.SleeperTask
 	shutdownOnce             sync.Once
 	srvcs                    []services.ServiceCtx
@@ -192,12 +205,26 @@ func NewApplication(opts ApplicationOpts) (Application, error) {
 	unrestrictedHTTPClient := opts.UnrestrictedHTTPClient
 	registry := capabilities.NewRegistry(globalLogger)
 
+	// built-in triggers, so that workflows can be run without a streams trigger
+	cronTrigger := triggers.NewCronTrigger(globalLogger)
+	srvcs = append(srvcs, cronTrigger)
+	if err := registry.Add(context.TODO(), cronTrigger); err != nil {
+		return nil, err
+	}
+	if err := registry.Add(context.TODO(), triggers.NewHTTPTrigger(globalLogger)); err != nil {
+		return nil, err
+	}
+
 	if cfg.Capabilities().Peering().Enabled() {
 		externalPeerWrapper := externalp2p.NewExternalPeerWrapper(keyStore.P2P(), cfg.Capabilities().Peering(), globalLogger)
 		srvcs = append(srvcs, externalPeerWrapper)
 
+		// the dispatcher routes the messages received from other DONs to remote capabilities
+		dispatcher := remote.NewDispatcher(externalPeerWrapper, globalLogger)
+		srvcs = append(srvcs, dispatcher)
+
 		// NOTE: RegistrySyncer will depend on a Relayer when fully implemented
-		registrySyncer := capabilities.NewRegistrySyncer(externalPeerWrapper, registry, globalLogger)
+		registrySyncer := capabilities.NewRegistrySyncer(externalPeerWrapper, registry, dispatcher, cfg.Capabilities().RemoteCapabilities(), globalLogger)
 		srvcs = append(srvcs, registrySyncer)
 	}
 
@@ -309,7 +336,9 @@ func NewApplication(opts ApplicationOpts) (Application, error) {
 		jobORM         = job.NewORM(sqlxDB, pipelineORM, bridgeORM, keyStore, globalLogger, cfg.Database())
 		txmORM         = txmgr.NewTxStore(sqlxDB, globalLogger, cfg.Database())
 		streamRegistry = streams.NewRegistry(globalLogger, pipelineRunner)
+		runBroadcaster = pipeline.NewRunBroadcaster(globalLogger)
 	)
+	pipelineRunner.OnRunFinished(runBroadcaster.Broadcast)
 
 	for _, chain := range legacyEVMChains.Slice() {
 		chain.HeadBroadcaster().Subscribe(promReporter)
@@ -372,6 +401,7 @@ func NewApplication(opts ApplicationOpts) (Application, error) {
 				globalLogger,
 				registry,
 				legacyEVMChains,
+				opts.DB,
 			),
 		}
 		webhookJobRunner = delegates[job.Webhook].(*webhook.Delegate).WebhookJobRunner()
@@ -511,6 +541,7 @@ func NewApplication(opts ApplicationOpts) (Application, error) {
 		jobORM:                   jobORM,
 		jobSpawner:               jobSpawner,
 		pipelineRunner:           pipelineRunner,
+		runBroadcaster:           runBroadcaster,
 		pipelineORM:              pipelineORM,
 		bridgeORM:                bridgeORM,
 		localAdminUsersORM:       localAdminUsersORM,
@@ -522,6 +553,7 @@ func NewApplication(opts ApplicationOpts) (Application, error) {
 		KeyStore:                 keyStore,
 		SessionReaper:            sessionReaper,
 		ExternalInitiatorManager: externalInitiatorManager,
+		capabilitiesRegistry:     registry,
 		HealthChecker:            healthChecker,
 		Nurse:                    nurse,
 		logger:                   globalLogger,
@@ -704,6 +736,10 @@ func (app *ChainlinkApplication) PipelineORM() pipeline.ORM {
 	return app.pipelineORM
 }
 
+func (app *ChainlinkApplication) PipelineRunBroadcaster() *pipeline.RunBroadcaster {
+	return app.runBroadcaster
+}
+
 func (app *ChainlinkApplication) TxmStorageService() txmgr.EvmTxStore {
 	return app.txmStorageService
 }
@@ -712,6 +748,10 @@ func (app *ChainlinkApplication) GetExternalInitiatorManager() webhook.ExternalI
 	return app.ExternalInitiatorManager
 }
 
+func (app *ChainlinkApplication) GetCapabilitiesRegistry() coretypes.CapabilitiesRegistry {
+	return app.capabilitiesRegistry
+}
+
 func (app *ChainlinkApplication) SecretGenerator() SecretGenerator {
 	return app.secretGenerator
 }
@@ -803,11 +843,55 @@ func (app *ChainlinkApplication) RunJobV2(
 				},
 			}
 		}
+		// the pipeline spec is loaded without the fields of the job, which the spawner sets
+		jb.PipelineSpec.JobID = jb.ID
+		jb.PipelineSpec.SimulateTransactions = jb.SimulateTransactions
+		jb.PipelineSpec.SigningKeys = jb.SigningKeys
 		runID, _, err = app.pipelineRunner.ExecuteAndInsertFinishedRun(ctx, *jb.PipelineSpec, pipeline.NewVarsFrom(vars), app.logger, saveTasks)
 	}
 	return runID, err
 }
 
+// SimulateJobV2 implements the Application interface. The pipeline runs without effects, see
+// pipeline.Pipeline.Simulate, and jobSpec vars default to the job's own.
+func (app *ChainlinkApplication) SimulateJobV2(
+	ctx context.Context,
+	jb job.Job,
+	vars map[string]interface{},
+) (*pipeline.Run, pipeline.TaskRunResults, error) {
+	if strings.TrimSpace(jb.Pipeline.Source) == "" {
+		return nil, nil, errors.New("job has no pipeline to simulate")
+	}
+	spec := pipeline.Spec{
+		DotDagSource:         jb.Pipeline.Source,
+		MaxTaskDuration:      jb.MaxTaskDuration,
+		ForwardingAllowed:    jb.ForwardingAllowed,
+		SimulateTransactions: jb.SimulateTransactions,
+		JobName:              jb.Name.ValueOrZero(),
+		JobType:              string(jb.Type),
+	}
+	if jb.GasLimit.Valid {
+		spec.GasLimit = &jb.GasLimit.Uint32
+	}
+	p, err := app.pipelineRunner.InitializePipeline(spec)
+	if err != nil {
+		return nil, nil, err
+	}
+	p.Simulate()
+	spec.Pipeline = p
+
+	if vars == nil {
+		vars = map[string]interface{}{}
+	}
+	if _, ok := vars["jobSpec"]; !ok {
+		vars["jobSpec"] = map[string]interface{}{
+			"externalJobID": jb.ExternalJobID,
+			"name":          jb.Name.ValueOrZero(),
+		}
+	}
+	return app.pipelineRunner.ExecuteRun(ctx, spec, pipeline.NewVarsFrom(vars), app.logger)
+}
+
 func (app *ChainlinkApplication) ResumeJobV2(
 	ctx context.Context,
 	taskID uuid.UUID,
@@ -816,6 +900,10 @@ func (app *ChainlinkApplication) ResumeJobV2(
 	return app.pipelineRunner.ResumeRun(taskID, result.Value, result.Error)
 }
 
+func (app *ChainlinkApplication) CancelJobRunV2(ctx context.Context, runID int64) error {
+	return app.pipelineRunner.CancelRun(ctx, runID)
+}
+
 func (app *ChainlinkApplication) GetFeedsService() feeds.Service {
 	return app.FeedsService
 }
@@ -192,6 +192,10 @@ func (s *Secrets) SetFrom(f *Secrets) (err error) {
 		err = multierr.Append(err, config.NamedMultiErrorList(err2, "Threshold"))
 	}
 
+	if err2 := s.RemoteSigner.SetFrom(&f.RemoteSigner); err2 != nil {
+		err = multierr.Append(err, config.NamedMultiErrorList(err2, "RemoteSigner"))
+	}
+
 	_, err = utils.MultiErrorList(err)
 
 	return err
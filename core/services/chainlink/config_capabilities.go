@@ -1,8 +1,11 @@
 package chainlink
 
 import (
+	"github.com/smartcontractkit/chainlink-common/pkg/capabilities"
+
 	"github.com/smartcontractkit/chainlink/v2/core/config"
 	"github.com/smartcontractkit/chainlink/v2/core/config/toml"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/p2pkey"
 )
 
 var _ config.Capabilities = (*capabilitiesConfig)(nil)
@@ -14,3 +17,41 @@ type capabilitiesConfig struct {
 func (c *capabilitiesConfig) Peering() config.P2P {
 	return &p2p{c: c.c.Peering}
 }
+
+func (c *capabilitiesConfig) RemoteCapabilities() []config.RemoteCapability {
+	var remoteCapabilities []config.RemoteCapability
+	for _, r := range c.c.RemoteCapabilities {
+		remoteCapabilities = append(remoteCapabilities, &remoteCapabilityConfig{c: r})
+	}
+	return remoteCapabilities
+}
+
+type remoteCapabilityConfig struct {
+	c toml.RemoteCapability
+}
+
+func (r *remoteCapabilityConfig) ID() string {
+	return *r.c.ID
+}
+
+func (r *remoteCapabilityConfig) Type() capabilities.CapabilityType {
+	// the type is validated with the config
+	t, _ := toml.ParseCapabilityType(*r.c.Type)
+	return t
+}
+
+func (r *remoteCapabilityConfig) CapabilityDONMembers() []p2pkey.PeerID {
+	return r.c.CapabilityDONMembers
+}
+
+func (r *remoteCapabilityConfig) CapabilityDONF() uint8 {
+	return *r.c.CapabilityDONF
+}
+
+func (r *remoteCapabilityConfig) WorkflowDONMembers() []p2pkey.PeerID {
+	return r.c.WorkflowDONMembers
+}
+
+func (r *remoteCapabilityConfig) WorkflowDONF() uint8 {
+	return *r.c.WorkflowDONF
+}
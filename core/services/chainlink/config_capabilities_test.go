@@ -8,6 +8,8 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/smartcontractkit/libocr/commontypes"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/capabilities"
 )
 
 func TestCapabilitiesConfig(t *testing.T) {
@@ -43,4 +45,16 @@ func TestCapabilitiesConfig(t *testing.T) {
 	assert.Equal(t, time.Minute, v2.DeltaDial().Duration())
 	assert.Equal(t, 2*time.Second, v2.DeltaReconcile().Duration())
 	assert.Equal(t, []string{"foo", "bar"}, v2.ListenAddresses())
+
+	remoteCapabilities := cfg.Capabilities().RemoteCapabilities()
+	require.Len(t, remoteCapabilities, 1)
+	remoteCapability := remoteCapabilities[0]
+	assert.Equal(t, "streams-trigger", remoteCapability.ID())
+	assert.Equal(t, capabilities.CapabilityTypeTrigger, remoteCapability.Type())
+	require.Len(t, remoteCapability.CapabilityDONMembers(), 4)
+	assert.Equal(t, "12D3KooWMHMRLQkgPbFSYHwD3NBuwtS1AmxhvKVUrcfyaGDASR4U", remoteCapability.CapabilityDONMembers()[0].Raw())
+	assert.Equal(t, uint8(1), remoteCapability.CapabilityDONF())
+	require.Len(t, remoteCapability.WorkflowDONMembers(), 4)
+	assert.Equal(t, "12D3KooWJbZLiMuGeKw78s3LM5TNgBTJHcF39DraxLu14bucG9RN", remoteCapability.WorkflowDONMembers()[0].Raw())
+	assert.Equal(t, uint8(1), remoteCapability.WorkflowDONF())
 }
@@ -38,6 +38,14 @@ func (j *jobPipelineConfig) ReaperThreshold() time.Duration {
 	return j.c.ReaperThreshold.Duration()
 }
 
+func (j *jobPipelineConfig) ReaperThresholdByJobType() map[string]time.Duration {
+	thresholds := make(map[string]time.Duration, len(j.c.ReaperThresholdByJobType))
+	for jobType, threshold := range j.c.ReaperThresholdByJobType {
+		thresholds[jobType] = threshold.Duration()
+	}
+	return thresholds
+}
+
 func (j *jobPipelineConfig) ResultWriteQueueDepth() uint64 {
 	return uint64(*j.c.ResultWriteQueueDepth)
 }
@@ -28,6 +28,7 @@ func TestJobPipelineConfigTest(t *testing.T) {
 	assert.Equal(t, uint64(123456), jp.MaxSuccessfulRuns())
 	assert.Equal(t, 4*time.Hour, jp.ReaperInterval())
 	assert.Equal(t, 168*time.Hour, jp.ReaperThreshold())
+	assert.Equal(t, map[string]time.Duration{"offchainreporting2": time.Hour, "webhook": 720 * time.Hour}, jp.ReaperThresholdByJobType())
 	assert.Equal(t, uint64(10), jp.ResultWriteQueueDepth())
 	assert.True(t, jp.ExternalInitiatorsEnabled())
 }
@@ -0,0 +1,19 @@
+package chainlink
+
+import (
+	"net/url"
+
+	"github.com/smartcontractkit/chainlink/v2/core/config/toml"
+)
+
+type remoteSignerConfig struct {
+	s toml.RemoteSignerSecrets
+}
+
+func (r *remoteSignerConfig) EVMURL(chainID string) *url.URL {
+	s, ok := r.s.EVM[chainID]
+	if !ok || s.URL == nil {
+		return nil
+	}
+	return s.URL.URL()
+}
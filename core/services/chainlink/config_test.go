@@ -374,6 +374,10 @@ func TestConfig_Marshal(t *testing.T) {
 			MaxSize:        ptr[utils.FileSize](100 * utils.MB),
 			DefaultTimeout: commoncfg.MustNewDuration(time.Minute),
 		},
+		ReaperThresholdByJobType: map[string]*commoncfg.Duration{
+			"offchainreporting2": commoncfg.MustNewDuration(time.Hour),
+			"webhook":            commoncfg.MustNewDuration(30 * 24 * time.Hour),
+		},
 	}
 	full.FluxMonitor = toml.FluxMonitor{
 		DefaultTransactionQueueDepth: ptr[uint32](100),
@@ -442,6 +446,24 @@ func TestConfig_Marshal(t *testing.T) {
 				ListenAddresses: &[]string{"foo", "bar"},
 			},
 		},
+		RemoteCapabilities: []toml.RemoteCapability{{
+			ID:   ptr("streams-trigger"),
+			Type: ptr("trigger"),
+			CapabilityDONMembers: []p2pkey.PeerID{
+				*mustPeerID("12D3KooWMHMRLQkgPbFSYHwD3NBuwtS1AmxhvKVUrcfyaGDASR4U"),
+				*mustPeerID("12D3KooWM55u5Swtpw9r8aFLQHEtw7HR4t44GdNs654ej5gRs2Dh"),
+				*mustPeerID("12D3KooWF3dVeJ6YoT5HFnYhmwQWWMoEwVFzJQ5kKCMX3ZityxMC"),
+				*mustPeerID("12D3KooWQsmok6aD8PZqt3RnJhQRrNzKHLficq7zYFRp7kZ1hHP8"),
+			},
+			CapabilityDONF: ptr[uint8](1),
+			WorkflowDONMembers: []p2pkey.PeerID{
+				*mustPeerID("12D3KooWJbZLiMuGeKw78s3LM5TNgBTJHcF39DraxLu14bucG9RN"),
+				*mustPeerID("12D3KooWGqfSPhHKmQycfhRjgUDE2vg9YWZN27Eue8idb2ZUk6EH"),
+				*mustPeerID("12D3KooWMoejJznyDuEk5aX6GvbjaG12UzeornPCBNzMRqdwrFJw"),
+				*mustPeerID("12D3KooWHCcyTPmYFB1ydNvNcXw5WyAomRzGSFu1B7hpB4yi8Smf"),
+			},
+			WorkflowDONF: ptr[uint8](1),
+		}},
 	}
 	full.Keeper = toml.Keeper{
 		DefaultTransactionQueueDepth: ptr[uint32](17),
@@ -565,6 +587,7 @@ func TestConfig_Marshal(t *testing.T) {
 					ReaperThreshold:      &minute,
 					ResendAfterThreshold: &hour,
 					ForwardersEnabled:    ptr(true),
+					SimulateTransactions: ptr(true),
 				},
 
 				HeadTracker: evmcfg.HeadTracker{
@@ -849,6 +872,10 @@ ResultWriteQueueDepth = 10
 [JobPipeline.HTTPRequest]
 DefaultTimeout = '1m0s'
 MaxSize = '100.00mb'
+
+[JobPipeline.ReaperThresholdByJobType]
+offchainreporting2 = '1h0m0s'
+webhook = '720h0m0s'
 `},
 		{"OCR", Config{Core: toml.Core{OCR: full.OCR}}, `[OCR]
 Enabled = true
@@ -962,6 +989,7 @@ MaxQueued = 99
 ReaperInterval = '1m0s'
 ReaperThreshold = '1m0s'
 ResendAfterThreshold = '1h0m0s'
+SimulateTransactions = true
 
 [EVM.BalanceMonitor]
 Enabled = true
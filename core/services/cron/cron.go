@@ -34,7 +34,7 @@ func NewCronFromJobSpec(
 	)
 
 	return &Cron{
-		cronRunner:     cronRunner(),
+		cronRunner:     NewRunner(),
 		logger:         cronLogger,
 		jobSpec:        jobSpec,
 		pipelineRunner: pipelineRunner,
@@ -86,6 +86,8 @@ func (cr *Cron) runPipeline() {
 	}
 }
 
-func cronRunner() *cron.Cron {
+// NewRunner returns a cron runner accepting the same schedules as cron jobs,
+// see utils.ValidateCronSchedule.
+func NewRunner() *cron.Cron {
 	return cron.New(cron.WithSeconds())
 }
@@ -73,7 +73,7 @@ func (d *Delegate) ServicesForSpec(ctx context.Context, jb job.Job) (services []
 	cfg := chain.Config()
 	strategy := txmgrcommon.NewQueueingTxStrategy(jb.ExternalJobID, cfg.FluxMonitor().DefaultTransactionQueueDepth(), cfg.Database().DefaultQueryTimeout())
 	var checker txmgr.TransmitCheckerSpec
-	if chain.Config().FluxMonitor().SimulateTransactions() {
+	if chain.Config().FluxMonitor().SimulateTransactions() || jb.SimulateTransactions {
 		checker.CheckerType = txmgr.TransmitCheckerTypeSimulate
 	}
 
@@ -163,15 +163,20 @@ type Job struct {
 	EALSpecID                     *int32
 	LiquidityBalancerSpec         *LiquidityBalancerSpec
 	LiquidityBalancerSpecID       *int32
+	WorkflowSpec                  *WorkflowSpec
+	WorkflowSpecID                *int32
 	PipelineSpecID                int32
 	PipelineSpec                  *pipeline.Spec
 	JobSpecErrors                 []SpecError
-	Type                          Type          `toml:"type"`
-	SchemaVersion                 uint32        `toml:"schemaVersion"`
-	GasLimit                      clnull.Uint32 `toml:"gasLimit"`
-	ForwardingAllowed             bool          `toml:"forwardingAllowed"`
-	Name                          null.String   `toml:"name"`
+	Type                          Type           `toml:"type"`
+	SchemaVersion                 uint32         `toml:"schemaVersion"`
+	GasLimit                      clnull.Uint32  `toml:"gasLimit"`
+	ForwardingAllowed             bool           `toml:"forwardingAllowed"`
+	SimulateTransactions          bool           `toml:"simulateTransactions"`
+	SigningKeys                   pq.StringArray `toml:"signingKeys"`
+	Name                          null.String    `toml:"name"`
 	MaxTaskDuration               models.Interval
+	ReaperThreshold               models.Interval   `toml:"reaperThreshold"`
 	Pipeline                      pipeline.Pipeline `toml:"observationSource"`
 	CreatedAt                     time.Time
 }
@@ -779,6 +784,22 @@ func (s *GatewaySpec) SetID(value string) error {
 	return nil
 }
 
+// WorkflowSpec defines the job spec for a workflow.
+type WorkflowSpec struct {
+	ID int32 `toml:"-"`
+	// Workflow is the YAML definition of the workflow.
+	Workflow string `toml:"workflow"`
+	// WorkflowOwner is the hex-encoded address of the workflow's owner.
+	WorkflowOwner string `toml:"workflowOwner"`
+	// WorkflowID is derived from the workflow and its owner when the spec is validated.
+	WorkflowID string `toml:"-"`
+	// AllowUnregisteredCapabilities lists the capability types the workflow may use
+	// before they are registered. It is only used when the spec is validated and is not persisted.
+	AllowUnregisteredCapabilities []string  `toml:"allowUnregisteredCapabilities" db:"-"`
+	CreatedAt                     time.Time `toml:"-"`
+	UpdatedAt                     time.Time `toml:"-"`
+}
+
 // EALSpec defines the job spec for the gas station.
 type EALSpec struct {
 	ID int32
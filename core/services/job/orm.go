@@ -443,7 +443,14 @@ func (o *orm) CreateJob(jb *Job, qopts ...pg.QOpt) error {
 		case Stream:
 			// 'stream' type has no associated spec, nothing to do here
 		case Workflow:
-			// 'workflow' type has no associated spec, nothing to do here
+			var specID int32
+			sql := `INSERT INTO workflow_specs (workflow, workflow_id, workflow_owner, created_at, updated_at)
+			VALUES (:workflow, :workflow_id, :workflow_owner, NOW(), NOW())
+			RETURNING id;`
+			if err := pg.PrepareQueryRowx(tx, sql, &specID, jb.WorkflowSpec); err != nil {
+				return errors.Wrap(err, "failed to create WorkflowSpec for jobSpec")
+			}
+			jb.WorkflowSpecID = &specID
 		default:
 			o.lggr.Panicf("Unsupported jb.Type: %v", jb.Type)
 		}
@@ -475,14 +482,24 @@ func ValidateKeyStoreMatch(ctx context.Context, spec *OCR2OracleSpec, keyStore k
 			err = errors.Errorf("no CSA key matching: %q", key)
 		}
 	default:
-		err = validateKeyStoreMatchForRelay(ctx, spec.Relay, keyStore, key)
+		err = validateKeyStoreMatchForRelay(ctx, spec, keyStore, key)
 	}
 	return
 }
 
-func validateKeyStoreMatchForRelay(ctx context.Context, network relay.Network, keyStore keystore.Master, key string) error {
-	switch network {
+func validateKeyStoreMatchForRelay(ctx context.Context, spec *OCR2OracleSpec, keyStore keystore.Master, key string) error {
+	switch spec.Relay {
 	case relay.EVM:
+		// the keys of a chain with a remote signer are the accounts of the remote signer
+		if chainID, err := spec.getChainID(); err == nil {
+			var id big.Big
+			if err = id.UnmarshalText([]byte(chainID)); err == nil && keystore.UsesEthSigner(keyStore.Eth(), id.ToInt()) {
+				if err = keyStore.Eth().CheckEnabled(ctx, common.HexToAddress(key), id.ToInt()); err != nil {
+					return errors.Errorf("no EVM key matching: %q: %v", key, err)
+				}
+				return nil
+			}
+		}
 		_, err := keyStore.Eth().Get(ctx, key)
 		if err != nil {
 			return errors.Errorf("no EVM key matching: %q", key)
@@ -540,18 +557,18 @@ func (o *orm) InsertJob(job *Job, qopts ...pg.QOpt) error {
 	if job.ID == 0 {
 		query = `INSERT INTO jobs (pipeline_spec_id, name, stream_id, schema_version, type, max_task_duration, ocr_oracle_spec_id, ocr2_oracle_spec_id, direct_request_spec_id, flux_monitor_spec_id,
 				keeper_spec_id, cron_spec_id, vrf_spec_id, webhook_spec_id, blockhash_store_spec_id, bootstrap_spec_id, block_header_feeder_spec_id, gateway_spec_id, 
-                legacy_gas_station_server_spec_id, legacy_gas_station_sidecar_spec_id, external_job_id, gas_limit, forwarding_allowed, created_at)
+                legacy_gas_station_server_spec_id, legacy_gas_station_sidecar_spec_id, workflow_spec_id, external_job_id, gas_limit, forwarding_allowed, simulate_transactions, signing_keys, reaper_threshold, created_at)
 		VALUES (:pipeline_spec_id, :name, :stream_id, :schema_version, :type, :max_task_duration, :ocr_oracle_spec_id, :ocr2_oracle_spec_id, :direct_request_spec_id, :flux_monitor_spec_id,
 				:keeper_spec_id, :cron_spec_id, :vrf_spec_id, :webhook_spec_id, :blockhash_store_spec_id, :bootstrap_spec_id, :block_header_feeder_spec_id, :gateway_spec_id, 
-		        :legacy_gas_station_server_spec_id, :legacy_gas_station_sidecar_spec_id, :external_job_id, :gas_limit, :forwarding_allowed, NOW())
+		        :legacy_gas_station_server_spec_id, :legacy_gas_station_sidecar_spec_id, :workflow_spec_id, :external_job_id, :gas_limit, :forwarding_allowed, :simulate_transactions, :signing_keys, :reaper_threshold, NOW())
 		RETURNING *;`
 	} else {
 		query = `INSERT INTO jobs (id, pipeline_spec_id, name, stream_id, schema_version, type, max_task_duration, ocr_oracle_spec_id, ocr2_oracle_spec_id, direct_request_spec_id, flux_monitor_spec_id,
 			keeper_spec_id, cron_spec_id, vrf_spec_id, webhook_spec_id, blockhash_store_spec_id, bootstrap_spec_id, block_header_feeder_spec_id, gateway_spec_id, 
-                  legacy_gas_station_server_spec_id, legacy_gas_station_sidecar_spec_id, external_job_id, gas_limit, forwarding_allowed, created_at)
+                  legacy_gas_station_server_spec_id, legacy_gas_station_sidecar_spec_id, workflow_spec_id, external_job_id, gas_limit, forwarding_allowed, simulate_transactions, signing_keys, reaper_threshold, created_at)
 		VALUES (:id, :pipeline_spec_id, :name, :stream_id, :schema_version, :type, :max_task_duration, :ocr_oracle_spec_id, :ocr2_oracle_spec_id, :direct_request_spec_id, :flux_monitor_spec_id,
 				:keeper_spec_id, :cron_spec_id, :vrf_spec_id, :webhook_spec_id, :blockhash_store_spec_id, :bootstrap_spec_id, :block_header_feeder_spec_id, :gateway_spec_id, 
-				:legacy_gas_station_server_spec_id, :legacy_gas_station_sidecar_spec_id, :external_job_id, :gas_limit, :forwarding_allowed, NOW())
+				:legacy_gas_station_server_spec_id, :legacy_gas_station_sidecar_spec_id, :workflow_spec_id, :external_job_id, :gas_limit, :forwarding_allowed, :simulate_transactions, :signing_keys, :reaper_threshold, NOW())
 		RETURNING *;`
 	}
 	return q.GetNamed(query, job, job)
@@ -580,7 +597,8 @@ func (o *orm) DeleteJob(id int32, qopts ...pg.QOpt) error {
 				blockhash_store_spec_id,
 				bootstrap_spec_id,
 				block_header_feeder_spec_id,
-				gateway_spec_id
+				gateway_spec_id,
+				workflow_spec_id
 		),
 		deleted_oracle_specs AS (
 			DELETE FROM ocr_oracle_specs WHERE id IN (SELECT ocr_oracle_spec_id FROM deleted_jobs)
@@ -617,6 +635,9 @@ func (o *orm) DeleteJob(id int32, qopts ...pg.QOpt) error {
 		),
 		deleted_gateway_specs AS (
 			DELETE FROM gateway_specs WHERE id IN (SELECT gateway_spec_id FROM deleted_jobs)
+		),
+		deleted_workflow_specs AS (
+			DELETE FROM workflow_specs WHERE id IN (SELECT workflow_spec_id FROM deleted_jobs)
 		)
 		DELETE FROM pipeline_specs WHERE id IN (SELECT pipeline_spec_id FROM deleted_jobs)`
 	res, cancel, err := q.ExecQIter(query, id)
@@ -1200,7 +1221,7 @@ func (o *orm) loadPipelineRunsRelations(runs []pipeline.Run, tx pg.Queryer) ([]p
 	for specID := range specM {
 		specIDs = append(specIDs, specID)
 	}
-	stmt := `SELECT pipeline_specs.*, jobs.id AS job_id FROM pipeline_specs JOIN jobs ON pipeline_specs.id = jobs.pipeline_spec_id WHERE pipeline_specs.id = ANY($1);`
+	stmt := `SELECT pipeline_specs.*, jobs.id AS job_id, jobs.simulate_transactions, jobs.signing_keys FROM pipeline_specs JOIN jobs ON pipeline_specs.id = jobs.pipeline_spec_id WHERE pipeline_specs.id = ANY($1);`
 	var specs []pipeline.Spec
 	if err := o.q.Select(&specs, stmt, specIDs); err != nil {
 		return nil, errors.Wrap(err, "error loading specs")
@@ -1262,6 +1283,7 @@ func LoadAllJobTypes(tx pg.Queryer, job *Job) error {
 		loadJobType(tx, job, "LegacyGasStationSidecarSpec", "legacy_gas_station_sidecar_specs", job.LegacyGasStationSidecarSpecID),
 		loadJobType(tx, job, "BootstrapSpec", "bootstrap_specs", job.BootstrapSpecID),
 		loadJobType(tx, job, "GatewaySpec", "gateway_specs", job.GatewaySpecID),
+		loadJobType(tx, job, "WorkflowSpec", "workflow_specs", job.WorkflowSpecID),
 	)
 }
 
@@ -3,6 +3,7 @@ package job
 import (
 	"strings"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/pelletier/go-toml"
 	"github.com/pkg/errors"
 )
@@ -65,6 +66,14 @@ func ValidateSpec(ts string) (Type, error) {
 	if jb.Pipeline.RequiresPreInsert() && !jb.Type.SupportsAsync() {
 		return "", errors.Errorf("async=true tasks are not supported for %v", jb.Type)
 	}
+	for _, key := range jb.SigningKeys {
+		if !common.IsHexAddress(key) {
+			return "", errors.Errorf("invalid signing key %q, expected an eth address", key)
+		}
+	}
+	if jb.ReaperThreshold.Duration() < 0 {
+		return "", errors.Errorf("reaperThreshold must not be negative, got %s", jb.ReaperThreshold.Duration())
+	}
 	// spec.CustomRevertsPipelineEnabled == false, default is custom reverted txns pipeline disabled
 
 	if strings.Contains(ts, "<{}>") {
@@ -90,6 +90,34 @@ ds [type=bridge async=true]
 				require.Error(t, err)
 			},
 		},
+		{
+			name: "invalid signing key",
+			spec: `
+type="webhook"
+schemaVersion=1
+signingKeys=["0x0000000000000000000000000000000000000001", "not-an-address"]
+observationSource="""
+ds [type=sign]
+"""
+`,
+			assertion: func(t *testing.T, err error) {
+				require.ErrorContains(t, err, `invalid signing key "not-an-address"`)
+			},
+		},
+		{
+			name: "negative reaper threshold",
+			spec: `
+type="webhook"
+schemaVersion=1
+reaperThreshold="-1h"
+observationSource="""
+ds [type=http]
+"""
+`,
+			assertion: func(t *testing.T, err error) {
+				require.ErrorContains(t, err, "reaperThreshold must not be negative")
+			},
+		},
 		{
 			name: "happy path",
 			spec: `
@@ -0,0 +1,141 @@
+package keystore
+
+import (
+	"context"
+	"math/big"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/pkg/errors"
+)
+
+// EthSigner holds the sending keys of a chain outside of the keystore, e.g. a remote signer, and signs its
+// transactions with them.
+type EthSigner interface {
+	EnabledAddressesForChain(ctx context.Context, chainID *big.Int) ([]common.Address, error)
+	CheckEnabled(ctx context.Context, address common.Address, chainID *big.Int) error
+	SignTx(ctx context.Context, fromAddress common.Address, tx *types.Transaction, chainID *big.Int) (*types.Transaction, error)
+}
+
+// NewWithEthSigners returns ks with an Eth keystore which selects, checks and signs with the keys of signers, keyed by
+// chain ID, for their chains instead of its own keys, so that the jobs and the transaction manager of such a chain
+// send from the same keys.
+func NewWithEthSigners(ks Master, signers map[string]EthSigner) Master {
+	if len(signers) == 0 {
+		return ks
+	}
+	return &masterWithEthSigners{
+		Master: ks,
+		eth: &ethWithSigners{
+			Eth:      ks.Eth(),
+			signers:  signers,
+			lastUsed: make(map[string]map[common.Address]time.Time),
+		},
+	}
+}
+
+// UsesEthSigner tells whether ks selects, checks and signs with the keys of an EthSigner for the chain with chainID,
+// instead of its own keys.
+func UsesEthSigner(ks Eth, chainID *big.Int) bool {
+	ksWithSigners, ok := ks.(*ethWithSigners)
+	if !ok {
+		return false
+	}
+	_, ok = ksWithSigners.signer(chainID)
+	return ok
+}
+
+type masterWithEthSigners struct {
+	Master
+	eth *ethWithSigners
+}
+
+func (ks *masterWithEthSigners) Eth() Eth {
+	return ks.eth
+}
+
+type ethWithSigners struct {
+	Eth
+	signers map[string]EthSigner
+
+	mu       sync.Mutex
+	lastUsed map[string]map[common.Address]time.Time
+}
+
+var _ Eth = &ethWithSigners{}
+
+func (ks *ethWithSigners) signer(chainID *big.Int) (EthSigner, bool) {
+	if chainID == nil {
+		return nil, false
+	}
+	signer, ok := ks.signers[chainID.String()]
+	return signer, ok
+}
+
+func (ks *ethWithSigners) SignTx(ctx context.Context, fromAddress common.Address, tx *types.Transaction, chainID *big.Int) (*types.Transaction, error) {
+	if signer, ok := ks.signer(chainID); ok {
+		return signer.SignTx(ctx, fromAddress, tx, chainID)
+	}
+	return ks.Eth.SignTx(ctx, fromAddress, tx, chainID)
+}
+
+func (ks *ethWithSigners) CheckEnabled(ctx context.Context, address common.Address, chainID *big.Int) error {
+	if signer, ok := ks.signer(chainID); ok {
+		return signer.CheckEnabled(ctx, address, chainID)
+	}
+	return ks.Eth.CheckEnabled(ctx, address, chainID)
+}
+
+func (ks *ethWithSigners) EnabledAddressesForChain(ctx context.Context, chainID *big.Int) ([]common.Address, error) {
+	if signer, ok := ks.signer(chainID); ok {
+		return signer.EnabledAddressesForChain(ctx, chainID)
+	}
+	return ks.Eth.EnabledAddressesForChain(ctx, chainID)
+}
+
+// GetRoundRobinAddress returns the least recently used of the keys of the signer of the chain, if it has one, which
+// are in whitelist, if given.
+func (ks *ethWithSigners) GetRoundRobinAddress(ctx context.Context, chainID *big.Int, whitelist ...common.Address) (common.Address, error) {
+	signer, ok := ks.signer(chainID)
+	if !ok {
+		return ks.Eth.GetRoundRobinAddress(ctx, chainID, whitelist...)
+	}
+	addresses, err := signer.EnabledAddressesForChain(ctx, chainID)
+	if err != nil {
+		return common.Address{}, err
+	}
+	if len(whitelist) > 0 {
+		var whitelisted []common.Address
+		for _, address := range addresses {
+			if slices.Contains(whitelist, address) {
+				whitelisted = append(whitelisted, address)
+			}
+		}
+		addresses = whitelisted
+	}
+	if len(addresses) == 0 {
+		if len(whitelist) == 0 {
+			return common.Address{}, errors.Errorf("no sending keys available for chain %s", chainID.String())
+		}
+		return common.Address{}, errors.Errorf("no sending keys available for chain %s that match whitelist: %v", chainID, whitelist)
+	}
+
+	ks.mu.Lock()
+	defer ks.mu.Unlock()
+	lastUsed, ok := ks.lastUsed[chainID.String()]
+	if !ok {
+		lastUsed = make(map[common.Address]time.Time)
+		ks.lastUsed[chainID.String()] = lastUsed
+	}
+	leastRecentlyUsed := addresses[0]
+	for _, address := range addresses[1:] {
+		if lastUsed[address].Before(lastUsed[leastRecentlyUsed]) {
+			leastRecentlyUsed = address
+		}
+	}
+	lastUsed[leastRecentlyUsed] = time.Now()
+	return leastRecentlyUsed, nil
+}
@@ -0,0 +1,91 @@
+package keystore_test
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"slices"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/mocks"
+)
+
+type fakeEthSigner struct {
+	addresses []common.Address
+}
+
+func (s *fakeEthSigner) EnabledAddressesForChain(ctx context.Context, chainID *big.Int) ([]common.Address, error) {
+	return s.addresses, nil
+}
+
+func (s *fakeEthSigner) CheckEnabled(ctx context.Context, address common.Address, chainID *big.Int) error {
+	if !slices.Contains(s.addresses, address) {
+		return fmt.Errorf("no key for address %s", address)
+	}
+	return nil
+}
+
+func (s *fakeEthSigner) SignTx(ctx context.Context, fromAddress common.Address, tx *types.Transaction, chainID *big.Int) (*types.Transaction, error) {
+	return tx, nil
+}
+
+func TestNewWithEthSigners(t *testing.T) {
+	t.Parallel()
+	ctx := testutils.Context(t)
+
+	local, remote := big.NewInt(1), big.NewInt(2)
+	localAddress := testutils.NewAddress()
+	remoteAddresses := []common.Address{testutils.NewAddress(), testutils.NewAddress(), testutils.NewAddress()}
+
+	master := mocks.NewMaster(t)
+	assert.Equal(t, master, keystore.NewWithEthSigners(master, nil))
+
+	eth := mocks.NewEth(t)
+	master.On("Eth").Return(eth)
+	ks := keystore.NewWithEthSigners(master, map[string]keystore.EthSigner{remote.String(): &fakeEthSigner{addresses: remoteAddresses}})
+	assert.True(t, keystore.UsesEthSigner(ks.Eth(), remote))
+	assert.False(t, keystore.UsesEthSigner(ks.Eth(), local))
+	assert.False(t, keystore.UsesEthSigner(eth, remote))
+
+	t.Run("chain without a signer", func(t *testing.T) {
+		eth.On("GetRoundRobinAddress", ctx, local).Return(localAddress, nil).Once()
+		address, err := ks.Eth().GetRoundRobinAddress(ctx, local)
+		require.NoError(t, err)
+		assert.Equal(t, localAddress, address)
+
+		eth.On("CheckEnabled", ctx, localAddress, local).Return(nil).Once()
+		require.NoError(t, ks.Eth().CheckEnabled(ctx, localAddress, local))
+	})
+
+	t.Run("chain with a signer", func(t *testing.T) {
+		addresses, err := ks.Eth().EnabledAddressesForChain(ctx, remote)
+		require.NoError(t, err)
+		assert.Equal(t, remoteAddresses, addresses)
+
+		require.NoError(t, ks.Eth().CheckEnabled(ctx, remoteAddresses[0], remote))
+		require.Error(t, ks.Eth().CheckEnabled(ctx, localAddress, remote))
+
+		// the keys are used in turn
+		var used []common.Address
+		for range remoteAddresses {
+			address, err := ks.Eth().GetRoundRobinAddress(ctx, remote)
+			require.NoError(t, err)
+			used = append(used, address)
+		}
+		assert.ElementsMatch(t, remoteAddresses, used)
+
+		address, err := ks.Eth().GetRoundRobinAddress(ctx, remote, localAddress, remoteAddresses[1])
+		require.NoError(t, err)
+		assert.Equal(t, remoteAddresses[1], address)
+
+		_, err = ks.Eth().GetRoundRobinAddress(ctx, remote, localAddress)
+		require.ErrorContains(t, err, "no sending keys available for chain 2 that match whitelist")
+	})
+}
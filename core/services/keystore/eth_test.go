@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
@@ -360,6 +361,29 @@ func Test_EthKeyStore_SignTx(t *testing.T) {
 	require.NotEqual(t, tx, signed)
 }
 
+func Test_EthKeyStore_SignHash(t *testing.T) {
+	t.Parallel()
+
+	ctx := testutils.Context(t)
+
+	db := pgtest.NewSqlxDB(t)
+	config := configtest.NewTestGeneralConfig(t)
+	keyStore := cltest.NewKeyStore(t, db, config.Database())
+	ethKeyStore := keyStore.Eth()
+
+	k, _ := cltest.MustInsertRandomKey(t, ethKeyStore)
+	hash := crypto.Keccak256Hash([]byte("report"))
+
+	_, err := ethKeyStore.SignHash(ctx, testutils.NewAddress(), hash)
+	require.EqualError(t, err, "Key not found")
+
+	signature, err := ethKeyStore.SignHash(ctx, k.Address, hash)
+	require.NoError(t, err)
+	pubKey, err := crypto.SigToPub(hash.Bytes(), signature)
+	require.NoError(t, err)
+	assert.Equal(t, k.Address, crypto.PubkeyToAddress(*pubKey))
+}
+
 func Test_EthKeyStore_E2E(t *testing.T) {
 	t.Parallel()
 
@@ -539,6 +539,36 @@ func (_m *Eth) Import(ctx context.Context, keyJSON []byte, password string, chai
 	return r0, r1
 }
 
+// SignHash provides a mock function with given fields: ctx, address, hash
+func (_m *Eth) SignHash(ctx context.Context, address common.Address, hash common.Hash) ([]byte, error) {
+	ret := _m.Called(ctx, address, hash)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SignHash")
+	}
+
+	var r0 []byte
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, common.Address, common.Hash) ([]byte, error)); ok {
+		return rf(ctx, address, hash)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, common.Address, common.Hash) []byte); ok {
+		r0 = rf(ctx, address, hash)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]byte)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, common.Address, common.Hash) error); ok {
+		r1 = rf(ctx, address, hash)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // SignTx provides a mock function with given fields: ctx, fromAddress, tx, chainID
 func (_m *Eth) SignTx(ctx context.Context, fromAddress common.Address, tx *types.Transaction, chainID *big.Int) (*types.Transaction, error) {
 	ret := _m.Called(ctx, fromAddress, tx, chainID)
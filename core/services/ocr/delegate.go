@@ -202,7 +202,7 @@ func (d *Delegate) ServicesForSpec(ctx context.Context, jb job.Job) (services []
 		strategy := txmgrcommon.NewQueueingTxStrategy(jb.ExternalJobID, cfg.OCR().DefaultTransactionQueueDepth(), cfg.Database().DefaultQueryTimeout())
 
 		var checker txmgr.TransmitCheckerSpec
-		if chain.Config().OCR().SimulateTransactions() {
+		if chain.Config().OCR().SimulateTransactions() || jb.SimulateTransactions {
 			checker.CheckerType = txmgr.TransmitCheckerTypeSimulate
 		}
 
@@ -392,6 +392,7 @@ func (d *Delegate) ServicesForSpec(ctx context.Context, jb job.Job) ([]job.Servi
 		if err2 != nil {
 			return nil, fmt.Errorf("ServicesForSpec failed to get evm transmitterID: %w", err2)
 		}
+		spec.RelayConfig["simulateTransactions"] = jb.SimulateTransactions
 	}
 	spec.RelayConfig["effectiveTransmitterID"] = effectiveTransmitterID
 
@@ -10,6 +10,7 @@ import (
 //go:generate mockery --quiet --name Peer --output ./mocks/ --case=underscore
 type Peer interface {
 	services.Service
+	ID() ragetypes.PeerID
 	UpdateConnections(peers map[ragetypes.PeerID]StreamConfig) error
 	Send(peerID ragetypes.PeerID, msg []byte) error
 	Receive() <-chan Message
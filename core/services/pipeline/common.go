@@ -71,6 +71,7 @@ type (
 		MaxRunDuration() time.Duration
 		ReaperInterval() time.Duration
 		ReaperThreshold() time.Duration
+		ReaperThresholdByJobType() map[string]time.Duration
 	}
 
 	BridgeConfig interface {
@@ -108,6 +109,10 @@ const (
 type RunInfo struct {
 	IsRetryable bool
 	IsPending   bool
+	// PendingTimeout, if set, fails a pending task that has not been resumed within it.
+	PendingTimeout time.Duration
+	// ResumeBridgeName, if set, is the bridge whose outgoing token must sign the result resuming a pending task.
+	ResumeBridgeName string
 }
 
 // retryableMeta should be returned if the error is non-deterministic; i.e. a
@@ -374,6 +379,7 @@ func (t TaskType) String() string {
 }
 
 const (
+	TaskTypeAbs              TaskType = "abs"
 	TaskTypeAny              TaskType = "any"
 	TaskTypeBase64Decode     TaskType = "base64decode"
 	TaskTypeBase64Encode     TaskType = "base64encode"
@@ -387,20 +393,32 @@ const (
 	TaskTypeETHABIEncode2    TaskType = "ethabiencode2"
 	TaskTypeETHCall          TaskType = "ethcall"
 	TaskTypeETHTx            TaskType = "ethtx"
+	TaskTypeEquals           TaskType = "equals"
 	TaskTypeEstimateGasLimit TaskType = "estimategaslimit"
+	TaskTypeExpr             TaskType = "expr"
+	TaskTypeGreaterThan      TaskType = "greaterthan"
 	TaskTypeHTTP             TaskType = "http"
 	TaskTypeHexDecode        TaskType = "hexdecode"
 	TaskTypeHexEncode        TaskType = "hexencode"
+	TaskTypeJSONEncode       TaskType = "jsonencode"
 	TaskTypeJSONParse        TaskType = "jsonparse"
+	TaskTypeJSONSet          TaskType = "jsonset"
+	TaskTypeKeccak256        TaskType = "keccak256"
 	TaskTypeLength           TaskType = "length"
 	TaskTypeLessThan         TaskType = "lessthan"
 	TaskTypeLookup           TaskType = "lookup"
 	TaskTypeLowercase        TaskType = "lowercase"
+	TaskTypeMax              TaskType = "max"
 	TaskTypeMean             TaskType = "mean"
 	TaskTypeMedian           TaskType = "median"
 	TaskTypeMerge            TaskType = "merge"
+	TaskTypeMin              TaskType = "min"
 	TaskTypeMode             TaskType = "mode"
 	TaskTypeMultiply         TaskType = "multiply"
+	TaskTypeRound            TaskType = "round"
+	TaskTypeSHA256           TaskType = "sha256"
+	TaskTypeSign             TaskType = "sign"
+	TaskTypeSubtract         TaskType = "subtract"
 	TaskTypeSum              TaskType = "sum"
 	TaskTypeUppercase        TaskType = "uppercase"
 	TaskTypeVRF              TaskType = "vrf"
@@ -448,10 +466,24 @@ func UnmarshalTaskFromMap(taskType TaskType, taskMap interface{}, ID int, dotID
 		task = &ModeTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
 	case TaskTypeSum:
 		task = &SumTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
+	case TaskTypeSubtract:
+		task = &SubtractTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
+	case TaskTypeMin:
+		task = &MinTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
+	case TaskTypeMax:
+		task = &MaxTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
+	case TaskTypeAbs:
+		task = &AbsTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
+	case TaskTypeRound:
+		task = &RoundTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
 	case TaskTypeAny:
 		task = &AnyTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
 	case TaskTypeJSONParse:
 		task = &JSONParseTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
+	case TaskTypeJSONEncode:
+		task = &JSONEncodeTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
+	case TaskTypeJSONSet:
+		task = &JSONSetTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
 	case TaskTypeMemo:
 		task = &MemoTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
 	case TaskTypeMultiply:
@@ -488,6 +520,12 @@ func UnmarshalTaskFromMap(taskType TaskType, taskMap interface{}, ID int, dotID
 		task = &LengthTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
 	case TaskTypeLessThan:
 		task = &LessThanTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
+	case TaskTypeGreaterThan:
+		task = &GreaterThanTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
+	case TaskTypeEquals:
+		task = &EqualsTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
+	case TaskTypeExpr:
+		task = &ExprTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
 	case TaskTypeLookup:
 		task = &LookupTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
 	case TaskTypeLowercase:
@@ -504,6 +542,12 @@ func UnmarshalTaskFromMap(taskType TaskType, taskMap interface{}, ID int, dotID
 		task = &Base64DecodeTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
 	case TaskTypeBase64Encode:
 		task = &Base64EncodeTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
+	case TaskTypeKeccak256:
+		task = &Keccak256Task{BaseTask: BaseTask{id: ID, dotID: dotID}}
+	case TaskTypeSHA256:
+		task = &SHA256Task{BaseTask: BaseTask{id: ID, dotID: dotID}}
+	case TaskTypeSign:
+		task = &SignTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
 	default:
 		return nil, pkgerrors.Errorf(`unknown task type: "%v"`, taskType)
 	}
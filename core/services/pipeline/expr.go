@@ -0,0 +1,616 @@
+package pipeline
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/shopspring/decimal"
+)
+
+// The expression language of the expr task. It has no loops, assignments or IO: an expression
+// is parsed into a tree which is evaluated once, so its execution is bounded by its length.
+//
+// Values are decimals, booleans or strings, e.g.
+//
+//	($(ds1) - $(ds2)) / $(ds2) * 100 > 0.5 && $(ds1) != 0 ? "deviated" : 'ok'
+//
+// Operators, from lowest to highest precedence:
+//
+//	?:  ||  &&  == !=  < <= > >=  + -  * / %  unary - !
+//
+// Functions: abs(x), ceil(x), floor(x), round(x[, places]), min(x, ...) and max(x, ...).
+//
+// Strings are converted to decimals when used as numbers, so the string output of a jsonparse
+// task can be used in arithmetic. == and != compare numerically unless both sides are strings.
+//
+// In the filters of a Selector, @ is the element being filtered, e.g. @.price > 10.
+
+const (
+	exprMaxLength = 4096
+	exprMaxDepth  = 64
+	// exprMaxExponent bounds the exponents of decimals, since operations on decimals with very
+	// different exponents allocate coefficients with as many digits as the difference.
+	exprMaxExponent = 1000
+	// exprMaxDigits bounds the digits of the coefficients of decimals, since every multiplication
+	// may double them.
+	exprMaxDigits = 1000
+)
+
+var ErrExpression = errors.New("invalid expression")
+
+type exprTokenKind int
+
+const (
+	exprTokenEOF exprTokenKind = iota
+	exprTokenNumber
+	exprTokenString
+	exprTokenVariable
+	exprTokenIdent
+	exprTokenOperator
+)
+
+type exprToken struct {
+	kind  exprTokenKind
+	text  string
+	value interface{}
+	pos   int
+}
+
+func (t exprToken) String() string {
+	if t.kind == exprTokenEOF {
+		return "end of expression"
+	}
+	return fmt.Sprintf("%q at position %d", t.text, t.pos)
+}
+
+var exprOperators = []string{"&&", "||", "==", "!=", "<=", ">=", "<", ">", "+", "-", "*", "/", "%", "!", "?", ":", "(", ")", ","}
+
+func tokenizeExpr(s string) ([]exprToken, error) {
+	var tokens []exprToken
+	for i := 0; i < len(s); {
+		c := s[i]
+		switch {
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r':
+			i++
+		case c >= '0' && c <= '9' || c == '.':
+			start := i
+			for i < len(s) && (s[i] >= '0' && s[i] <= '9' || s[i] == '.') {
+				i++
+			}
+			if i < len(s) && (s[i] == 'e' || s[i] == 'E') {
+				i++
+				if i < len(s) && (s[i] == '+' || s[i] == '-') {
+					i++
+				}
+				for i < len(s) && s[i] >= '0' && s[i] <= '9' {
+					i++
+				}
+			}
+			d, err := decimal.NewFromString(s[start:i])
+			if err != nil {
+				return nil, errors.Wrapf(ErrExpression, "invalid number %q at position %d", s[start:i], start)
+			}
+			if d, err = exprCheckDecimal(d); err != nil {
+				return nil, err
+			}
+			tokens = append(tokens, exprToken{kind: exprTokenNumber, text: s[start:i], value: d, pos: start})
+		case c == '"' || c == '\'':
+			start := i
+			end := strings.IndexByte(s[i+1:], c)
+			if end < 0 {
+				return nil, errors.Wrapf(ErrExpression, "unterminated string at position %d", start)
+			}
+			i += end + 2
+			tokens = append(tokens, exprToken{kind: exprTokenString, text: s[start:i], value: s[start+1 : i-1], pos: start})
+		case c == '$':
+			loc := variableRegexp.FindStringSubmatchIndex(s[i:])
+			if loc == nil || loc[0] != 0 {
+				return nil, errors.Wrapf(ErrExpression, "invalid variable at position %d", i)
+			}
+			tokens = append(tokens, exprToken{kind: exprTokenVariable, text: s[i : i+loc[1]], value: s[i+loc[2] : i+loc[3]], pos: i})
+			i += loc[1]
+		case c == '@':
+			start := i
+			for i++; i < len(s) && s[i] == '.'; {
+				end := i + 1
+				for end < len(s) && (s[end] >= 'a' && s[end] <= 'z' || s[end] >= 'A' && s[end] <= 'Z' || s[end] >= '0' && s[end] <= '9' || s[end] == '_') {
+					end++
+				}
+				if end == i+1 {
+					return nil, errors.Wrapf(ErrExpression, "invalid element reference at position %d", start)
+				}
+				i = end
+			}
+			tokens = append(tokens, exprToken{kind: exprTokenVariable, text: s[start:i], value: s[start:i], pos: start})
+		case c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c == '_':
+			start := i
+			for i < len(s) && (s[i] >= 'a' && s[i] <= 'z' || s[i] >= 'A' && s[i] <= 'Z' || s[i] >= '0' && s[i] <= '9' || s[i] == '_') {
+				i++
+			}
+			tokens = append(tokens, exprToken{kind: exprTokenIdent, text: s[start:i], pos: start})
+		default:
+			var op string
+			for _, o := range exprOperators {
+				if strings.HasPrefix(s[i:], o) {
+					op = o
+					break
+				}
+			}
+			if op == "" {
+				return nil, errors.Wrapf(ErrExpression, "unexpected character %q at position %d", c, i)
+			}
+			tokens = append(tokens, exprToken{kind: exprTokenOperator, text: op, pos: i})
+			i += len(op)
+		}
+	}
+	return append(tokens, exprToken{kind: exprTokenEOF, pos: len(s)}), nil
+}
+
+type exprNode interface {
+	eval(e *exprEvaluator) (interface{}, error)
+}
+
+type (
+	exprLiteral struct {
+		value interface{}
+	}
+	exprVariable struct {
+		keypath string
+	}
+	exprUnary struct {
+		op      string
+		operand exprNode
+	}
+	exprBinary struct {
+		op          string
+		left, right exprNode
+	}
+	exprTernary struct {
+		cond, then, otherwise exprNode
+	}
+	exprCall struct {
+		name string
+		args []exprNode
+	}
+)
+
+// Expression is a parsed expr task expression.
+type Expression struct {
+	root exprNode
+}
+
+// ParseExpression parses an expression of the language described above.
+func ParseExpression(s string) (*Expression, error) {
+	if len(s) > exprMaxLength {
+		return nil, errors.Wrapf(ErrExpression, "expression is longer than %d characters", exprMaxLength)
+	}
+	tokens, err := tokenizeExpr(s)
+	if err != nil {
+		return nil, err
+	}
+	p := exprParser{tokens: tokens}
+	root, err := p.parseTernary()
+	if err != nil {
+		return nil, err
+	}
+	if tok := p.peek(); tok.kind != exprTokenEOF {
+		return nil, errors.Wrapf(ErrExpression, "unexpected %s", tok)
+	}
+	return &Expression{root: root}, nil
+}
+
+// Evaluate evaluates the expression against vars, returning a decimal.Decimal, bool or string.
+// If precision is set, divisions are rounded to that many decimal places.
+func (x *Expression) Evaluate(vars Vars, precision *int32) (interface{}, error) {
+	if precision != nil && (*precision > exprMaxExponent || *precision < -exprMaxExponent) {
+		return nil, errors.Wrapf(ErrBadInput, "precision %d is out of range", *precision)
+	}
+	return x.root.eval(&exprEvaluator{vars: vars, precision: precision})
+}
+
+type exprParser struct {
+	tokens []exprToken
+	pos    int
+	depth  int
+}
+
+func (p *exprParser) peek() exprToken {
+	return p.tokens[p.pos]
+}
+
+func (p *exprParser) next() exprToken {
+	tok := p.tokens[p.pos]
+	if tok.kind != exprTokenEOF {
+		p.pos++
+	}
+	return tok
+}
+
+func (p *exprParser) accept(ops ...string) (string, bool) {
+	tok := p.peek()
+	if tok.kind != exprTokenOperator {
+		return "", false
+	}
+	for _, op := range ops {
+		if tok.text == op {
+			p.pos++
+			return op, true
+		}
+	}
+	return "", false
+}
+
+func (p *exprParser) expect(op string) error {
+	if _, ok := p.accept(op); !ok {
+		return errors.Wrapf(ErrExpression, "expected %q, got %s", op, p.peek())
+	}
+	return nil
+}
+
+func (p *exprParser) parseTernary() (exprNode, error) {
+	p.depth++
+	defer func() { p.depth-- }()
+	if p.depth > exprMaxDepth {
+		return nil, errors.Wrapf(ErrExpression, "expression is nested deeper than %d levels", exprMaxDepth)
+	}
+
+	cond, err := p.parseBinary(0)
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := p.accept("?"); !ok {
+		return cond, nil
+	}
+	then, err := p.parseTernary()
+	if err != nil {
+		return nil, err
+	}
+	if err = p.expect(":"); err != nil {
+		return nil, err
+	}
+	otherwise, err := p.parseTernary()
+	if err != nil {
+		return nil, err
+	}
+	return exprTernary{cond: cond, then: then, otherwise: otherwise}, nil
+}
+
+// exprBinaryOperators lists the binary operators by increasing precedence.
+var exprBinaryOperators = [][]string{
+	{"||"},
+	{"&&"},
+	{"==", "!="},
+	{"<", "<=", ">", ">="},
+	{"+", "-"},
+	{"*", "/", "%"},
+}
+
+func (p *exprParser) parseBinary(level int) (exprNode, error) {
+	if level == len(exprBinaryOperators) {
+		return p.parseUnary()
+	}
+	left, err := p.parseBinary(level + 1)
+	if err != nil {
+		return nil, err
+	}
+	for {
+		op, ok := p.accept(exprBinaryOperators[level]...)
+		if !ok {
+			return left, nil
+		}
+		right, err := p.parseBinary(level + 1)
+		if err != nil {
+			return nil, err
+		}
+		left = exprBinary{op: op, left: left, right: right}
+	}
+}
+
+func (p *exprParser) parseUnary() (exprNode, error) {
+	op, ok := p.accept("-", "!")
+	if !ok {
+		return p.parsePrimary()
+	}
+	p.depth++
+	defer func() { p.depth-- }()
+	if p.depth > exprMaxDepth {
+		return nil, errors.Wrapf(ErrExpression, "expression is nested deeper than %d levels", exprMaxDepth)
+	}
+	operand, err := p.parseUnary()
+	if err != nil {
+		return nil, err
+	}
+	return exprUnary{op: op, operand: operand}, nil
+}
+
+func (p *exprParser) parsePrimary() (exprNode, error) {
+	tok := p.next()
+	switch tok.kind {
+	case exprTokenNumber, exprTokenString:
+		return exprLiteral{value: tok.value}, nil
+	case exprTokenVariable:
+		return exprVariable{keypath: tok.value.(string)}, nil
+	case exprTokenIdent:
+		switch tok.text {
+		case "true":
+			return exprLiteral{value: true}, nil
+		case "false":
+			return exprLiteral{value: false}, nil
+		}
+		if _, ok := exprFunctions[tok.text]; !ok {
+			return nil, errors.Wrapf(ErrExpression, "unknown function %s", tok)
+		}
+		if err := p.expect("("); err != nil {
+			return nil, err
+		}
+		call := exprCall{name: tok.text}
+		if _, ok := p.accept(")"); ok {
+			return call, nil
+		}
+		for {
+			arg, err := p.parseTernary()
+			if err != nil {
+				return nil, err
+			}
+			call.args = append(call.args, arg)
+			if _, ok := p.accept(","); !ok {
+				break
+			}
+		}
+		if err := p.expect(")"); err != nil {
+			return nil, err
+		}
+		return call, nil
+	case exprTokenOperator:
+		if tok.text == "(" {
+			node, err := p.parseTernary()
+			if err != nil {
+				return nil, err
+			}
+			if err = p.expect(")"); err != nil {
+				return nil, err
+			}
+			return node, nil
+		}
+	}
+	return nil, errors.Wrapf(ErrExpression, "unexpected %s", tok)
+}
+
+type exprEvaluator struct {
+	vars      Vars
+	precision *int32
+}
+
+func (e *exprEvaluator) decimal(node exprNode) (decimal.Decimal, error) {
+	v, err := node.eval(e)
+	if err != nil {
+		return decimal.Decimal{}, err
+	}
+	return exprToDecimal(v)
+}
+
+func (e *exprEvaluator) bool(node exprNode) (bool, error) {
+	v, err := node.eval(e)
+	if err != nil {
+		return false, err
+	}
+	b, ok := v.(bool)
+	if !ok {
+		return false, errors.Wrapf(ErrBadInput, "expected a boolean, got %v", v)
+	}
+	return b, nil
+}
+
+func exprToDecimal(v interface{}) (decimal.Decimal, error) {
+	var d decimal.Decimal
+	switch v := v.(type) {
+	case decimal.Decimal:
+		d = v
+	case string:
+		var err error
+		if d, err = decimal.NewFromString(v); err != nil {
+			return decimal.Decimal{}, errors.Wrapf(ErrBadInput, "expected a number, got %q", v)
+		}
+	default:
+		return decimal.Decimal{}, errors.Wrapf(ErrBadInput, "expected a number, got %v", v)
+	}
+	return exprCheckDecimal(d)
+}
+
+func exprCheckDecimal(d decimal.Decimal) (decimal.Decimal, error) {
+	if exp := d.Exponent(); exp > exprMaxExponent || exp < -exprMaxExponent {
+		return decimal.Decimal{}, errors.Wrapf(ErrBadInput, "number with exponent %d is out of range", exp)
+	}
+	if c := d.Coefficient(); len(c.Abs(c).String()) > exprMaxDigits {
+		return decimal.Decimal{}, errors.Wrapf(ErrBadInput, "number with more than %d digits is out of range", exprMaxDigits)
+	}
+	return d, nil
+}
+
+func (n exprLiteral) eval(*exprEvaluator) (interface{}, error) {
+	return n.value, nil
+}
+
+func (n exprVariable) eval(e *exprEvaluator) (interface{}, error) {
+	val, err := e.vars.Get(n.keypath)
+	if err != nil {
+		return nil, err
+	}
+	switch v := val.(type) {
+	case bool, string:
+		return v, nil
+	case json.Number:
+		return exprToDecimal(string(v))
+	}
+	var d DecimalParam
+	if err = d.UnmarshalPipelineParam(val); err != nil {
+		return nil, errors.Wrapf(ErrBadInput, "variable %s is a %T, not a number, boolean or string", n.keypath, val)
+	}
+	return exprCheckDecimal(d.Decimal())
+}
+
+func (n exprUnary) eval(e *exprEvaluator) (interface{}, error) {
+	if n.op == "!" {
+		b, err := e.bool(n.operand)
+		return !b, err
+	}
+	d, err := e.decimal(n.operand)
+	if err != nil {
+		return nil, err
+	}
+	return d.Neg(), nil
+}
+
+func (n exprBinary) eval(e *exprEvaluator) (interface{}, error) {
+	switch n.op {
+	case "&&", "||":
+		left, err := e.bool(n.left)
+		if err != nil {
+			return nil, err
+		}
+		if left == (n.op == "||") {
+			return left, nil
+		}
+		return e.bool(n.right)
+	case "==", "!=":
+		equal, err := e.equal(n.left, n.right)
+		if err != nil {
+			return nil, err
+		}
+		return equal == (n.op == "=="), nil
+	}
+
+	a, err := e.decimal(n.left)
+	if err != nil {
+		return nil, err
+	}
+	b, err := e.decimal(n.right)
+	if err != nil {
+		return nil, err
+	}
+	switch n.op {
+	case "<":
+		return a.LessThan(b), nil
+	case "<=":
+		return a.LessThanOrEqual(b), nil
+	case ">":
+		return a.GreaterThan(b), nil
+	case ">=":
+		return a.GreaterThanOrEqual(b), nil
+	case "+":
+		return exprCheckDecimal(a.Add(b))
+	case "-":
+		return exprCheckDecimal(a.Sub(b))
+	case "*":
+		newExp := int64(a.Exponent()) + int64(b.Exponent())
+		if newExp > math.MaxInt32 || newExp < math.MinInt32 {
+			return nil, ErrMultiplyOverlow
+		}
+		return exprCheckDecimal(a.Mul(b))
+	case "/":
+		if b.IsZero() {
+			return nil, ErrDivideByZero
+		}
+		if e.precision != nil {
+			return exprCheckDecimal(a.DivRound(b, *e.precision))
+		}
+		return exprCheckDecimal(a.Div(b))
+	case "%":
+		if b.IsZero() {
+			return nil, ErrDivideByZero
+		}
+		return exprCheckDecimal(a.Mod(b))
+	}
+	return nil, errors.Wrapf(ErrExpression, "unknown operator %q", n.op)
+}
+
+func (e *exprEvaluator) equal(left, right exprNode) (bool, error) {
+	a, err := left.eval(e)
+	if err != nil {
+		return false, err
+	}
+	b, err := right.eval(e)
+	if err != nil {
+		return false, err
+	}
+	switch a := a.(type) {
+	case bool:
+		b, ok := b.(bool)
+		if !ok {
+			return false, errors.Wrapf(ErrBadInput, "cannot compare a boolean with %v", b)
+		}
+		return a == b, nil
+	case string:
+		if b, ok := b.(string); ok {
+			return a == b, nil
+		}
+	}
+	if _, ok := b.(bool); ok {
+		return false, errors.Wrapf(ErrBadInput, "cannot compare %v with a boolean", a)
+	}
+	da, err := exprToDecimal(a)
+	if err != nil {
+		return false, err
+	}
+	db, err := exprToDecimal(b)
+	if err != nil {
+		return false, err
+	}
+	return da.Equal(db), nil
+}
+
+func (n exprTernary) eval(e *exprEvaluator) (interface{}, error) {
+	cond, err := e.bool(n.cond)
+	if err != nil {
+		return nil, err
+	}
+	if cond {
+		return n.then.eval(e)
+	}
+	return n.otherwise.eval(e)
+}
+
+// exprFunctions maps the functions of the language to their arity and implementation.
+var exprFunctions = map[string]struct {
+	minArgs, maxArgs int
+	fn               func(args []decimal.Decimal) (decimal.Decimal, error)
+}{
+	"abs":   {1, 1, func(args []decimal.Decimal) (decimal.Decimal, error) { return args[0].Abs(), nil }},
+	"ceil":  {1, 1, func(args []decimal.Decimal) (decimal.Decimal, error) { return args[0].Ceil(), nil }},
+	"floor": {1, 1, func(args []decimal.Decimal) (decimal.Decimal, error) { return args[0].Floor(), nil }},
+	"round": {1, 2, func(args []decimal.Decimal) (decimal.Decimal, error) {
+		var places int32
+		if len(args) == 2 {
+			if !args[1].IsInteger() || args[1].Abs().GreaterThan(decimal.NewFromInt(exprMaxExponent)) {
+				return decimal.Decimal{}, errors.Wrapf(ErrBadInput, "invalid number of decimal places %s", args[1])
+			}
+			places = int32(args[1].IntPart())
+		}
+		return args[0].Round(places), nil
+	}},
+	"min": {1, -1, func(args []decimal.Decimal) (decimal.Decimal, error) { return decimal.Min(args[0], args[1:]...), nil }},
+	"max": {1, -1, func(args []decimal.Decimal) (decimal.Decimal, error) { return decimal.Max(args[0], args[1:]...), nil }},
+}
+
+func (n exprCall) eval(e *exprEvaluator) (interface{}, error) {
+	f := exprFunctions[n.name]
+	if len(n.args) < f.minArgs || (f.maxArgs >= 0 && len(n.args) > f.maxArgs) {
+		return nil, errors.Wrapf(ErrExpression, "wrong number of arguments to %s: %d", n.name, len(n.args))
+	}
+	args := make([]decimal.Decimal, len(n.args))
+	for i, arg := range n.args {
+		d, err := e.decimal(arg)
+		if err != nil {
+			return nil, errors.Wrapf(err, "argument %d of %s", i+1, n.name)
+		}
+		args[i] = d
+	}
+	d, err := f.fn(args)
+	if err != nil {
+		return nil, err
+	}
+	return exprCheckDecimal(d)
+}
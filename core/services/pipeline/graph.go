@@ -199,6 +199,24 @@ func (p *Pipeline) RequiresPreInsert() bool {
 	return false
 }
 
+// Simulate prepares the pipeline for a dry run, which must have no effects: the ethtx tasks return
+// the transaction they would send instead of sending it, the sign tasks fail instead of signing
+// with the keys of the node, and the http and bridge tasks do not write their caches.
+func (p *Pipeline) Simulate() {
+	for _, task := range p.Tasks {
+		switch t := task.(type) {
+		case *ETHTxTask:
+			t.simulate = true
+		case *SignTask:
+			t.simulate = true
+		case *HTTPTask:
+			t.simulate = true
+		case *BridgeTask:
+			t.simulate = true
+		}
+	}
+}
+
 func (p *Pipeline) ByDotID(id string) Task {
 	for _, task := range p.Tasks {
 		if task.DotID() == id {
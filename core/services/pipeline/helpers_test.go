@@ -3,7 +3,9 @@ package pipeline
 import (
 	"net/http"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/google/uuid"
+	"golang.org/x/sync/singleflight"
 
 	"github.com/smartcontractkit/chainlink/v2/core/bridges"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/legacyevm"
@@ -44,12 +46,24 @@ func (t *BridgeTask) HelperSetDependencies(
 	t.specId = specId
 }
 
+func (t *BridgeTask) HelperSetRequestGroup(group *singleflight.Group) {
+	t.requestGroup = group
+}
+
+func (r *runner) HelperTimeOutPendingTasks() {
+	r.timeOutPendingTasks()
+}
+
 func (t *HTTPTask) HelperSetDependencies(config Config, restrictedHTTPClient, unrestrictedHTTPClient *http.Client) {
 	t.config = config
 	t.httpClient = restrictedHTTPClient
 	t.unrestrictedHTTPClient = unrestrictedHTTPClient
 }
 
+func (t *HTTPTask) HelperSetCache(cache *HTTPResponseCache) {
+	t.cache = cache
+}
+
 func (t *ETHCallTask) HelperSetDependencies(legacyChains legacyevm.LegacyChainContainer, config Config, specGasLimit *uint32, jobType string) {
 	t.legacyChains = legacyChains
 	t.config = config
@@ -63,3 +77,8 @@ func (t *ETHTxTask) HelperSetDependencies(legacyChains legacyevm.LegacyChainCont
 	t.specGasLimit = specGasLimit
 	t.jobType = jobType
 }
+
+func (t *SignTask) HelperSetDependencies(keyStore ETHKeyStore, signingKeys []common.Address) {
+	t.keyStore = keyStore
+	t.signingKeys = signingKeys
+}
@@ -0,0 +1,133 @@
+package pipeline
+
+import (
+	"context"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/json"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+)
+
+// httpCacheMaxEntries bounds the number of responses held in memory, the oldest entry is evicted when it is reached.
+const httpCacheMaxEntries = 1000
+
+// HTTPCachedResponse is a successful http task response, stored under a hash of the request that produced it.
+type HTTPCachedResponse struct {
+	Key        []byte    `db:"key"`
+	Value      []byte    `db:"value"`
+	FinishedAt time.Time `db:"finished_at"`
+}
+
+// HTTPResponseCache caches http task responses in memory, backed by the http_task_responses table so that
+// they survive restarts. A single cache is shared by all jobs on the node, so tasks making the same request
+// share one response.
+type HTTPResponseCache struct {
+	orm  ORM
+	lggr logger.Logger
+
+	mu      sync.RWMutex
+	entries map[string]HTTPCachedResponse
+}
+
+// NewHTTPResponseCache creates an HTTPResponseCache. If orm is nil, responses are only cached in memory.
+func NewHTTPResponseCache(orm ORM, lggr logger.Logger) *HTTPResponseCache {
+	return &HTTPResponseCache{
+		orm:     orm,
+		lggr:    lggr.Named("HTTPResponseCache"),
+		entries: make(map[string]HTTPCachedResponse),
+	}
+}
+
+// Get returns the response cached under key, if it is not older than maxAge.
+func (c *HTTPResponseCache) Get(ctx context.Context, key []byte, maxAge time.Duration) ([]byte, bool) {
+	c.mu.RLock()
+	entry, ok := c.entries[string(key)]
+	c.mu.RUnlock()
+	if ok && time.Since(entry.FinishedAt) < maxAge {
+		return entry.Value, true
+	}
+	if c.orm == nil {
+		return nil, false
+	}
+
+	entry, err := c.orm.GetHTTPCachedResponse(ctx, key, maxAge)
+	if err != nil {
+		if !errors.Is(err, sql.ErrNoRows) {
+			c.lggr.Warnw("Failed to load cached response", "err", err)
+		}
+		return nil, false
+	}
+	c.store(entry)
+	return entry.Value, true
+}
+
+// Put caches value under key.
+func (c *HTTPResponseCache) Put(ctx context.Context, key []byte, value []byte) {
+	entry := HTTPCachedResponse{Key: key, Value: value, FinishedAt: time.Now()}
+	c.store(entry)
+	if c.orm == nil {
+		return
+	}
+	if err := c.orm.UpsertHTTPCachedResponse(ctx, entry); err != nil {
+		c.lggr.Warnw("Failed to store cached response", "err", err)
+	}
+}
+
+// Prune removes the responses older than threshold.
+func (c *HTTPResponseCache) Prune(ctx context.Context, threshold time.Duration) error {
+	c.mu.Lock()
+	for k, entry := range c.entries {
+		if time.Since(entry.FinishedAt) > threshold {
+			delete(c.entries, k)
+		}
+	}
+	c.mu.Unlock()
+	if c.orm == nil {
+		return nil
+	}
+	return c.orm.DeleteHTTPCachedResponsesOlderThan(ctx, threshold)
+}
+
+func (c *HTTPResponseCache) store(entry HTTPCachedResponse) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	key := string(entry.Key)
+	if existing, ok := c.entries[key]; ok {
+		if existing.FinishedAt.After(entry.FinishedAt) {
+			return
+		}
+	} else if len(c.entries) >= httpCacheMaxEntries {
+		var oldest string
+		var oldestAt time.Time
+		for k, e := range c.entries {
+			if oldestAt.IsZero() || e.FinishedAt.Before(oldestAt) {
+				oldest, oldestAt = k, e.FinishedAt
+			}
+		}
+		delete(c.entries, oldest)
+	}
+	c.entries[key] = entry
+}
+
+// httpCacheKey hashes everything that determines the response of an http task request. Whether unrestricted
+// network access is allowed is part of the key, so a restricted task can never be served a response that
+// only an unrestricted one could fetch.
+func httpCacheKey(method StringParam, url URLParam, requestData MapParam, reqHeaders []string, allowUnrestrictedNetworkAccess BoolParam) ([]byte, error) {
+	b, err := json.Marshal(struct {
+		Method                         string
+		URL                            string
+		RequestData                    MapParam
+		Headers                        []string
+		AllowUnrestrictedNetworkAccess bool
+	}{string(method), url.String(), requestData, reqHeaders, bool(allowUnrestrictedNetworkAccess)})
+	if err != nil {
+		return nil, err
+	}
+	key := sha256.Sum256(b)
+	return key[:], nil
+}
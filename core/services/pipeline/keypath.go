@@ -1,6 +1,8 @@
 package pipeline
 
 import (
+	"sort"
+	"strconv"
 	"strings"
 
 	"github.com/pkg/errors"
@@ -36,3 +38,276 @@ func NewKeypathFromString(keypathStr string) (Keypath, error) {
 
 	return Keypath{parts}, nil
 }
+
+// Selector is a keypath extended with wildcards, slices and filters, parsed by NewSelectorFromString.
+// Besides the dot separated keys and indexes of a Keypath, it supports the bracketed segments:
+//
+//	[2], [-1]                  array index, negative from the end
+//	['a.b']                    key containing separators
+//	[*] or .*                  all elements of an array or values of a map, by key
+//	[1:3], [:-1]               slice of an array
+//	[?(@.price > 10)]          elements for which the expr task expression is true, with @ the element
+//
+// e.g. "data.items[?(@.stock > 0)].name". Selectors with wildcards, slices or filters select a list
+// of matches, skipping elements the rest of the selector does not apply to.
+type Selector struct {
+	steps []selectorStep
+	multi bool
+}
+
+type selectorStepKind int
+
+const (
+	selectorKey selectorStepKind = iota
+	selectorIndex
+	selectorWildcard
+	selectorSlice
+	selectorFilter
+)
+
+type selectorStep struct {
+	kind       selectorStepKind
+	key        string
+	index      int
+	start, end *int
+	filter     *Expression
+}
+
+// NewSelectorFromString creates a new Selector from the given string.
+func NewSelectorFromString(selectorStr string) (Selector, error) {
+	var s Selector
+	for i := 0; i < len(selectorStr); {
+		if i > 0 && selectorStr[i] != '[' {
+			if !strings.HasPrefix(selectorStr[i:], KeypathSeparator) {
+				return Selector{}, errors.Wrapf(ErrWrongKeypath, "expected %q at position %d", KeypathSeparator, i)
+			}
+			i += len(KeypathSeparator)
+		}
+
+		if i < len(selectorStr) && selectorStr[i] == '[' {
+			end := selectorBracketEnd(selectorStr, i)
+			if end < 0 {
+				return Selector{}, errors.Wrapf(ErrWrongKeypath, "unterminated [ at position %d", i)
+			}
+			step, err := parseSelectorBracket(strings.TrimSpace(selectorStr[i+1 : end]))
+			if err != nil {
+				return Selector{}, errors.Wrapf(err, "segment at position %d", i)
+			}
+			s.steps = append(s.steps, step)
+			i = end + 1
+			continue
+		}
+
+		end := strings.IndexAny(selectorStr[i:], KeypathSeparator+"[")
+		if end < 0 {
+			end = len(selectorStr) - i
+		}
+		part := selectorStr[i : i+end]
+		if len(part) == 0 {
+			return Selector{}, errors.Wrapf(ErrWrongKeypath, "empty keypath segment at position %d", i)
+		}
+		if part == "*" {
+			s.steps = append(s.steps, selectorStep{kind: selectorWildcard})
+		} else {
+			s.steps = append(s.steps, selectorStep{kind: selectorKey, key: part})
+		}
+		i += end
+	}
+	if strings.HasSuffix(selectorStr, KeypathSeparator) {
+		return Selector{}, errors.Wrapf(ErrWrongKeypath, "empty keypath segment at position %d", len(selectorStr))
+	}
+
+	for _, step := range s.steps {
+		if step.kind == selectorWildcard || step.kind == selectorSlice || step.kind == selectorFilter {
+			s.multi = true
+		}
+	}
+	return s, nil
+}
+
+// selectorBracketEnd returns the index of the ] closing the [ at start, skipping quoted strings
+// and nested brackets of filters, or -1.
+func selectorBracketEnd(s string, start int) int {
+	depth := 0
+	var quote byte
+	for i := start; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case quote != 0:
+			if c == quote {
+				quote = 0
+			}
+		case c == '"' || c == '\'':
+			quote = c
+		case c == '[':
+			depth++
+		case c == ']':
+			depth--
+			if depth == 0 {
+				return i
+			}
+		}
+	}
+	return -1
+}
+
+func parseSelectorBracket(s string) (selectorStep, error) {
+	switch {
+	case s == "*":
+		return selectorStep{kind: selectorWildcard}, nil
+
+	case strings.HasPrefix(s, "?"):
+		filter := strings.TrimSpace(s[1:])
+		if len(filter) < 2 || filter[0] != '(' || filter[len(filter)-1] != ')' {
+			return selectorStep{}, errors.Wrapf(ErrWrongKeypath, "filter must be of the form ?(expression), got %q", s)
+		}
+		expr, err := ParseExpression(filter[1 : len(filter)-1])
+		if err != nil {
+			return selectorStep{}, errors.Wrap(ErrWrongKeypath, err.Error())
+		}
+		return selectorStep{kind: selectorFilter, filter: expr}, nil
+
+	case len(s) >= 2 && (s[0] == '\'' || s[0] == '"') && s[len(s)-1] == s[0]:
+		return selectorStep{kind: selectorKey, key: s[1 : len(s)-1]}, nil
+
+	case strings.Contains(s, ":"):
+		bounds := strings.SplitN(s, ":", 2)
+		step := selectorStep{kind: selectorSlice}
+		for i, bound := range bounds {
+			bound = strings.TrimSpace(bound)
+			if bound == "" {
+				continue
+			}
+			n, err := strconv.Atoi(bound)
+			if err != nil {
+				return selectorStep{}, errors.Wrapf(ErrWrongKeypath, "invalid slice bound %q", bound)
+			}
+			if i == 0 {
+				step.start = &n
+			} else {
+				step.end = &n
+			}
+		}
+		return step, nil
+	}
+
+	n, err := strconv.Atoi(s)
+	if err != nil {
+		return selectorStep{}, errors.Wrapf(ErrWrongKeypath, "invalid segment [%s]", s)
+	}
+	return selectorStep{kind: selectorIndex, index: n}, nil
+}
+
+// Select returns the value selected in v, or a []interface{} of all matches if the selector has
+// wildcards, slices or filters. Returns ErrKeypathNotFound if a single value selector does not
+// resolve.
+func (s Selector) Select(v interface{}) (interface{}, error) {
+	current := []interface{}{v}
+	for i, step := range s.steps {
+		var next []interface{}
+		for _, val := range current {
+			selected, err := step.apply(val)
+			if err != nil {
+				if s.multi {
+					continue
+				}
+				return nil, errors.Wrapf(err, "segment %d", i)
+			}
+			next = append(next, selected...)
+		}
+		current = next
+	}
+
+	if s.multi {
+		if current == nil {
+			current = []interface{}{}
+		}
+		return current, nil
+	}
+	return current[0], nil
+}
+
+func (step selectorStep) apply(v interface{}) ([]interface{}, error) {
+	switch step.kind {
+	case selectorKey:
+		switch v := v.(type) {
+		case map[string]interface{}:
+			val, exists := v[step.key]
+			if !exists {
+				return nil, errors.Wrapf(ErrKeypathNotFound, "key %v", step.key)
+			}
+			return []interface{}{val}, nil
+		case []interface{}:
+			idx, err := strconv.Atoi(step.key)
+			if err != nil {
+				return nil, errors.Wrapf(ErrKeypathNotFound, "%v is not a valid array index", step.key)
+			}
+			return selectorStep{kind: selectorIndex, index: idx}.apply(v)
+		}
+
+	case selectorIndex:
+		if v, ok := v.([]interface{}); ok {
+			idx := step.index
+			if idx < 0 {
+				idx += len(v)
+			}
+			if idx < 0 || idx >= len(v) {
+				return nil, errors.Wrapf(ErrIndexOutOfRange, "index %v out of range (length %v)", step.index, len(v))
+			}
+			return []interface{}{v[idx]}, nil
+		}
+
+	case selectorWildcard, selectorFilter:
+		var elems []interface{}
+		switch v := v.(type) {
+		case map[string]interface{}:
+			keys := make([]string, 0, len(v))
+			for key := range v {
+				keys = append(keys, key)
+			}
+			sort.Strings(keys)
+			for _, key := range keys {
+				elems = append(elems, v[key])
+			}
+		case []interface{}:
+			elems = v
+		default:
+			return nil, errors.Wrapf(ErrKeypathNotFound, "value is a %T, not a map or slice", v)
+		}
+		if step.kind == selectorWildcard {
+			return elems, nil
+		}
+		var matches []interface{}
+		for _, elem := range elems {
+			// Elements the filter cannot be evaluated on, e.g. missing a key, do not match.
+			matched, err := step.filter.Evaluate(NewVarsFrom(map[string]interface{}{"@": elem}), nil)
+			if err == nil && matched == true {
+				matches = append(matches, elem)
+			}
+		}
+		return matches, nil
+
+	case selectorSlice:
+		if v, ok := v.([]interface{}); ok {
+			start, end := 0, len(v)
+			if step.start != nil {
+				start = clampSliceBound(*step.start, len(v))
+			}
+			if step.end != nil {
+				end = clampSliceBound(*step.end, len(v))
+			}
+			if start >= end {
+				return nil, nil
+			}
+			return v[start:end], nil
+		}
+	}
+	return nil, errors.Wrapf(ErrKeypathNotFound, "value is a %T, not a map or slice", v)
+}
+
+func clampSliceBound(bound, length int) int {
+	if bound < 0 {
+		bound += length
+	}
+	return max(0, min(bound, length))
+}
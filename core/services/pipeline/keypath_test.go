@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
 )
@@ -47,3 +48,65 @@ func TestKeypath(t *testing.T) {
 		}
 	})
 }
+
+func TestSelector(t *testing.T) {
+	t.Parallel()
+
+	value := map[string]interface{}{
+		"a.b": "dotted",
+		"list": []interface{}{
+			map[string]interface{}{"id": 1, "ok": true},
+			map[string]interface{}{"id": 2, "ok": false},
+			map[string]interface{}{"id": 3, "ok": true},
+		},
+	}
+
+	t.Run("selects", func(t *testing.T) {
+		tests := []struct {
+			selector string
+			want     interface{}
+		}{
+			{"", value},
+			{"['a.b']", "dotted"},
+			{"list[0].id", 1},
+			{"list.2.id", 3},
+			{"list[-2].id", 2},
+			{"list[*].id", []interface{}{1, 2, 3}},
+			{"list[0:2].id", []interface{}{1, 2}},
+			{"list[5:].id", []interface{}{}},
+			{"list[?(@.ok)].id", []interface{}{1, 3}},
+			{"list[?(!@.ok && @.id >= 2)].id", []interface{}{2}},
+			{"list[?(@.missing > 0)]", []interface{}{}},
+			{"list[*].missing", []interface{}{}},
+		}
+		for _, test := range tests {
+			t.Run(test.selector, func(t *testing.T) {
+				s, err := pipeline.NewSelectorFromString(test.selector)
+				require.NoError(t, err)
+				got, err := s.Select(value)
+				require.NoError(t, err)
+				assert.Equal(t, test.want, got)
+			})
+		}
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		for _, selector := range []string{"missing", "list[3]", "list.x", "a.b", "list[0].id.x"} {
+			t.Run(selector, func(t *testing.T) {
+				s, err := pipeline.NewSelectorFromString(selector)
+				require.NoError(t, err)
+				_, err = s.Select(value)
+				require.Error(t, err)
+			})
+		}
+	})
+
+	t.Run("wrong selector", func(t *testing.T) {
+		for _, selector := range []string{".", "x.", ".y", "x..y", "x[", "x[]", "x[a]", "x[1:b]", "x[?@.ok]", "x[?(@.)]", "x[0]y"} {
+			t.Run(selector, func(t *testing.T) {
+				_, err := pipeline.NewSelectorFromString(selector)
+				assert.ErrorIs(t, err, pipeline.ErrWrongKeypath)
+			})
+		}
+	})
+}
@@ -104,6 +104,26 @@ func (_m *Config) ReaperThreshold() time.Duration {
 	return r0
 }
 
+// ReaperThresholdByJobType provides a mock function with given fields:
+func (_m *Config) ReaperThresholdByJobType() map[string]time.Duration {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReaperThresholdByJobType")
+	}
+
+	var r0 map[string]time.Duration
+	if rf, ok := ret.Get(0).(func() map[string]time.Duration); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]time.Duration)
+		}
+	}
+
+	return r0
+}
+
 // NewConfig creates a new instance of Config. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewConfig(t interface {
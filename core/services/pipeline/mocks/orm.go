@@ -22,6 +22,34 @@ type ORM struct {
 	mock.Mock
 }
 
+// CancelRun provides a mock function with given fields: ctx, id
+func (_m *ORM) CancelRun(ctx context.Context, id int64) (pipeline.Run, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CancelRun")
+	}
+
+	var r0 pipeline.Run
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) (pipeline.Run, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) pipeline.Run); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(pipeline.Run)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Close provides a mock function with given fields:
 func (_m *ORM) Close() error {
 	ret := _m.Called()
@@ -100,6 +128,24 @@ func (_m *ORM) CreateSpec(_a0 pipeline.Pipeline, maxTaskTimeout models.Interval,
 	return r0, r1
 }
 
+// DeleteHTTPCachedResponsesOlderThan provides a mock function with given fields: ctx, threshold
+func (_m *ORM) DeleteHTTPCachedResponsesOlderThan(ctx context.Context, threshold time.Duration) error {
+	ret := _m.Called(ctx, threshold)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteHTTPCachedResponsesOlderThan")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Duration) error); ok {
+		r0 = rf(ctx, threshold)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // DeleteRun provides a mock function with given fields: id
 func (_m *ORM) DeleteRun(id int64) error {
 	ret := _m.Called(id)
@@ -118,17 +164,17 @@ func (_m *ORM) DeleteRun(id int64) error {
 	return r0
 }
 
-// DeleteRunsOlderThan provides a mock function with given fields: _a0, _a1
-func (_m *ORM) DeleteRunsOlderThan(_a0 context.Context, _a1 time.Duration) error {
-	ret := _m.Called(_a0, _a1)
+// DeleteRunsOlderThan provides a mock function with given fields: ctx, threshold, thresholdsByJobType
+func (_m *ORM) DeleteRunsOlderThan(ctx context.Context, threshold time.Duration, thresholdsByJobType map[string]time.Duration) error {
+	ret := _m.Called(ctx, threshold, thresholdsByJobType)
 
 	if len(ret) == 0 {
 		panic("no return value specified for DeleteRunsOlderThan")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, time.Duration) error); ok {
-		r0 = rf(_a0, _a1)
+	if rf, ok := ret.Get(0).(func(context.Context, time.Duration, map[string]time.Duration) error); ok {
+		r0 = rf(ctx, threshold, thresholdsByJobType)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -164,6 +210,34 @@ func (_m *ORM) FindRun(id int64) (pipeline.Run, error) {
 	return r0, r1
 }
 
+// FindTaskRun provides a mock function with given fields: id
+func (_m *ORM) FindTaskRun(id uuid.UUID) (pipeline.TaskRun, error) {
+	ret := _m.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindTaskRun")
+	}
+
+	var r0 pipeline.TaskRun
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uuid.UUID) (pipeline.TaskRun, error)); ok {
+		return rf(id)
+	}
+	if rf, ok := ret.Get(0).(func(uuid.UUID) pipeline.TaskRun); ok {
+		r0 = rf(id)
+	} else {
+		r0 = ret.Get(0).(pipeline.TaskRun)
+	}
+
+	if rf, ok := ret.Get(1).(func(uuid.UUID) error); ok {
+		r1 = rf(id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetAllRuns provides a mock function with given fields:
 func (_m *ORM) GetAllRuns() ([]pipeline.Run, error) {
 	ret := _m.Called()
@@ -194,6 +268,34 @@ func (_m *ORM) GetAllRuns() ([]pipeline.Run, error) {
 	return r0, r1
 }
 
+// GetHTTPCachedResponse provides a mock function with given fields: ctx, key, maxAge
+func (_m *ORM) GetHTTPCachedResponse(ctx context.Context, key []byte, maxAge time.Duration) (pipeline.HTTPCachedResponse, error) {
+	ret := _m.Called(ctx, key, maxAge)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetHTTPCachedResponse")
+	}
+
+	var r0 pipeline.HTTPCachedResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []byte, time.Duration) (pipeline.HTTPCachedResponse, error)); ok {
+		return rf(ctx, key, maxAge)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []byte, time.Duration) pipeline.HTTPCachedResponse); ok {
+		r0 = rf(ctx, key, maxAge)
+	} else {
+		r0 = ret.Get(0).(pipeline.HTTPCachedResponse)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []byte, time.Duration) error); ok {
+		r1 = rf(ctx, key, maxAge)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetQ provides a mock function with given fields:
 func (_m *ORM) GetQ() pg.Q {
 	ret := _m.Called()
@@ -212,6 +314,36 @@ func (_m *ORM) GetQ() pg.Q {
 	return r0
 }
 
+// GetTimedOutTaskRuns provides a mock function with given fields: ctx, now
+func (_m *ORM) GetTimedOutTaskRuns(ctx context.Context, now time.Time) ([]uuid.UUID, error) {
+	ret := _m.Called(ctx, now)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTimedOutTaskRuns")
+	}
+
+	var r0 []uuid.UUID
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) ([]uuid.UUID, error)); ok {
+		return rf(ctx, now)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) []uuid.UUID); ok {
+		r0 = rf(ctx, now)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]uuid.UUID)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time) error); ok {
+		r1 = rf(ctx, now)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetUnfinishedRuns provides a mock function with given fields: _a0, _a1, _a2
 func (_m *ORM) GetUnfinishedRuns(_a0 context.Context, _a1 time.Time, _a2 func(pipeline.Run) error) error {
 	ret := _m.Called(_a0, _a1, _a2)
@@ -414,6 +546,41 @@ func (_m *ORM) StoreRun(run *pipeline.Run, qopts ...pg.QOpt) (bool, error) {
 	return r0, r1
 }
 
+// UpdatePendingTaskRunResult provides a mock function with given fields: taskID, result
+func (_m *ORM) UpdatePendingTaskRunResult(taskID uuid.UUID, result pipeline.Result) (pipeline.Run, bool, error) {
+	ret := _m.Called(taskID, result)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdatePendingTaskRunResult")
+	}
+
+	var r0 pipeline.Run
+	var r1 bool
+	var r2 error
+	if rf, ok := ret.Get(0).(func(uuid.UUID, pipeline.Result) (pipeline.Run, bool, error)); ok {
+		return rf(taskID, result)
+	}
+	if rf, ok := ret.Get(0).(func(uuid.UUID, pipeline.Result) pipeline.Run); ok {
+		r0 = rf(taskID, result)
+	} else {
+		r0 = ret.Get(0).(pipeline.Run)
+	}
+
+	if rf, ok := ret.Get(1).(func(uuid.UUID, pipeline.Result) bool); ok {
+		r1 = rf(taskID, result)
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	if rf, ok := ret.Get(2).(func(uuid.UUID, pipeline.Result) error); ok {
+		r2 = rf(taskID, result)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
 // UpdateTaskRunResult provides a mock function with given fields: taskID, result
 func (_m *ORM) UpdateTaskRunResult(taskID uuid.UUID, result pipeline.Result) (pipeline.Run, bool, error) {
 	ret := _m.Called(taskID, result)
@@ -449,6 +616,24 @@ func (_m *ORM) UpdateTaskRunResult(taskID uuid.UUID, result pipeline.Result) (pi
 	return r0, r1, r2
 }
 
+// UpsertHTTPCachedResponse provides a mock function with given fields: ctx, response
+func (_m *ORM) UpsertHTTPCachedResponse(ctx context.Context, response pipeline.HTTPCachedResponse) error {
+	ret := _m.Called(ctx, response)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpsertHTTPCachedResponse")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, pipeline.HTTPCachedResponse) error); ok {
+		r0 = rf(ctx, response)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // NewORM creates a new instance of ORM. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewORM(t interface {
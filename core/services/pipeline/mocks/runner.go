@@ -20,6 +20,24 @@ type Runner struct {
 	mock.Mock
 }
 
+// CancelRun provides a mock function with given fields: ctx, runID
+func (_m *Runner) CancelRun(ctx context.Context, runID int64) error {
+	ret := _m.Called(ctx, runID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CancelRun")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) error); ok {
+		r0 = rf(ctx, runID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // Close provides a mock function with given fields:
 func (_m *Runner) Close() error {
 	ret := _m.Called()
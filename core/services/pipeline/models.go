@@ -8,7 +8,9 @@ import (
 	"strconv"
 	"time"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/google/uuid"
+	"github.com/lib/pq"
 	"github.com/pkg/errors"
 	"github.com/shopspring/decimal"
 	"go.uber.org/multierr"
@@ -18,12 +20,14 @@ import (
 )
 
 type Spec struct {
-	ID                int32
-	DotDagSource      string          `json:"dotDagSource"`
-	CreatedAt         time.Time       `json:"-"`
-	MaxTaskDuration   models.Interval `json:"-"`
-	GasLimit          *uint32         `json:"-"`
-	ForwardingAllowed bool            `json:"-"`
+	ID                   int32
+	DotDagSource         string          `json:"dotDagSource"`
+	CreatedAt            time.Time       `json:"-"`
+	MaxTaskDuration      models.Interval `json:"-"`
+	GasLimit             *uint32         `json:"-"`
+	ForwardingAllowed    bool            `json:"-"`
+	SimulateTransactions bool            `json:"-"`
+	SigningKeys          pq.StringArray  `json:"-"`
 
 	JobID   int32  `json:"-"`
 	JobName string `json:"-"`
@@ -32,6 +36,15 @@ type Spec struct {
 	Pipeline *Pipeline `json:"-" db:"-"` // This may be nil, or may be populated manually as a cache. There is no locking on this, so be careful
 }
 
+// SigningAddresses returns the eth keys the sign tasks of the job are allowed to sign with.
+func (s *Spec) SigningAddresses() []common.Address {
+	addresses := make([]common.Address, len(s.SigningKeys))
+	for i, key := range s.SigningKeys {
+		addresses[i] = common.HexToAddress(key)
+	}
+	return addresses
+}
+
 func (s *Spec) GetOrParsePipeline() (*Pipeline, error) {
 	if s.Pipeline != nil {
 		return s.Pipeline, nil
@@ -271,6 +284,10 @@ type TaskRun struct {
 	FinishedAt    null.Time        `json:"finishedAt"`
 	Index         int32            `json:"index"`
 	DotID         string           `json:"dotId"`
+	// PendingDeadline is when a pending task run times out, if it has a timeout.
+	PendingDeadline null.Time `json:"pendingDeadline"`
+	// ResumeBridgeName is the bridge which must sign the result resuming a pending task run, if any.
+	ResumeBridgeName null.String `json:"-"`
 
 	// Used internally for sorting completed results
 	task Task
@@ -9,7 +9,11 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/lib/pq"
 	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"gopkg.in/guregu/null.v4"
 
 	"github.com/jmoiron/sqlx"
 
@@ -20,6 +24,13 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/store/models"
 )
 
+var promPipelineRunsReaped = promauto.NewCounterVec(prometheus.CounterOpts{
+	Name: "pipeline_runs_reaped_total",
+	Help: "Number of finished pipeline runs deleted by the reaper, by job type",
+},
+	[]string{"job_type"},
+)
+
 // KeepersObservationSource is the same for all keeper jobs and it is not persisted in DB
 const KeepersObservationSource = `
     encode_check_upkeep_tx      [type=ethabiencode
@@ -81,14 +92,29 @@ type ORM interface {
 	DeleteRun(id int64) error
 	StoreRun(run *Run, qopts ...pg.QOpt) (restart bool, err error)
 	UpdateTaskRunResult(taskID uuid.UUID, result Result) (run Run, start bool, err error)
+	// UpdatePendingTaskRunResult is like UpdateTaskRunResult, but returns ErrTaskRunFinished rather than overwriting
+	// the result of a task run which was resumed already.
+	UpdatePendingTaskRunResult(taskID uuid.UUID, result Result) (run Run, start bool, err error)
+	// CancelRun fails the pending tasks of a suspended run and finishes it as errored, without running the rest
+	// of its pipeline.
+	CancelRun(ctx context.Context, id int64) (Run, error)
 	InsertFinishedRun(run *Run, saveSuccessfulTaskRuns bool, qopts ...pg.QOpt) (err error)
 
 	// InsertFinishedRuns inserts all the given runs into the database.
 	// If saveSuccessfulTaskRuns is false, only errored runs are saved.
 	InsertFinishedRuns(run []*Run, saveSuccessfulTaskRuns bool, qopts ...pg.QOpt) (err error)
 
-	DeleteRunsOlderThan(context.Context, time.Duration) error
+	DeleteRunsOlderThan(ctx context.Context, threshold time.Duration, thresholdsByJobType map[string]time.Duration) error
+
+	// GetHTTPCachedResponse returns the cached http task response for key, if it is not older than maxAge.
+	GetHTTPCachedResponse(ctx context.Context, key []byte, maxAge time.Duration) (HTTPCachedResponse, error)
+	UpsertHTTPCachedResponse(ctx context.Context, response HTTPCachedResponse) error
+	DeleteHTTPCachedResponsesOlderThan(ctx context.Context, threshold time.Duration) error
+
 	FindRun(id int64) (Run, error)
+	FindTaskRun(id uuid.UUID) (TaskRun, error)
+	// GetTimedOutTaskRuns returns the IDs of the pending task runs whose deadline is before now.
+	GetTimedOutTaskRuns(ctx context.Context, now time.Time) ([]uuid.UUID, error)
 	GetAllRuns() ([]Run, error)
 	GetUnfinishedRuns(context.Context, time.Time, func(run Run) error) error
 	GetQ() pg.Q
@@ -261,10 +287,12 @@ func (o *orm) StoreRun(run *Run, qopts ...pg.QOpt) (restart bool, err error) {
 		}
 
 		sql := `
-		INSERT INTO pipeline_task_runs (pipeline_run_id, id, type, index, output, error, dot_id, created_at, finished_at)
-		VALUES (:pipeline_run_id, :id, :type, :index, :output, :error, :dot_id, :created_at, :finished_at)
+		INSERT INTO pipeline_task_runs (pipeline_run_id, id, type, index, output, error, dot_id, created_at, finished_at, pending_deadline, resume_bridge_name)
+		VALUES (:pipeline_run_id, :id, :type, :index, :output, :error, :dot_id, :created_at, :finished_at, :pending_deadline, :resume_bridge_name)
 		ON CONFLICT (pipeline_run_id, dot_id) DO UPDATE SET
-		output = EXCLUDED.output, error = EXCLUDED.error, finished_at = EXCLUDED.finished_at
+		output = EXCLUDED.output, error = EXCLUDED.error, finished_at = EXCLUDED.finished_at,
+		pending_deadline = COALESCE(EXCLUDED.pending_deadline, pipeline_task_runs.pending_deadline),
+		resume_bridge_name = COALESCE(EXCLUDED.resume_bridge_name, pipeline_task_runs.resume_bridge_name)
 		RETURNING *;
 		`
 
@@ -286,6 +314,42 @@ func (o *orm) StoreRun(run *Run, qopts ...pg.QOpt) (restart bool, err error) {
 	return
 }
 
+// ErrRunNotSuspended is returned when cancelling a run which is not awaiting the result of a pending task.
+var ErrRunNotSuspended = errors.New("only suspended runs can be cancelled")
+
+// ErrRunCancelled is the error of the task runs left pending by a cancelled run.
+var ErrRunCancelled = errors.New("pipeline run cancelled")
+
+func (o *orm) CancelRun(ctx context.Context, id int64) (run Run, err error) {
+	q := o.q.WithOpts(pg.WithParentCtx(ctx))
+	err = q.Transaction(func(tx pg.Queryer) error {
+		if err = tx.Get(&run, `SELECT * FROM pipeline_runs WHERE id = $1 FOR UPDATE`, id); err != nil {
+			return errors.Wrapf(err, "failed to find pipeline run %d", id)
+		}
+		if run.State != RunStatusSuspended {
+			return errors.Wrapf(ErrRunNotSuspended, "run %d is %s", id, run.State)
+		}
+
+		now := time.Now()
+		runErr := null.StringFrom(ErrRunCancelled.Error())
+		if _, err = tx.Exec(`UPDATE pipeline_task_runs SET error = $2, finished_at = $3 WHERE pipeline_run_id = $1 AND finished_at IS NULL`, id, runErr, now); err != nil {
+			return errors.Wrap(err, "failed to update pending task runs")
+		}
+
+		run.State = RunStatusErrored
+		run.FinishedAt = null.TimeFrom(now)
+		run.AllErrors = RunErrors{runErr}
+		run.FatalErrors = RunErrors{runErr}
+		run.Outputs = JSONSerializable{Val: []interface{}{nil}, Valid: true}
+		sql := `UPDATE pipeline_runs SET state = :state, finished_at = :finished_at, all_errors = :all_errors, fatal_errors = :fatal_errors, outputs = :outputs WHERE id = :id`
+		if _, err = tx.NamedExec(sql, run); err != nil {
+			return errors.Wrap(err, "failed to finish pipeline run")
+		}
+		return loadAssociations(tx, []*Run{&run})
+	})
+	return run, err
+}
+
 // DeleteRun cleans up a run that failed and is marked failEarly (should leave no trace of the run)
 func (o *orm) DeleteRun(id int64) error {
 	// NOTE: this will cascade and wipe pipeline_task_runs too
@@ -293,7 +357,18 @@ func (o *orm) DeleteRun(id int64) error {
 	return err
 }
 
+// ErrTaskRunFinished is returned when updating the result of a pending task run which was resumed already.
+var ErrTaskRunFinished = errors.New("task run is already finished")
+
 func (o *orm) UpdateTaskRunResult(taskID uuid.UUID, result Result) (run Run, start bool, err error) {
+	return o.updateTaskRunResult(taskID, result, false)
+}
+
+func (o *orm) UpdatePendingTaskRunResult(taskID uuid.UUID, result Result) (run Run, start bool, err error) {
+	return o.updateTaskRunResult(taskID, result, true)
+}
+
+func (o *orm) updateTaskRunResult(taskID uuid.UUID, result Result, pendingOnly bool) (run Run, start bool, err error) {
 	if result.OutputDB().Valid && result.ErrorDB().Valid {
 		panic("run result must specify either output or error, not both")
 	}
@@ -311,9 +386,22 @@ func (o *orm) UpdateTaskRunResult(taskID uuid.UUID, result Result) (run Run, sta
 
 		// Update the task with result
 		sql = `UPDATE pipeline_task_runs SET output = $2, error = $3, finished_at = $4 WHERE id = $1`
-		if _, err = tx.Exec(sql, taskID, result.OutputDB(), result.ErrorDB(), time.Now()); err != nil {
+		if pendingOnly {
+			sql += ` AND finished_at IS NULL`
+		}
+		res, err := tx.Exec(sql, taskID, result.OutputDB(), result.ErrorDB(), time.Now())
+		if err != nil {
 			return fmt.Errorf("failed to update pipeline task run: %w", err)
 		}
+		if pendingOnly {
+			rowsAffected, err := res.RowsAffected()
+			if err != nil {
+				return fmt.Errorf("failed to update pipeline task run: %w", err)
+			}
+			if rowsAffected == 0 {
+				return ErrTaskRunFinished
+			}
+		}
 
 		if run.State == RunStatusSuspended {
 			start = true
@@ -453,43 +541,79 @@ func (o *orm) InsertFinishedRun(run *Run, saveSuccessfulTaskRuns bool, qopts ...
 	return errors.Wrap(err, "InsertFinishedRun failed")
 }
 
-// DeleteRunsOlderThan deletes all pipeline_runs that have been finished for a certain threshold to free DB space
+// DeleteRunsOlderThan deletes all pipeline_runs that have been finished for a certain threshold to free DB space.
+// The runs of jobs whose type is in thresholdsByJobType use that threshold instead, and a job's own reaper_threshold
+// takes precedence over both.
 // Caller is expected to set timeout on calling context.
-func (o *orm) DeleteRunsOlderThan(ctx context.Context, threshold time.Duration) error {
+func (o *orm) DeleteRunsOlderThan(ctx context.Context, threshold time.Duration, thresholdsByJobType map[string]time.Duration) error {
 	start := time.Now()
 
 	q := o.q.WithOpts(pg.WithParentCtxInheritTimeout(ctx))
 
-	queryThreshold := start.Add(-threshold)
+	// no run finished later than the shortest threshold can be deleted, which keeps each batch on the finished_at index
+	minThreshold := threshold
+	jobTypes := make([]string, 0, len(thresholdsByJobType))
+	jobTypeThresholds := make([]int64, 0, len(thresholdsByJobType))
+	for jobType, t := range thresholdsByJobType {
+		jobTypes = append(jobTypes, jobType)
+		jobTypeThresholds = append(jobTypeThresholds, t.Nanoseconds())
+		if t < minThreshold {
+			minThreshold = t
+		}
+	}
+	var minJobThreshold models.Interval
+	err := q.Get(&minJobThreshold, `SELECT MIN(reaper_threshold) FROM jobs WHERE reaper_threshold > 0`)
+	if err != nil {
+		return errors.Wrap(err, "DeleteRunsOlderThan failed to load job reaper thresholds")
+	}
+	if d := minJobThreshold.Duration(); d > 0 && d < minThreshold {
+		minThreshold = d
+	}
+
+	queryThreshold := start.Add(-minThreshold)
 
 	rowsDeleted := int64(0)
 
-	err := pg.Batch(func(_, limit uint) (count uint, err error) {
-		result, cancel, err := q.ExecQIter(`
-WITH batched_pipeline_runs AS (
-	SELECT * FROM pipeline_runs
-	WHERE finished_at < ($1)
-	ORDER BY finished_at ASC
-	LIMIT $2
+	err = pg.Batch(func(_, limit uint) (count uint, err error) {
+		var deleted []struct {
+			JobType string `db:"job_type"`
+			Count   int64  `db:"count"`
+		}
+		err = q.Select(&deleted, `
+WITH job_type_thresholds AS (
+	SELECT * FROM unnest($3::text[], $4::bigint[]) AS t(job_type, threshold)
+), batched_pipeline_runs AS (
+	SELECT pipeline_runs.id, COALESCE(jobs.type, '') AS job_type FROM pipeline_runs
+	LEFT JOIN jobs ON jobs.pipeline_spec_id = pipeline_runs.pipeline_spec_id
+	LEFT JOIN job_type_thresholds ON job_type_thresholds.job_type = jobs.type
+	WHERE pipeline_runs.finished_at < ($1)
+	AND pipeline_runs.finished_at < $2::timestamptz - (COALESCE(NULLIF(jobs.reaper_threshold, 0), job_type_thresholds.threshold, $5) / 1000) * interval '1 microsecond'
+	ORDER BY pipeline_runs.finished_at ASC
+	LIMIT $6
+), deleted AS (
+	DELETE FROM pipeline_runs
+	USING batched_pipeline_runs
+	WHERE pipeline_runs.id = batched_pipeline_runs.id
+	RETURNING batched_pipeline_runs.job_type
 )
-DELETE FROM pipeline_runs
-USING batched_pipeline_runs
-WHERE pipeline_runs.id = batched_pipeline_runs.id`,
+SELECT job_type, count(*) AS count FROM deleted GROUP BY job_type`,
 			queryThreshold,
+			start,
+			pq.Array(jobTypes),
+			pq.Array(jobTypeThresholds),
+			threshold.Nanoseconds(),
 			limit,
 		)
-		defer cancel()
 		if err != nil {
 			return count, errors.Wrap(err, "DeleteRunsOlderThan failed to delete old pipeline_runs")
 		}
 
-		rowsAffected, err := result.RowsAffected()
-		if err != nil {
-			return count, errors.Wrap(err, "DeleteRunsOlderThan failed to get rows affected")
+		for _, d := range deleted {
+			promPipelineRunsReaped.WithLabelValues(d.JobType).Add(float64(d.Count))
+			rowsDeleted += d.Count
+			count += uint(d.Count)
 		}
-		rowsDeleted += rowsAffected
-
-		return uint(rowsAffected), err
+		return count, nil
 	})
 	if err != nil {
 		return errors.Wrap(err, "DeleteRunsOlderThan failed")
@@ -511,6 +635,26 @@ WHERE pipeline_runs.id = batched_pipeline_runs.id`,
 	return nil
 }
 
+func (o *orm) GetHTTPCachedResponse(ctx context.Context, key []byte, maxAge time.Duration) (response HTTPCachedResponse, err error) {
+	q := o.q.WithOpts(pg.WithParentCtx(ctx))
+	err = q.Get(&response, `SELECT * FROM http_task_responses WHERE key = $1 AND finished_at > $2`, key, time.Now().Add(-maxAge))
+	return response, errors.Wrap(err, "failed to fetch cached http task response")
+}
+
+func (o *orm) UpsertHTTPCachedResponse(ctx context.Context, response HTTPCachedResponse) error {
+	q := o.q.WithOpts(pg.WithParentCtx(ctx))
+	err := q.ExecQ(`INSERT INTO http_task_responses (key, value, finished_at) VALUES ($1, $2, $3)
+ON CONFLICT (key) DO UPDATE SET value = EXCLUDED.value, finished_at = EXCLUDED.finished_at
+WHERE http_task_responses.finished_at < EXCLUDED.finished_at`, response.Key, response.Value, response.FinishedAt)
+	return errors.Wrap(err, "failed to upsert cached http task response")
+}
+
+func (o *orm) DeleteHTTPCachedResponsesOlderThan(ctx context.Context, threshold time.Duration) error {
+	q := o.q.WithOpts(pg.WithParentCtxInheritTimeout(ctx))
+	err := q.ExecQ(`DELETE FROM http_task_responses WHERE finished_at < $1`, time.Now().Add(-threshold))
+	return errors.Wrap(err, "failed to delete stale cached http task responses")
+}
+
 func (o *orm) FindRun(id int64) (r Run, err error) {
 	var runs []*Run
 	err = o.q.Transaction(func(tx pg.Queryer) error {
@@ -525,6 +669,19 @@ func (o *orm) FindRun(id int64) (r Run, err error) {
 	return *runs[0], err
 }
 
+func (o *orm) FindTaskRun(id uuid.UUID) (tr TaskRun, err error) {
+	err = o.q.Get(&tr, `SELECT * FROM pipeline_task_runs WHERE id = $1`, id)
+	return tr, errors.Wrap(err, "failed to load task run")
+}
+
+func (o *orm) GetTimedOutTaskRuns(ctx context.Context, now time.Time) (ids []uuid.UUID, err error) {
+	q := o.q.WithOpts(pg.WithParentCtx(ctx))
+	err = q.Select(&ids, `SELECT pipeline_task_runs.id FROM pipeline_task_runs
+JOIN pipeline_runs ON pipeline_runs.id = pipeline_task_runs.pipeline_run_id
+WHERE pipeline_task_runs.finished_at IS NULL AND pipeline_task_runs.pending_deadline < $1 AND pipeline_runs.state = 'suspended'`, now)
+	return ids, errors.Wrap(err, "failed to load timed out task runs")
+}
+
 func (o *orm) GetAllRuns() (runs []Run, err error) {
 	var runsPtrs []*Run
 	err = o.q.Transaction(func(tx pg.Queryer) error {
@@ -586,7 +743,7 @@ func loadAssociations(q pg.Queryer, runs []*Run) error {
 			pipelineSpecIDM[run.PipelineSpecID] = Spec{}
 		}
 	}
-	if err := q.Select(&specs, `SELECT ps.id, ps.dot_dag_source, ps.created_at, ps.max_task_duration, coalesce(jobs.id, 0) "job_id", coalesce(jobs.name, '') "job_name", coalesce(jobs.type, '') "job_type" FROM pipeline_specs ps LEFT OUTER JOIN jobs ON jobs.pipeline_spec_id=ps.id WHERE ps.id = ANY($1)`, pipelineSpecIDs); err != nil {
+	if err := q.Select(&specs, `SELECT ps.id, ps.dot_dag_source, ps.created_at, ps.max_task_duration, coalesce(jobs.id, 0) "job_id", coalesce(jobs.name, '') "job_name", coalesce(jobs.type, '') "job_type", coalesce(jobs.simulate_transactions, false) "simulate_transactions", jobs.signing_keys FROM pipeline_specs ps LEFT OUTER JOIN jobs ON jobs.pipeline_spec_id=ps.id WHERE ps.id = ANY($1)`, pipelineSpecIDs); err != nil {
 		return errors.Wrap(err, "failed to postload pipeline_specs for runs")
 	}
 	for _, spec := range specs {
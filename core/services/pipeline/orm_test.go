@@ -1,6 +1,7 @@
 package pipeline_test
 
 import (
+	"database/sql"
 	"testing"
 	"time"
 
@@ -421,6 +422,74 @@ func Test_PipelineORM_StoreRun_UpdateTaskRunResult(t *testing.T) {
 	task2 := run.ByDotID("ds2")
 	cborOutput["contractAddress"] = "0x8bd112d3f8f92e41c861939545ad387307af9703"
 	require.Equal(t, pipeline.JSONSerializable{Val: cborOutput, Valid: true}, task2.Output)
+
+	// a task run which was resumed already is not timed out
+	_, _, err = orm.UpdatePendingTaskRunResult(ds1_id, pipeline.Result{Error: pipeline.ErrPendingTimeout})
+	require.ErrorIs(t, err, pipeline.ErrTaskRunFinished)
+	tr, err := orm.FindTaskRun(ds1_id)
+	require.NoError(t, err)
+	require.Equal(t, pipeline.JSONSerializable{Val: "foo", Valid: true}, tr.Output)
+	require.False(t, tr.Error.Valid)
+}
+
+func Test_PipelineORM_PendingTaskRuns_TimeOutAndCancel(t *testing.T) {
+	_, orm := setupLiteORM(t)
+	ctx := testutils.Context(t)
+
+	run := mustInsertAsyncRun(t, orm)
+	now := time.Now()
+	ds1ID, ds2ID := uuid.New(), uuid.New()
+	run.PipelineTaskRuns = []pipeline.TaskRun{
+		{
+			ID:               ds1ID,
+			PipelineRunID:    run.ID,
+			Type:             "bridge",
+			DotID:            "ds1",
+			CreatedAt:        now,
+			PendingDeadline:  null.TimeFrom(now.Add(time.Minute)),
+			ResumeBridgeName: null.StringFrom("signing-bridge"),
+		},
+		{
+			ID:            ds2ID,
+			PipelineRunID: run.ID,
+			Type:          "bridge",
+			DotID:         "ds2",
+			CreatedAt:     now,
+		},
+	}
+	restart, err := orm.StoreRun(run)
+	require.NoError(t, err)
+	require.False(t, restart)
+	require.Equal(t, pipeline.RunStatusSuspended, run.State)
+
+	tr, err := orm.FindTaskRun(ds1ID)
+	require.NoError(t, err)
+	assert.Equal(t, null.StringFrom("signing-bridge"), tr.ResumeBridgeName)
+	assert.True(t, tr.PendingDeadline.Valid)
+
+	ids, err := orm.GetTimedOutTaskRuns(ctx, now)
+	require.NoError(t, err)
+	assert.Empty(t, ids)
+	ids, err = orm.GetTimedOutTaskRuns(ctx, now.Add(2*time.Minute))
+	require.NoError(t, err)
+	assert.Equal(t, []uuid.UUID{ds1ID}, ids)
+
+	cancelled, err := orm.CancelRun(ctx, run.ID)
+	require.NoError(t, err)
+	assert.Equal(t, pipeline.RunStatusErrored, cancelled.State)
+	assert.True(t, cancelled.FinishedAt.Valid)
+	require.Len(t, cancelled.PipelineTaskRuns, 2)
+	for _, tr := range cancelled.PipelineTaskRuns {
+		assert.True(t, tr.FinishedAt.Valid)
+		assert.Equal(t, null.StringFrom(pipeline.ErrRunCancelled.Error()), tr.Error)
+	}
+
+	// finished runs are neither timed out nor cancelled again
+	ids, err = orm.GetTimedOutTaskRuns(ctx, now.Add(2*time.Minute))
+	require.NoError(t, err)
+	assert.Empty(t, ids)
+	_, err = orm.CancelRun(ctx, run.ID)
+	require.ErrorIs(t, err, pipeline.ErrRunNotSuspended)
 }
 
 func Test_PipelineORM_DeleteRun(t *testing.T) {
@@ -500,7 +569,7 @@ func Test_PipelineORM_DeleteRunsOlderThan(t *testing.T) {
 		runsIds = append(runsIds, run.ID)
 	}
 
-	err := orm.DeleteRunsOlderThan(testutils.Context(t), 1*time.Second)
+	err := orm.DeleteRunsOlderThan(testutils.Context(t), 1*time.Second, nil)
 	assert.NoError(t, err)
 
 	for _, runId := range runsIds {
@@ -509,6 +578,117 @@ func Test_PipelineORM_DeleteRunsOlderThan(t *testing.T) {
 	}
 }
 
+func Test_PipelineORM_HTTPCachedResponses(t *testing.T) {
+	_, orm := setupORM(t, false)
+	ctx := testutils.Context(t)
+
+	key := []byte("key")
+	_, err := orm.GetHTTPCachedResponse(ctx, key, time.Minute)
+	require.ErrorIs(t, err, sql.ErrNoRows)
+
+	finishedAt := time.Now().Add(-time.Minute).Truncate(time.Microsecond)
+	require.NoError(t, orm.UpsertHTTPCachedResponse(ctx, pipeline.HTTPCachedResponse{Key: key, Value: []byte("old"), FinishedAt: finishedAt}))
+
+	response, err := orm.GetHTTPCachedResponse(ctx, key, 2*time.Minute)
+	require.NoError(t, err)
+	assert.Equal(t, []byte("old"), response.Value)
+	assert.True(t, finishedAt.Equal(response.FinishedAt))
+
+	_, err = orm.GetHTTPCachedResponse(ctx, key, 30*time.Second)
+	require.ErrorIs(t, err, sql.ErrNoRows)
+
+	require.NoError(t, orm.UpsertHTTPCachedResponse(ctx, pipeline.HTTPCachedResponse{Key: key, Value: []byte("new"), FinishedAt: time.Now()}))
+	// an older response does not overwrite a newer one
+	require.NoError(t, orm.UpsertHTTPCachedResponse(ctx, pipeline.HTTPCachedResponse{Key: key, Value: []byte("stale"), FinishedAt: finishedAt}))
+	response, err = orm.GetHTTPCachedResponse(ctx, key, time.Minute)
+	require.NoError(t, err)
+	assert.Equal(t, []byte("new"), response.Value)
+
+	require.NoError(t, orm.DeleteHTTPCachedResponsesOlderThan(ctx, time.Hour))
+	_, err = orm.GetHTTPCachedResponse(ctx, key, time.Minute)
+	require.NoError(t, err)
+
+	require.NoError(t, orm.DeleteHTTPCachedResponsesOlderThan(ctx, 0))
+	_, err = orm.GetHTTPCachedResponse(ctx, key, time.Minute)
+	require.ErrorIs(t, err, sql.ErrNoRows)
+}
+
+func Test_PipelineORM_DeleteRunsOlderThan_Thresholds(t *testing.T) {
+	config := configtest.NewTestGeneralConfig(t)
+	lggr := logger.TestLogger(t)
+	db := pgtest.NewSqlxDB(t)
+	keyStore := cltest.NewKeyStore(t, db, config.Database())
+	porm := pipeline.NewORM(db, lggr, config.Database(), config.JobPipeline().MaxSuccessfulRuns())
+	bridgeORM := bridges.NewORM(db, lggr, config.Database())
+
+	jorm := job.NewORM(db, porm, bridgeORM, keyStore, lggr, config.Database())
+	defer func() { assert.NoError(t, jorm.Close()) }()
+
+	mustCreateWebhookJob := func(reaperThreshold time.Duration) int32 {
+		jb := job.Job{
+			WebhookSpec:     &job.WebhookSpec{},
+			ExternalJobID:   uuid.New(),
+			PipelineSpec:    &pipeline.Spec{},
+			Type:            job.Webhook,
+			SchemaVersion:   1,
+			MaxTaskDuration: models.Interval(time.Minute),
+			ReaperThreshold: models.Interval(reaperThreshold),
+		}
+		require.NoError(t, jorm.CreateJob(&jb))
+		return jb.PipelineSpecID
+	}
+	mustInsertFinishedRun := func(specID int32, age time.Duration) int64 {
+		finishedAt := time.Now().Add(-age)
+		run := &pipeline.Run{
+			PipelineSpecID: specID,
+			State:          pipeline.RunStatusCompleted,
+			AllErrors:      pipeline.RunErrors{null.StringFrom("SOMETHING")},
+			FatalErrors:    pipeline.RunErrors{null.StringFrom("SOMETHING")},
+			Outputs:        pipeline.JSONSerializable{Val: 1, Valid: true},
+			CreatedAt:      finishedAt,
+			FinishedAt:     null.TimeFrom(finishedAt),
+			PipelineTaskRuns: []pipeline.TaskRun{{
+				ID:         uuid.New(),
+				Type:       pipeline.TaskTypeHTTP,
+				DotID:      "ds1",
+				Output:     pipeline.JSONSerializable{Val: 1, Valid: true},
+				CreatedAt:  finishedAt,
+				FinishedAt: null.TimeFrom(finishedAt),
+			}},
+		}
+		require.NoError(t, porm.InsertFinishedRun(run, true))
+		return run.ID
+	}
+
+	noJobSpecID, err := porm.CreateSpec(pipeline.Pipeline{}, models.Interval(time.Minute))
+	require.NoError(t, err)
+
+	// no job: the node default of 1h applies
+	expired := mustInsertFinishedRun(noJobSpecID, 2*time.Hour)
+	kept := mustInsertFinishedRun(noJobSpecID, 30*time.Minute)
+	// the webhook job type keeps runs for 3h
+	webhookSpecID := mustCreateWebhookJob(0)
+	keptByJobType := mustInsertFinishedRun(webhookSpecID, 2*time.Hour)
+	expiredByJobType := mustInsertFinishedRun(webhookSpecID, 4*time.Hour)
+	// a job's own threshold takes precedence over both
+	shortSpecID := mustCreateWebhookJob(20 * time.Minute)
+	expiredByJob := mustInsertFinishedRun(shortSpecID, 30*time.Minute)
+	longSpecID := mustCreateWebhookJob(24 * time.Hour)
+	keptByJob := mustInsertFinishedRun(longSpecID, 4*time.Hour)
+
+	err = porm.DeleteRunsOlderThan(testutils.Context(t), time.Hour, map[string]time.Duration{"webhook": 3 * time.Hour})
+	require.NoError(t, err)
+
+	for _, id := range []int64{expired, expiredByJobType, expiredByJob} {
+		_, err = porm.FindRun(id)
+		require.Error(t, err, "run %d should have been deleted", id)
+	}
+	for _, id := range []int64{kept, keptByJobType, keptByJob} {
+		_, err = porm.FindRun(id)
+		require.NoError(t, err, "run %d should have been kept", id)
+	}
+}
+
 func Test_GetUnfinishedRuns_Keepers(t *testing.T) {
 	t.Parallel()
 
@@ -0,0 +1,104 @@
+package pipeline
+
+import (
+	"sync"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+)
+
+// runSubscriptionBuffer is how many runs a subscriber can fall behind by before runs are dropped for it.
+const runSubscriptionBuffer = 100
+
+var promRunSubscriptionDrops = promauto.NewCounter(prometheus.CounterOpts{
+	Name: "pipeline_run_subscription_drops_total",
+	Help: "Number of finished runs not delivered to a subscriber because it was too slow to receive them",
+})
+
+// RunFilter selects the runs a subscriber receives. The zero value matches all runs.
+type RunFilter struct {
+	// JobID, if set, only matches the runs of that job.
+	JobID int32
+	// States, if set, only matches runs in one of these states.
+	States []RunStatus
+}
+
+// Matches reports whether run is selected by the filter.
+func (f RunFilter) Matches(run *Run) bool {
+	if f.JobID != 0 && run.PipelineSpec.JobID != f.JobID {
+		return false
+	}
+	if len(f.States) == 0 {
+		return true
+	}
+	for _, state := range f.States {
+		if run.State == state {
+			return true
+		}
+	}
+	return false
+}
+
+type runSubscription struct {
+	filter RunFilter
+	ch     chan *Run
+}
+
+// RunBroadcaster fans out the runs finished by a Runner to any number of subscribers, e.g. to stream them to
+// clients. Register Broadcast with Runner.OnRunFinished to feed it.
+type RunBroadcaster struct {
+	lggr logger.Logger
+
+	mu   sync.RWMutex
+	subs map[*runSubscription]struct{}
+}
+
+func NewRunBroadcaster(lggr logger.Logger) *RunBroadcaster {
+	return &RunBroadcaster{
+		lggr: lggr.Named("RunBroadcaster"),
+		subs: make(map[*runSubscription]struct{}),
+	}
+}
+
+// Subscribe returns a channel receiving the finished runs matching filter, and a function to unsubscribe, which
+// closes the channel. Runs are dropped rather than delivered late if the subscriber falls too far behind.
+func (b *RunBroadcaster) Subscribe(filter RunFilter) (<-chan *Run, func()) {
+	sub := &runSubscription{filter: filter, ch: make(chan *Run, runSubscriptionBuffer)}
+	b.mu.Lock()
+	b.subs[sub] = struct{}{}
+	b.mu.Unlock()
+
+	var once sync.Once
+	return sub.ch, func() {
+		once.Do(func() {
+			b.mu.Lock()
+			delete(b.subs, sub)
+			b.mu.Unlock()
+			close(sub.ch)
+		})
+	}
+}
+
+// Broadcast delivers run to the matching subscribers. It never blocks, so that it is safe to call from the runner.
+func (b *RunBroadcaster) Broadcast(run *Run) {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	if len(b.subs) == 0 {
+		return
+	}
+	// subscribers read the run concurrently with the caller, so they get their own copy
+	r := *run
+	for sub := range b.subs {
+		if !sub.filter.Matches(&r) {
+			continue
+		}
+		select {
+		case sub.ch <- &r:
+		default:
+			promRunSubscriptionDrops.Inc()
+			b.lggr.Warnw("Subscriber is too slow, dropping run", "runID", r.ID, "jobID", r.PipelineSpec.JobID)
+		}
+	}
+}
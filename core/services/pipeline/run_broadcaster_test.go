@@ -0,0 +1,53 @@
+package pipeline_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
+)
+
+func TestRunBroadcaster(t *testing.T) {
+	t.Parallel()
+
+	b := pipeline.NewRunBroadcaster(logger.TestLogger(t))
+
+	all, unsubscribeAll := b.Subscribe(pipeline.RunFilter{})
+	defer unsubscribeAll()
+	job1Errored, unsubscribeJob1 := b.Subscribe(pipeline.RunFilter{JobID: 1, States: []pipeline.RunStatus{pipeline.RunStatusErrored}})
+
+	completed := &pipeline.Run{ID: 1, State: pipeline.RunStatusCompleted, PipelineSpec: pipeline.Spec{JobID: 1}}
+	errored := &pipeline.Run{ID: 2, State: pipeline.RunStatusErrored, PipelineSpec: pipeline.Spec{JobID: 1}}
+	otherJob := &pipeline.Run{ID: 3, State: pipeline.RunStatusErrored, PipelineSpec: pipeline.Spec{JobID: 2}}
+	for _, run := range []*pipeline.Run{completed, errored, otherJob} {
+		b.Broadcast(run)
+	}
+
+	for _, id := range []int64{1, 2, 3} {
+		run := <-all
+		assert.Equal(t, id, run.ID)
+	}
+	run := <-job1Errored
+	assert.Equal(t, int64(2), run.ID)
+	assert.Empty(t, job1Errored)
+
+	unsubscribeJob1()
+	_, open := <-job1Errored
+	assert.False(t, open)
+	// unsubscribing twice is harmless, and closed subscriptions no longer receive runs
+	unsubscribeJob1()
+	b.Broadcast(errored)
+	require.Equal(t, int64(2), (<-all).ID)
+
+	t.Run("drops runs for slow subscribers", func(t *testing.T) {
+		slow, unsubscribe := b.Subscribe(pipeline.RunFilter{JobID: 3})
+		defer unsubscribe()
+		for i := 0; i < 200; i++ {
+			b.Broadcast(&pipeline.Run{ID: int64(i), PipelineSpec: pipeline.Spec{JobID: 3}})
+		}
+		assert.Len(t, slow, 100)
+	})
+}
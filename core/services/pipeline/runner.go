@@ -12,6 +12,7 @@ import (
 	pkgerrors "github.com/pkg/errors"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promauto"
+	"golang.org/x/sync/singleflight"
 	"gopkg.in/guregu/null.v4"
 
 	"github.com/smartcontractkit/chainlink-common/pkg/services"
@@ -37,6 +38,8 @@ type Runner interface {
 	// Note that `saveSuccessfulTaskRuns` value is ignored if the run contains async tasks.
 	Run(ctx context.Context, run *Run, l logger.Logger, saveSuccessfulTaskRuns bool, fn func(tx pg.Queryer) error) (incomplete bool, err error)
 	ResumeRun(taskID uuid.UUID, value interface{}, err error) error
+	// CancelRun finishes a suspended run as errored, without waiting for its pending tasks.
+	CancelRun(ctx context.Context, runID int64) error
 
 	// ExecuteRun executes a new run in-memory according to a spec and returns the results.
 	// We expect spec.JobID and spec.JobName to be set for logging/prometheus.
@@ -50,6 +53,8 @@ type Runner interface {
 	// Note that the spec MUST have a DOT graph for this to work.
 	ExecuteAndInsertFinishedRun(ctx context.Context, spec Spec, vars Vars, l logger.Logger, saveSuccessfulTaskRuns bool) (runID int64, finalResult FinalResult, err error)
 
+	// OnRunFinished sets a callback invoked with every run that finishes, once it has been stored. Runs stored as
+	// part of a caller's transaction are reported before that transaction commits.
 	OnRunFinished(func(*Run))
 	InitializePipeline(spec Spec) (*Pipeline, error)
 }
@@ -67,8 +72,9 @@ type runner struct {
 	lggr                   logger.Logger
 	httpClient             *http.Client
 	unrestrictedHTTPClient *http.Client
+	httpCache              *HTTPResponseCache
+	bridgeRequests         singleflight.Group
 
-	// test helper
 	runFinished func(*Run)
 
 	chStop services.StopChan
@@ -103,8 +109,18 @@ var (
 	},
 		[]string{"job_id", "job_name", "task_id", "task_type", "bridge_name", "status"},
 	)
+	promPipelineTasksTimedOut = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "pipeline_tasks_pending_timed_out_total",
+		Help: "The total number of pending pipeline tasks which were failed because they were not resumed in time",
+	})
 )
 
+// pendingTimeoutInterval is how often pending tasks are checked for having passed their deadline.
+const pendingTimeoutInterval = 30 * time.Second
+
+// ErrPendingTimeout is the error of a pending task which was not resumed before its pendingTimeout.
+var ErrPendingTimeout = pkgerrors.New("timed out waiting for pending task to be resumed")
+
 func NewRunner(orm ORM, btORM bridges.ORM, cfg Config, bridgeCfg BridgeConfig, legacyChains legacyevm.LegacyChainContainer, ethks ETHKeyStore, vrfks VRFKeyStore, lggr logger.Logger, httpClient, unrestrictedHTTPClient *http.Client) *runner {
 	r := &runner{
 		orm:                    orm,
@@ -120,6 +136,7 @@ func NewRunner(orm ORM, btORM bridges.ORM, cfg Config, bridgeCfg BridgeConfig, l
 		lggr:                   lggr.Named("PipelineRunner"),
 		httpClient:             httpClient,
 		unrestrictedHTTPClient: unrestrictedHTTPClient,
+		httpCache:              NewHTTPResponseCache(orm, lggr),
 	}
 	r.runReaperWorker = commonutils.NewSleeperTask(
 		commonutils.SleeperFuncTask(r.runReaper, "PipelineRunnerReaper"),
@@ -130,8 +147,9 @@ func NewRunner(orm ORM, btORM bridges.ORM, cfg Config, bridgeCfg BridgeConfig, l
 // Start starts Runner.
 func (r *runner) Start(context.Context) error {
 	return r.StartOnce("PipelineRunner", func() error {
-		r.wgDone.Add(1)
+		r.wgDone.Add(2)
 		go r.scheduleUnfinishedRuns()
+		go r.pendingTimeoutLoop()
 		if r.config.ReaperInterval() != time.Duration(0) {
 			r.wgDone.Add(1)
 			go r.runReaperLoop()
@@ -183,6 +201,48 @@ func (r *runner) runReaperLoop() {
 	}
 }
 
+func (r *runner) pendingTimeoutLoop() {
+	defer r.wgDone.Done()
+
+	ticker := time.NewTicker(utils.WithJitter(pendingTimeoutInterval))
+	defer ticker.Stop()
+	for {
+		select {
+		case <-r.chStop:
+			return
+		case <-ticker.C:
+			r.timeOutPendingTasks()
+		}
+	}
+}
+
+// timeOutPendingTasks fails the pending tasks which have not been resumed before their deadline, resuming their runs.
+func (r *runner) timeOutPendingTasks() {
+	ctx, cancel := r.chStop.NewCtx()
+	defer cancel()
+
+	ids, err := r.orm.GetTimedOutTaskRuns(ctx, time.Now())
+	if err != nil {
+		r.lggr.Errorw("Failed to load timed out pending tasks", "err", err)
+		return
+	}
+	for _, id := range ids {
+		run, start, err := r.orm.UpdatePendingTaskRunResult(id, Result{Error: ErrPendingTimeout})
+		if pkgerrors.Is(err, ErrTaskRunFinished) {
+			// the task was resumed since it was loaded, so its result is kept
+			continue
+		} else if err != nil {
+			r.lggr.Errorw("Failed to time out pending task", "taskRunID", id, "err", err)
+			continue
+		}
+		r.lggr.Warnw("Pending task was not resumed in time, failed it", "taskRunID", id)
+		promPipelineTasksTimedOut.Inc()
+		if start {
+			r.startResumedRun(run)
+		}
+	}
+}
+
 type memoryTaskRun struct {
 	task     Task
 	inputs   []Result // sorted by input index
@@ -281,6 +341,7 @@ func (r *runner) InitializePipeline(spec Spec) (pipeline *Pipeline, err error) {
 			task.(*HTTPTask).config = r.config
 			task.(*HTTPTask).httpClient = r.httpClient
 			task.(*HTTPTask).unrestrictedHTTPClient = r.unrestrictedHTTPClient
+			task.(*HTTPTask).cache = r.httpCache
 		case TaskTypeBridge:
 			task.(*BridgeTask).config = r.config
 			task.(*BridgeTask).bridgeConfig = r.bridgeConfig
@@ -290,6 +351,7 @@ func (r *runner) InitializePipeline(spec Spec) (pipeline *Pipeline, err error) {
 			// must use the unrestrictedHTTPClient because some node operators
 			// may run external adapters on their own hardware
 			task.(*BridgeTask).httpClient = r.unrestrictedHTTPClient
+			task.(*BridgeTask).requestGroup = &r.bridgeRequests
 		case TaskTypeETHCall:
 			task.(*ETHCallTask).legacyChains = r.legacyEVMChains
 			task.(*ETHCallTask).config = r.config
@@ -311,6 +373,10 @@ func (r *runner) InitializePipeline(spec Spec) (pipeline *Pipeline, err error) {
 			task.(*ETHTxTask).specGasLimit = spec.GasLimit
 			task.(*ETHTxTask).jobType = spec.JobType
 			task.(*ETHTxTask).forwardingAllowed = spec.ForwardingAllowed
+			task.(*ETHTxTask).simulateTransactions = spec.SimulateTransactions
+		case TaskTypeSign:
+			task.(*SignTask).keyStore = r.ethKeyStore
+			task.(*SignTask).signingKeys = spec.SigningAddresses()
 		default:
 		}
 	}
@@ -375,17 +441,27 @@ func (r *runner) run(ctx context.Context, pipeline *Pipeline, run *Run, vars Var
 	run.PipelineTaskRuns = nil
 	for _, result := range scheduler.results {
 		output := result.Result.OutputDB()
+		var pendingDeadline null.Time
+		if result.runInfo.IsPending && result.runInfo.PendingTimeout > 0 {
+			pendingDeadline = null.TimeFrom(result.CreatedAt.Add(result.runInfo.PendingTimeout))
+		}
+		var resumeBridgeName null.String
+		if result.runInfo.IsPending && result.runInfo.ResumeBridgeName != "" {
+			resumeBridgeName = null.StringFrom(result.runInfo.ResumeBridgeName)
+		}
 		run.PipelineTaskRuns = append(run.PipelineTaskRuns, TaskRun{
-			ID:            result.ID,
-			PipelineRunID: run.ID,
-			Type:          result.Task.Type(),
-			Index:         result.Task.OutputIndex(),
-			Output:        output,
-			Error:         result.Result.ErrorDB(),
-			DotID:         result.Task.DotID(),
-			CreatedAt:     result.CreatedAt,
-			FinishedAt:    result.FinishedAt,
-			task:          result.Task,
+			ID:               result.ID,
+			PipelineRunID:    run.ID,
+			Type:             result.Task.Type(),
+			Index:            result.Task.OutputIndex(),
+			Output:           output,
+			Error:            result.Result.ErrorDB(),
+			DotID:            result.Task.DotID(),
+			CreatedAt:        result.CreatedAt,
+			FinishedAt:       result.FinishedAt,
+			PendingDeadline:  pendingDeadline,
+			ResumeBridgeName: resumeBridgeName,
+			task:             result.Task,
 		})
 
 		sort.Slice(run.PipelineTaskRuns, func(i, j int) bool {
@@ -534,6 +610,7 @@ func (r *runner) ExecuteAndInsertFinishedRun(ctx context.Context, spec Spec, var
 	if err = r.orm.InsertFinishedRun(run, saveSuccessfulTaskRuns); err != nil {
 		return 0, finalResult, pkgerrors.Wrapf(err, "error inserting finished results for spec ID %v", spec.ID)
 	}
+	r.runFinished(run)
 	return run.ID, finalResult, nil
 
 }
@@ -644,23 +721,47 @@ func (r *runner) ResumeRun(taskID uuid.UUID, value interface{}, err error) error
 	// TODO: Should probably replace this with a listener to update events
 	// which allows to pass in a transactionalised database to this function
 	if start {
-		// start the runner again
-		go func() {
-			if _, err := r.Run(context.Background(), &run, r.lggr, false, nil); err != nil {
-				r.lggr.Errorw("Resume run failure", "err", err)
-			}
-			r.lggr.Debug("Resume run success")
-		}()
+		r.startResumedRun(run)
 	}
 	return nil
 }
 
+// startResumedRun runs the rest of the pipeline of a run whose pending task was resumed.
+func (r *runner) startResumedRun(run Run) {
+	go func() {
+		if _, err := r.Run(context.Background(), &run, r.lggr, false, nil); err != nil {
+			r.lggr.Errorw("Resume run failure", "err", err)
+		}
+		r.lggr.Debug("Resume run success")
+	}()
+}
+
+func (r *runner) CancelRun(ctx context.Context, runID int64) error {
+	run, err := r.orm.CancelRun(ctx, runID)
+	if err != nil {
+		return err
+	}
+	r.lggr.Infow("Cancelled pipeline run", "runID", runID, "jobID", run.PipelineSpec.JobID)
+	r.runFinished(&run)
+	return nil
+}
+
 func (r *runner) InsertFinishedRun(run *Run, saveSuccessfulTaskRuns bool, qopts ...pg.QOpt) error {
-	return r.orm.InsertFinishedRun(run, saveSuccessfulTaskRuns, qopts...)
+	if err := r.orm.InsertFinishedRun(run, saveSuccessfulTaskRuns, qopts...); err != nil {
+		return err
+	}
+	r.runFinished(run)
+	return nil
 }
 
 func (r *runner) InsertFinishedRuns(runs []*Run, saveSuccessfulTaskRuns bool, qopts ...pg.QOpt) error {
-	return r.orm.InsertFinishedRuns(runs, saveSuccessfulTaskRuns, qopts...)
+	if err := r.orm.InsertFinishedRuns(runs, saveSuccessfulTaskRuns, qopts...); err != nil {
+		return err
+	}
+	for _, run := range runs {
+		r.runFinished(run)
+	}
+	return nil
 }
 
 func (r *runner) runReaper() {
@@ -668,13 +769,19 @@ func (r *runner) runReaper() {
 	ctx, cancel := r.chStop.CtxCancel(context.WithTimeout(context.Background(), r.config.ReaperInterval()))
 	defer cancel()
 
-	err := r.orm.DeleteRunsOlderThan(ctx, r.config.ReaperThreshold())
+	err := r.orm.DeleteRunsOlderThan(ctx, r.config.ReaperThreshold(), r.config.ReaperThresholdByJobType())
 	if err != nil {
 		r.lggr.Errorw("Pipeline run reaper failed", "err", err)
 		r.SvcErrBuffer.Append(err)
 	} else {
 		r.lggr.Debugw("Pipeline run reaper completed successfully")
 	}
+
+	// http task responses are never used once they are older than stalenessCap
+	if err := r.httpCache.Prune(ctx, stalenessCap); err != nil {
+		r.lggr.Errorw("Pipeline run reaper failed to prune cached http task responses", "err", err)
+		r.SvcErrBuffer.Append(err)
+	}
 }
 
 // init task: Searches the database for runs stuck in the 'running' state while the node was previously killed.
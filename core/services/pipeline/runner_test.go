@@ -14,6 +14,8 @@ import (
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
 	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -24,6 +26,8 @@ import (
 
 	"github.com/smartcontractkit/chainlink/v2/core/bridges"
 	bridgesMocks "github.com/smartcontractkit/chainlink/v2/core/bridges/mocks"
+	txmmocks "github.com/smartcontractkit/chainlink/v2/core/chains/evm/txmgr/mocks"
+	evmmocks "github.com/smartcontractkit/chainlink/v2/core/chains/legacyevm/mocks"
 	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
 	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
 	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/configtest"
@@ -32,6 +36,7 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/pgtest"
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
 	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
+	keystoremocks "github.com/smartcontractkit/chainlink/v2/core/services/keystore/mocks"
 	"github.com/smartcontractkit/chainlink/v2/core/services/pg"
 	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
 	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline/mocks"
@@ -981,4 +986,108 @@ succeed;
 		require.Len(t, trrs, 1)
 		assert.Equal(t, "1", trrs[0].Result.Value.(pipeline.ObjectParam).DecimalValue.Decimal().String())
 	})
+
+	t.Run("returns the transactions of simulated ethtx tasks", func(t *testing.T) {
+		cfg := configtest.NewTestGeneralConfig(t)
+		lggr := logger.TestLogger(t)
+		from, to := testutils.NewAddress(), testutils.NewAddress()
+
+		// the tx manager has no expectations, so sending a transaction fails the test
+		chain := evmmocks.NewChain(t)
+		chain.On("Config").Return(evmtest.NewChainScopedConfig(t, cfg))
+		chain.On("ID").Return(testutils.FixtureChainID)
+		chain.On("TxManager").Return(txmmocks.NewMockEvmTxManager(t))
+		legacyChains := evmmocks.NewLegacyChainContainer(t)
+		legacyChains.On("Get", testutils.FixtureChainID.String()).Return(chain, nil)
+		ethKeyStore := keystoremocks.NewEth(t)
+		ethKeyStore.On("GetRoundRobinAddress", mock.Anything, testutils.FixtureChainID, from).Return(from, nil)
+		r := pipeline.NewRunner(nil, nil, cfg.JobPipeline(), cfg.WebServer(), legacyChains, ethKeyStore, nil, lggr, nil, nil)
+
+		spec := pipeline.Spec{DotDagSource: `
+tx [type=ethtx from="$(from)" to="$(to)" data="0x0102" gasLimit=21000 minConfirmations=2 evmChainID=0];
+`}
+		p, err := r.InitializePipeline(spec)
+		require.NoError(t, err)
+		p.Simulate()
+		spec.Pipeline = p
+
+		vars := pipeline.NewVarsFrom(map[string]interface{}{
+			"from": []common.Address{from},
+			"to":   to,
+		})
+		_, trrs, err := r.ExecuteRun(testutils.Context(t), spec, vars, lggr)
+		require.NoError(t, err)
+		require.Len(t, trrs, 1)
+		require.NoError(t, trrs[0].Result.Error)
+		assert.Equal(t, map[string]interface{}{
+			"from":             from.Hex(),
+			"to":               to.Hex(),
+			"data":             "0x0102",
+			"gasLimit":         uint64(21000),
+			"forwarder":        common.Address{}.Hex(),
+			"minConfirmations": uint64(2),
+		}, trrs[0].Result.Value)
+	})
+
+	t.Run("does not sign in simulated sign tasks", func(t *testing.T) {
+		cfg := configtest.NewTestGeneralConfig(t)
+		lggr := logger.TestLogger(t)
+		address := testutils.NewAddress()
+
+		// the keystore has no expectations, so signing fails the test
+		r := pipeline.NewRunner(nil, nil, cfg.JobPipeline(), cfg.WebServer(), nil, keystoremocks.NewEth(t), nil, lggr, nil, nil)
+
+		spec := pipeline.Spec{
+			DotDagSource: fmt.Sprintf(`sign [type=sign address="%s" data="0x%064x"];`, address.Hex(), 1),
+			SigningKeys:  pq.StringArray{address.Hex()},
+		}
+		p, err := r.InitializePipeline(spec)
+		require.NoError(t, err)
+		p.Simulate()
+		spec.Pipeline = p
+
+		_, trrs, err := r.ExecuteRun(testutils.Context(t), spec, pipeline.NewVarsFrom(nil), lggr)
+		require.NoError(t, err)
+		require.Len(t, trrs, 1)
+		require.ErrorContains(t, trrs[0].Result.Error, "sign tasks cannot be simulated")
+	})
+}
+
+func Test_PipelineRunner_TimeOutPendingTasks(t *testing.T) {
+	t.Parallel()
+
+	cfg := configtest.NewTestGeneralConfig(t)
+	orm := mocks.NewORM(t)
+	r := pipeline.NewRunner(orm, nil, cfg.JobPipeline(), cfg.WebServer(), nil, nil, nil, logger.TestLogger(t), nil, nil)
+
+	id, resumedID := uuid.New(), uuid.New()
+	orm.On("GetTimedOutTaskRuns", mock.Anything, mock.Anything).Return([]uuid.UUID{id, resumedID}, nil).Once()
+	orm.On("UpdatePendingTaskRunResult", id, pipeline.Result{Error: pipeline.ErrPendingTimeout}).Return(pipeline.Run{}, false, nil).Once()
+	// a task resumed since it was loaded keeps its result
+	orm.On("UpdatePendingTaskRunResult", resumedID, pipeline.Result{Error: pipeline.ErrPendingTimeout}).Return(pipeline.Run{}, false, pipeline.ErrTaskRunFinished).Once()
+
+	r.HelperTimeOutPendingTasks()
+}
+
+func Test_PipelineRunner_CancelRun(t *testing.T) {
+	t.Parallel()
+
+	cfg := configtest.NewTestGeneralConfig(t)
+	orm := mocks.NewORM(t)
+	r := pipeline.NewRunner(orm, nil, cfg.JobPipeline(), cfg.WebServer(), nil, nil, nil, logger.TestLogger(t), nil, nil)
+
+	var finished []*pipeline.Run
+	r.OnRunFinished(func(run *pipeline.Run) {
+		finished = append(finished, run)
+	})
+
+	ctx := testutils.Context(t)
+	orm.On("CancelRun", ctx, int64(1)).Return(pipeline.Run{ID: 1, State: pipeline.RunStatusErrored}, nil).Once()
+	require.NoError(t, r.CancelRun(ctx, 1))
+	require.Len(t, finished, 1)
+	assert.Equal(t, pipeline.RunStatusErrored, finished[0].State)
+
+	orm.On("CancelRun", ctx, int64(2)).Return(pipeline.Run{}, pipeline.ErrRunNotSuspended).Once()
+	require.ErrorIs(t, r.CancelRun(ctx, 2), pipeline.ErrRunNotSuspended)
+	assert.Len(t, finished, 1)
 }
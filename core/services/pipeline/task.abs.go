@@ -0,0 +1,38 @@
+package pipeline
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+)
+
+// Return types:
+//
+//	*decimal.Decimal
+type AbsTask struct {
+	BaseTask `mapstructure:",squash"`
+	Input    string `json:"input"`
+}
+
+var _ Task = (*AbsTask)(nil)
+
+func (t *AbsTask) Type() TaskType {
+	return TaskTypeAbs
+}
+
+func (t *AbsTask) Run(_ context.Context, _ logger.Logger, vars Vars, inputs []Result) (result Result, runInfo RunInfo) {
+	_, err := CheckInputs(inputs, 0, 1, 0)
+	if err != nil {
+		return Result{Error: errors.Wrap(err, "task inputs")}, runInfo
+	}
+
+	var a DecimalParam
+	err = errors.Wrap(ResolveParam(&a, From(VarExpr(t.Input, vars), NonemptyString(t.Input), Input(inputs, 0))), "input")
+	if err != nil {
+		return Result{Error: err}, runInfo
+	}
+
+	return Result{Value: a.Decimal().Abs()}, runInfo
+}
@@ -0,0 +1,63 @@
+package pipeline_test
+
+import (
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
+)
+
+func TestAbsTask(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		input interface{}
+		want  *decimal.Decimal
+		error error
+	}{
+		{"positive string", "1.23", mustDecimal(t, "1.23"), nil},
+		{"negative string", "-1.23", mustDecimal(t, "1.23"), nil},
+		{"zero", "0", mustDecimal(t, "0"), nil},
+		{"negative int", int(-2), mustDecimal(t, "2"), nil},
+		{"negative float64", float64(-0.5), mustDecimal(t, "0.5"), nil},
+		{"negative decimal", mustDecimal(t, "-100000000000000000000.1"), mustDecimal(t, "100000000000000000000.1"), nil},
+		{"bad input", "foo", nil, pipeline.ErrBadInput},
+		{"map", map[string]interface{}{"foo": 1}, nil, pipeline.ErrBadInput},
+	}
+
+	for _, test := range tests {
+		assertOK := func(result pipeline.Result, runInfo pipeline.RunInfo) {
+			assert.False(t, runInfo.IsPending)
+			assert.False(t, runInfo.IsRetryable)
+			if test.error != nil {
+				require.Equal(t, test.error, errors.Cause(result.Error))
+				return
+			}
+			require.NoError(t, result.Error)
+			require.Equal(t, test.want.String(), result.Value.(decimal.Decimal).String())
+		}
+		t.Run(test.name, func(t *testing.T) {
+			t.Run("without vars through job DAG", func(t *testing.T) {
+				task := pipeline.AbsTask{BaseTask: pipeline.NewBaseTask(0, "task", nil, nil, 0)}
+				assertOK(task.Run(testutils.Context(t), logger.TestLogger(t), pipeline.NewVarsFrom(nil), []pipeline.Result{{Value: test.input}}))
+			})
+			t.Run("with vars", func(t *testing.T) {
+				vars := pipeline.NewVarsFrom(map[string]interface{}{
+					"foo": map[string]interface{}{"bar": test.input},
+				})
+				task := pipeline.AbsTask{
+					BaseTask: pipeline.NewBaseTask(0, "task", nil, nil, 0),
+					Input:    "$(foo.bar)",
+				}
+				assertOK(task.Run(testutils.Context(t), logger.TestLogger(t), vars, []pipeline.Result{}))
+			})
+		})
+	}
+}
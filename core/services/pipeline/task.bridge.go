@@ -13,6 +13,7 @@ import (
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promauto"
 	"go.uber.org/multierr"
+	"golang.org/x/sync/singleflight"
 
 	"github.com/smartcontractkit/chainlink/v2/core/bridges"
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
@@ -49,6 +50,18 @@ var (
 	},
 		[]string{"name"},
 	)
+	promBridgeRequests = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "bridge_requests_total",
+		Help: "Bridge requests count scoped by name, including the ones coalesced with an identical in-flight request",
+	},
+		[]string{"name"},
+	)
+	promBridgeCoalescedRequests = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "bridge_coalesced_requests_total",
+		Help: "Bridge requests count scoped by name which were served by an identical in-flight request instead of making their own",
+	},
+		[]string{"name"},
+	)
 )
 
 // Return types:
@@ -63,12 +76,19 @@ type BridgeTask struct {
 	Async             string `json:"async"`
 	CacheTTL          string `json:"cacheTTL"`
 	Headers           string `json:"headers"`
+	// PendingTimeout fails an async task which is not resumed within it.
+	PendingTimeout string `json:"pendingTimeout"`
+	// SignedResume requires the result resuming an async task to be signed with the bridge's outgoing token.
+	SignedResume string `json:"signedResume"`
 
 	specId       int32
 	orm          bridges.ORM
 	config       Config
 	bridgeConfig BridgeConfig
 	httpClient   *http.Client
+	requestGroup *singleflight.Group
+	// simulate, if set, does not cache the response
+	simulate bool
 }
 
 var _ Task = (*BridgeTask)(nil)
@@ -93,6 +113,8 @@ func (t *BridgeTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, inp
 		includeInputAtKey StringParam
 		cacheTTL          Uint64Param
 		reqHeaders        StringSliceParam
+		pendingTimeout    Uint64Param
+		signedResume      BoolParam
 	)
 	err = multierr.Combine(
 		errors.Wrap(ResolveParam(&name, From(NonemptyString(t.Name))), "name"),
@@ -100,6 +122,8 @@ func (t *BridgeTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, inp
 		errors.Wrap(ResolveParam(&includeInputAtKey, From(t.IncludeInputAtKey)), "includeInputAtKey"),
 		errors.Wrap(ResolveParam(&cacheTTL, From(ValidDurationInSeconds(t.CacheTTL), t.bridgeConfig.BridgeCacheTTL().Seconds())), "cacheTTL"),
 		errors.Wrap(ResolveParam(&reqHeaders, From(NonemptyString(t.Headers), "[]")), "reqHeaders"),
+		errors.Wrap(ResolveParam(&pendingTimeout, From(ValidDurationInSeconds(t.PendingTimeout), 0)), "pendingTimeout"),
+		errors.Wrap(ResolveParam(&signedResume, From(NonemptyString(t.SignedResume), false)), "signedResume"),
 	)
 	if err != nil {
 		return Result{Error: err}, runInfo
@@ -167,7 +191,7 @@ func (t *BridgeTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, inp
 	}
 
 	var cachedResponse bool
-	responseBytes, statusCode, headers, elapsed, err := makeHTTPRequest(requestCtx, lggr, "POST", url, reqHeaders, requestData, t.httpClient, t.config.DefaultHTTPLimit())
+	responseBytes, statusCode, headers, elapsed, err := t.makeRequest(requestCtx, lggr, name, url, reqHeaders, requestData, requestDataJSON)
 
 	// check for external adapter response object status
 	if code, ok := eautils.BestEffortExtractEAStatus(responseBytes); ok {
@@ -203,20 +227,26 @@ func (t *BridgeTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, inp
 	}
 
 	if t.Async == "true" {
+		pendingInfo := pendingRunInfo()
+		pendingInfo.PendingTimeout = time.Duration(pendingTimeout) * time.Second
+		if signedResume {
+			pendingInfo.ResumeBridgeName = string(name)
+		}
+
 		// Look for a `pending` flag. This check is case-insensitive because http.Header normalizes header names
 		if _, ok := headers["X-Chainlink-Pending"]; ok {
-			return result, pendingRunInfo()
+			return result, pendingInfo
 		}
 
 		var response struct {
 			Pending bool `json:"pending"`
 		}
 		if err := json.Unmarshal(responseBytes, &response); err == nil && response.Pending {
-			return Result{}, pendingRunInfo()
+			return Result{}, pendingInfo
 		}
 	}
 
-	if !cachedResponse && cacheTTL > 0 {
+	if !cachedResponse && cacheTTL > 0 && !t.simulate {
 		err := t.orm.UpsertBridgeResponse(t.dotID, t.specId, responseBytes)
 		if err != nil {
 			lggr.Errorw("Bridge task: failed to upsert response in bridge cache", "err", err)
@@ -241,6 +271,78 @@ func (t *BridgeTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, inp
 	return result, runInfo
 }
 
+// bridgeResponse is the outcome of a bridge request, shared by all the tasks it was coalesced for.
+type bridgeResponse struct {
+	body       []byte
+	statusCode int
+	headers    http.Header
+	elapsed    time.Duration
+}
+
+// interruptedRequestError is the error of a coalesced bridge request which was interrupted by the context of the task
+// which made it, so the other tasks it was coalesced for make it again.
+type interruptedRequestError struct {
+	err error
+}
+
+func (e *interruptedRequestError) Error() string { return e.err.Error() }
+
+func (e *interruptedRequestError) Unwrap() error { return e.err }
+
+// makeRequest makes the bridge request, coalescing it with an identical request to the same bridge that is already
+// in flight, so that many jobs asking the same thing at once only make one call to the external adapter. Async
+// requests are never coalesced, as their responseURL is unique to the task run. The request is made with the context
+// of the task which made it first: if that context is done before the request completes, the other tasks make the
+// request again, instead of failing with it.
+func (t *BridgeTask) makeRequest(ctx context.Context, lggr logger.Logger, name StringParam, url URLParam, reqHeaders []string, requestData MapParam, requestDataJSON []byte) ([]byte, int, http.Header, time.Duration, error) {
+	if t.requestGroup == nil || t.Async == "true" {
+		return makeHTTPRequest(ctx, lggr, "POST", url, reqHeaders, requestData, t.httpClient, t.config.DefaultHTTPLimit())
+	}
+
+	key, err := json.Marshal([]interface{}{name, reqHeaders, json.RawMessage(requestDataJSON)})
+	if err != nil {
+		return nil, 0, nil, 0, err
+	}
+
+	promBridgeRequests.WithLabelValues(t.Name).Inc()
+	for {
+		var executed bool
+		ch := t.requestGroup.DoChan(string(key), func() (interface{}, error) {
+			executed = true
+			body, statusCode, headers, elapsed, err := makeHTTPRequest(ctx, lggr, "POST", url, reqHeaders, requestData, t.httpClient, t.config.DefaultHTTPLimit())
+			if err != nil && ctx.Err() != nil {
+				err = &interruptedRequestError{err}
+			}
+			return bridgeResponse{body, statusCode, headers, elapsed}, err
+		})
+		select {
+		case res := <-ch:
+			var interrupted *interruptedRequestError
+			if errors.As(res.Err, &interrupted) {
+				if !executed {
+					lggr.Debugw("Bridge task: coalesced request was interrupted by the task which made it, retrying",
+						"url", url.String(),
+						"dotID", t.DotID(),
+					)
+					continue
+				}
+				res.Err = interrupted.err
+			}
+			if !executed {
+				promBridgeCoalescedRequests.WithLabelValues(t.Name).Inc()
+				lggr.Debugw("Bridge task: coalesced with an identical in-flight request",
+					"url", url.String(),
+					"dotID", t.DotID(),
+				)
+			}
+			response := res.Val.(bridgeResponse)
+			return response.body, response.statusCode, response.headers, response.elapsed, res.Err
+		case <-ctx.Done():
+			return nil, 0, nil, 0, errors.New("http request timed out or interrupted")
+		}
+	}
+}
+
 func (t BridgeTask) getBridgeURLFromName(name StringParam) (URLParam, error) {
 	bt, err := t.orm.FindBridge(bridges.BridgeName(name))
 	if err != nil {
@@ -1,6 +1,7 @@
 package pipeline_test
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -10,6 +11,7 @@ import (
 	"net/url"
 	"os"
 	"sort"
+	"sync"
 	"sync/atomic"
 	"testing"
 	"time"
@@ -18,10 +20,13 @@ import (
 	"github.com/pkg/errors"
 	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
+	"golang.org/x/sync/singleflight"
 
 	commonconfig "github.com/smartcontractkit/chainlink-common/pkg/config"
 	"github.com/smartcontractkit/chainlink/v2/core/bridges"
+	bridgesMocks "github.com/smartcontractkit/chainlink/v2/core/bridges/mocks"
 	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
 	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
 	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/configtest"
@@ -442,6 +447,142 @@ func TestBridgeTask_DoesNotReturnStaleResults(t *testing.T) {
 	require.Equal(t, string(big.NewInt(9700).Bytes()), result2.Value)
 }
 
+func TestBridgeTask_CoalescesIdenticalRequests(t *testing.T) {
+	t.Parallel()
+
+	cfg := configtest.NewTestGeneralConfig(t)
+
+	var calls atomic.Int32
+	release := make(chan struct{})
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		<-release
+		w.Header().Set("Content-Type", "application/json")
+		_, err := w.Write([]byte(`{"data": {"result": 42}}`))
+		require.NoError(t, err)
+	})
+	server := httptest.NewServer(handler)
+	defer server.Close()
+	feedURL, err := url.ParseRequestURI(server.URL)
+	require.NoError(t, err)
+
+	orm := bridgesMocks.NewORM(t)
+	orm.On("FindBridge", mock.Anything).Return(bridges.BridgeType{URL: models.WebURL(*feedURL)}, nil)
+
+	var group singleflight.Group
+	c := clhttptest.NewTestLocalOnlyHTTPClient()
+	run := func(requestData string, async string) pipeline.Result {
+		task := pipeline.BridgeTask{
+			BaseTask:    pipeline.NewBaseTask(0, "bridge", nil, nil, 0),
+			Name:        "bridge",
+			RequestData: requestData,
+			Async:       async,
+		}
+		task.HelperSetDependencies(cfg.JobPipeline(), cfg.WebServer(), orm, 0, uuid.New(), c)
+		task.HelperSetRequestGroup(&group)
+		result, _ := task.Run(testutils.Context(t), logger.TestLogger(t), pipeline.NewVarsFrom(nil), nil)
+		return result
+	}
+
+	var wg sync.WaitGroup
+	results := make([]pipeline.Result, 5)
+	start := func(i int, requestData string, async string) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			results[i] = run(requestData, async)
+		}()
+	}
+
+	start(0, ethUSDPairing, "")
+	require.Eventually(t, func() bool { return calls.Load() == 1 }, testutils.WaitTimeout(t), 10*time.Millisecond)
+	// identical requests join the one in flight
+	start(1, ethUSDPairing, "")
+	start(2, ethUSDPairing, "")
+	// a different body or an async request make their own call
+	start(3, btcUSDPairing, "")
+	start(4, ethUSDPairing, "true")
+	require.Eventually(t, func() bool { return calls.Load() == 3 }, testutils.WaitTimeout(t), 10*time.Millisecond)
+	time.Sleep(100 * time.Millisecond)
+	close(release)
+	wg.Wait()
+
+	assert.Equal(t, int32(3), calls.Load())
+	for _, result := range results {
+		require.NoError(t, result.Error)
+	}
+	for _, result := range results[:4] {
+		assert.Equal(t, `{"data": {"result": 42}}`, result.Value)
+	}
+}
+
+func TestBridgeTask_RetriesCoalescedRequestInterruptedByLeader(t *testing.T) {
+	t.Parallel()
+
+	cfg := configtest.NewTestGeneralConfig(t)
+
+	var calls atomic.Int32
+	release := make(chan struct{})
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		select {
+		case <-release:
+		case <-r.Context().Done():
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, err := w.Write([]byte(`{"data": {"result": 42}}`))
+		require.NoError(t, err)
+	})
+	server := httptest.NewServer(handler)
+	defer server.Close()
+	feedURL, err := url.ParseRequestURI(server.URL)
+	require.NoError(t, err)
+
+	orm := bridgesMocks.NewORM(t)
+	orm.On("FindBridge", mock.Anything).Return(bridges.BridgeType{URL: models.WebURL(*feedURL)}, nil)
+
+	var group singleflight.Group
+	c := clhttptest.NewTestLocalOnlyHTTPClient()
+	run := func(ctx context.Context) pipeline.Result {
+		task := pipeline.BridgeTask{
+			BaseTask:    pipeline.NewBaseTask(0, "bridge", nil, nil, 0),
+			Name:        "bridge",
+			RequestData: ethUSDPairing,
+		}
+		task.HelperSetDependencies(cfg.JobPipeline(), cfg.WebServer(), orm, 0, uuid.New(), c)
+		task.HelperSetRequestGroup(&group)
+		result, _ := task.Run(ctx, logger.TestLogger(t), pipeline.NewVarsFrom(nil), nil)
+		return result
+	}
+
+	leaderCtx, cancel := context.WithCancel(testutils.Context(t))
+	var leader, follower pipeline.Result
+	var wg sync.WaitGroup
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		leader = run(leaderCtx)
+	}()
+	require.Eventually(t, func() bool { return calls.Load() == 1 }, testutils.WaitTimeout(t), 10*time.Millisecond)
+	go func() {
+		defer wg.Done()
+		follower = run(testutils.Context(t))
+	}()
+	time.Sleep(100 * time.Millisecond)
+
+	// the follower makes the request again once the leader gives up on it
+	cancel()
+	require.Eventually(t, func() bool { return calls.Load() == 2 }, testutils.WaitTimeout(t), 10*time.Millisecond)
+	close(release)
+	wg.Wait()
+
+	require.Error(t, leader.Error)
+	require.NoError(t, follower.Error)
+	assert.Equal(t, `{"data": {"result": 42}}`, follower.Value)
+	assert.Equal(t, int32(2), calls.Load())
+}
+
 func TestBridgeTask_AsyncJobPendingState(t *testing.T) {
 	t.Parallel()
 
@@ -489,9 +630,22 @@ func TestBridgeTask_AsyncJobPendingState(t *testing.T) {
 	result, runInfo := task.Run(testutils.Context(t), logger.TestLogger(t), pipeline.NewVarsFrom(nil), nil)
 	assert.True(t, runInfo.IsPending)
 	assert.False(t, runInfo.IsRetryable)
+	assert.Zero(t, runInfo.PendingTimeout)
+	assert.Empty(t, runInfo.ResumeBridgeName)
 
 	require.NoError(t, result.Error)
 	require.Nil(t, result.Value)
+
+	t.Run("with pendingTimeout and signedResume", func(t *testing.T) {
+		task.PendingTimeout = "1h"
+		task.SignedResume = "true"
+
+		result, runInfo := task.Run(testutils.Context(t), logger.TestLogger(t), pipeline.NewVarsFrom(nil), nil)
+		require.NoError(t, result.Error)
+		assert.True(t, runInfo.IsPending)
+		assert.Equal(t, time.Hour, runInfo.PendingTimeout)
+		assert.Equal(t, bridge.Name.String(), runInfo.ResumeBridgeName)
+	})
 }
 
 func TestBridgeTask_Variables(t *testing.T) {
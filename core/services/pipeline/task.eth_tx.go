@@ -8,6 +8,7 @@ import (
 	"strconv"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/mitchellh/mapstructure"
 	"github.com/pkg/errors"
 	"go.uber.org/multierr"
@@ -24,6 +25,7 @@ import (
 // Return types:
 //
 //	nil
+//	map[string]interface{} (the transaction it would have sent, if simulated)
 type ETHTxTask struct {
 	BaseTask         `mapstructure:",squash"`
 	From             string `json:"from"`
@@ -40,14 +42,19 @@ type ETHTxTask struct {
 	TransmitChecker string `json:"transmitChecker"`
 
 	forwardingAllowed bool
-	specGasLimit      *uint32
-	keyStore          ETHKeyStore
-	legacyChains      legacyevm.LegacyChainContainer
-	jobType           string
+	// simulateTransactions, if set, simulates transactions before they are sent, after their transmitChecker passed
+	simulateTransactions bool
+	specGasLimit         *uint32
+	keyStore             ETHKeyStore
+	legacyChains         legacyevm.LegacyChainContainer
+	jobType              string
+	// simulate, if set, returns the transaction instead of sending it
+	simulate bool
 }
 
 type ETHKeyStore interface {
 	GetRoundRobinAddress(ctx context.Context, chainID *big.Int, addrs ...common.Address) (common.Address, error)
+	SignHash(ctx context.Context, address common.Address, hash common.Hash) ([]byte, error)
 }
 
 var _ Task = (*ETHTxTask)(nil)
@@ -126,6 +133,9 @@ func (t *ETHTxTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, inpu
 	if err != nil {
 		return Result{Error: err}, runInfo
 	}
+	if t.simulateTransactions {
+		transmitChecker.Simulate = true
+	}
 
 	fromAddr, err := t.keyStore.GetRoundRobinAddress(ctx, chain.ID(), fromAddrs...)
 	if err != nil {
@@ -158,6 +168,17 @@ func (t *ETHTxTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, inpu
 		SignalCallback:   true,
 	}
 
+	if t.simulate {
+		return Result{Value: map[string]interface{}{
+			"from":             fromAddr.Hex(),
+			"to":               common.Address(toAddr).Hex(),
+			"data":             hexutil.Encode(data),
+			"gasLimit":         uint64(gasLimit),
+			"forwarder":        forwarderAddress.Hex(),
+			"minConfirmations": minOutgoingConfirmations,
+		}}, runInfo
+	}
+
 	if minOutgoingConfirmations > 0 {
 		// Store the task run ID, so we can resume the pipeline when tx is confirmed
 		txRequest.PipelineTaskRunID = &t.uuid
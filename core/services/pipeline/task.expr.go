@@ -0,0 +1,66 @@
+package pipeline
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"go.uber.org/multierr"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+)
+
+// Return types:
+//
+//	decimal.Decimal
+//	bool
+//	string
+//
+// ExprTask evaluates an expression against the pipeline vars, e.g.
+//
+//	deviation [type=expr expression="abs($(ds1) - $(ds2)) / $(ds2) * 100 > 1"]
+//
+// See ParseExpression for the syntax. Divisions are rounded to precision decimal places if it is set.
+type ExprTask struct {
+	BaseTask   `mapstructure:",squash"`
+	Expression string `json:"expression"`
+	Precision  string `json:"precision"`
+}
+
+var _ Task = (*ExprTask)(nil)
+
+func (t *ExprTask) Type() TaskType {
+	return TaskTypeExpr
+}
+
+func (t *ExprTask) Run(_ context.Context, _ logger.Logger, vars Vars, inputs []Result) (result Result, runInfo RunInfo) {
+	_, err := CheckInputs(inputs, -1, -1, 0)
+	if err != nil {
+		return Result{Error: errors.Wrap(err, "task inputs")}, runInfo
+	}
+
+	var (
+		expression     StringParam
+		maybePrecision MaybeInt32Param
+	)
+	err = multierr.Combine(
+		errors.Wrap(ResolveParam(&expression, From(NonemptyString(t.Expression))), "expression"),
+		errors.Wrap(ResolveParam(&maybePrecision, From(VarExpr(t.Precision, vars), t.Precision)), "precision"),
+	)
+	if err != nil {
+		return Result{Error: err}, runInfo
+	}
+
+	expr, err := ParseExpression(string(expression))
+	if err != nil {
+		return Result{Error: errors.Wrap(err, "expression")}, runInfo
+	}
+	var precision *int32
+	if p, isSet := maybePrecision.Int32(); isSet {
+		precision = &p
+	}
+	value, err := expr.Evaluate(vars, precision)
+	if err != nil {
+		return Result{Error: err}, runInfo
+	}
+	return Result{Value: value}, runInfo
+}
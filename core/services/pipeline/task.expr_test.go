@@ -0,0 +1,170 @@
+package pipeline_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
+)
+
+func TestExprTask_Happy(t *testing.T) {
+	t.Parallel()
+
+	vars := map[string]interface{}{
+		"ds1":     "110",
+		"ds2":     mustDecimal(t, "100"),
+		"count":   int64(3),
+		"ratio":   0.25,
+		"enabled": true,
+		"status":  "ok",
+		"result":  map[string]interface{}{"prices": []interface{}{"1.5", 2.5}},
+	}
+
+	tests := []struct {
+		name       string
+		expression string
+		precision  string
+		want       interface{}
+	}{
+		{"literal", "42", "", mustDecimal(t, "42")},
+		{"arithmetic", "1 + 2 * 3 - 4 / 2", "", mustDecimal(t, "5")},
+		{"parentheses", "(1 + 2) * 3", "", mustDecimal(t, "9")},
+		{"left associative", "10 - 4 - 3", "", mustDecimal(t, "3")},
+		{"modulo", "10 % 3", "", mustDecimal(t, "1")},
+		{"unary minus", "-$(count) + -(-1)", "", mustDecimal(t, "-2")},
+		{"exponent literal", "1e18 * 2.5", "", mustDecimal(t, "2500000000000000000")},
+		{"decimal precision", "0.1 + 0.2", "", mustDecimal(t, "0.3")},
+		{"deviation", "($(ds1) - $(ds2)) / $(ds2) * 100", "", mustDecimal(t, "10")},
+		{"division precision", "1 / 3", "4", mustDecimal(t, "0.3333")},
+		{"keypath", "$(result.prices.0) + $(result.prices.1)", "", mustDecimal(t, "4")},
+		{"float var", "$(ratio) * 4", "", mustDecimal(t, "1")},
+		{"comparison", "$(ds1) > $(ds2)", "", true},
+		{"comparison chain", "$(ds1) >= 110 && $(ds2) <= 99", "", false},
+		{"equality of numbers", "$(ds1) == 110.0", "", true},
+		{"equality of strings", "$(status) == 'ok'", "", true},
+		{"inequality of strings", `$(status) != "ok"`, "", false},
+		{"equality of booleans", "$(enabled) == true", "", true},
+		{"not", "!$(enabled) || false", "", false},
+		{"precedence of && over ||", "true || false && false", "", true},
+		{"ternary", "$(ds1) > $(ds2) ? 'up' : 'down'", "", "up"},
+		{"nested ternary", "$(count) < 2 ? 'low' : $(count) < 5 ? 'mid' : 'high'", "", "mid"},
+		{"functions", "abs(-2) + ceil(1.2) + floor(1.8) + min(3, $(count), 5) + max(1, 2)", "", mustDecimal(t, "10")},
+		{"round", "round(2.5) + round(1.2345, 2) + round(1250, -2)", "", mustDecimal(t, "1304.23")},
+		{"short circuit", "false && $(missing) > 0", "", false},
+		{"whitespace", " \n ( 1\t+ 1 ) ", "", mustDecimal(t, "2")},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			task := pipeline.ExprTask{
+				BaseTask:   pipeline.NewBaseTask(0, "task", nil, nil, 0),
+				Expression: test.expression,
+				Precision:  test.precision,
+			}
+			result, runInfo := task.Run(testutils.Context(t), logger.TestLogger(t), pipeline.NewVarsFrom(vars), nil)
+			assert.False(t, runInfo.IsPending)
+			assert.False(t, runInfo.IsRetryable)
+			require.NoError(t, result.Error)
+			if want, ok := test.want.(*decimal.Decimal); ok {
+				require.Equal(t, want.String(), result.Value.(decimal.Decimal).String())
+			} else {
+				require.Equal(t, test.want, result.Value)
+			}
+		})
+	}
+}
+
+func TestExprTask_Unhappy(t *testing.T) {
+	t.Parallel()
+
+	vars := map[string]interface{}{
+		"price":  "100",
+		"status": "ok",
+		"obj":    map[string]interface{}{"a": 1},
+		"huge":   "1e-100000000",
+	}
+
+	tests := []struct {
+		name              string
+		expression        string
+		inputs            []pipeline.Result
+		wantErrorCause    error
+		wantErrorContains string
+	}{
+		{"empty", "", nil, pipeline.ErrParameterEmpty, "expression"},
+		{"syntax error", "1 +", nil, pipeline.ErrExpression, "unexpected end of expression"},
+		{"unbalanced parentheses", "(1 + 2", nil, pipeline.ErrExpression, `expected ")"`},
+		{"trailing tokens", "1 2", nil, pipeline.ErrExpression, `unexpected "2"`},
+		{"unknown character", "1 & 2", nil, pipeline.ErrExpression, "unexpected character"},
+		{"unterminated string", "'abc", nil, pipeline.ErrExpression, "unterminated string"},
+		{"invalid variable", "$(foo bar)", nil, pipeline.ErrExpression, "invalid variable"},
+		{"unknown function", "exec(1)", nil, pipeline.ErrExpression, "unknown function"},
+		{"wrong number of arguments", "abs(1, 2)", nil, pipeline.ErrExpression, "wrong number of arguments"},
+		{"too long", strings.Repeat("1+", 3000) + "1", nil, pipeline.ErrExpression, "longer than"},
+		{"too deep", strings.Repeat("(", 100) + "1" + strings.Repeat(")", 100), nil, pipeline.ErrExpression, "nested deeper"},
+		{"missing var", "$(foo) + 1", nil, pipeline.ErrKeypathNotFound, ""},
+		{"map var", "$(obj) + 1", nil, pipeline.ErrBadInput, "variable obj"},
+		{"string arithmetic", "$(status) + 1", nil, pipeline.ErrBadInput, "expected a number"},
+		{"number as condition", "$(price) ? 1 : 2", nil, pipeline.ErrBadInput, "expected a boolean"},
+		{"comparing boolean and number", "true == 1", nil, pipeline.ErrBadInput, "cannot compare"},
+		{"divide by zero", "$(price) / 0", nil, pipeline.ErrDivideByZero, ""},
+		{"modulo by zero", "$(price) % 0", nil, pipeline.ErrDivideByZero, ""},
+		{"huge exponent", "1e100000000 + 1", nil, pipeline.ErrBadInput, "out of range"},
+		{"huge exponent from var", "$(huge) + 1", nil, pipeline.ErrBadInput, "out of range"},
+		{"huge result", "1e999 * 1e999", nil, pipeline.ErrBadInput, "out of range"},
+		{"too many digits", strings.Repeat("9", 600) + " * " + strings.Repeat("9", 600), nil, pipeline.ErrBadInput, "more than 1000 digits"},
+		{"too many digits from exponents", "1e999 + 1e-999", nil, pipeline.ErrBadInput, "more than 1000 digits"},
+		{"errored input", "1", []pipeline.Result{{Error: errors.New("uh oh")}}, pipeline.ErrTooManyErrors, "task inputs"},
+	}
+
+	t.Run("huge precision", func(t *testing.T) {
+		task := pipeline.ExprTask{
+			BaseTask:   pipeline.NewBaseTask(0, "task", nil, nil, 0),
+			Expression: "1 / 3",
+			Precision:  "100000000",
+		}
+		result, _ := task.Run(testutils.Context(t), logger.TestLogger(t), pipeline.NewVarsFrom(nil), nil)
+		require.ErrorIs(t, result.Error, pipeline.ErrBadInput)
+	})
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			task := pipeline.ExprTask{
+				BaseTask:   pipeline.NewBaseTask(0, "task", nil, nil, 0),
+				Expression: test.expression,
+			}
+			result, runInfo := task.Run(testutils.Context(t), logger.TestLogger(t), pipeline.NewVarsFrom(vars), test.inputs)
+			assert.False(t, runInfo.IsPending)
+			assert.False(t, runInfo.IsRetryable)
+			require.Error(t, result.Error)
+			require.Equal(t, test.wantErrorCause, errors.Cause(result.Error))
+			if test.wantErrorContains != "" {
+				require.Contains(t, result.Error.Error(), test.wantErrorContains)
+			}
+		})
+	}
+}
+
+func TestExprTask_Pipeline(t *testing.T) {
+	t.Parallel()
+
+	p, err := pipeline.Parse(`
+		a   [type=memo value="110"];
+		b   [type=memo value="100"];
+		dev [type=expr expression="abs($(a) - $(b)) / $(b) * 100 > 5"];
+		a -> b -> dev;
+	`)
+	require.NoError(t, err)
+	task := p.ByDotID("dev")
+	require.IsType(t, &pipeline.ExprTask{}, task)
+	assert.Equal(t, "abs($(a) - $(b)) / $(b) * 100 > 5", task.(*pipeline.ExprTask).Expression)
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"net/http"
+	"time"
 
 	"github.com/pkg/errors"
 	"github.com/prometheus/client_golang/prometheus"
@@ -17,6 +18,9 @@ import (
 // Return types:
 //
 //	string
+//
+// If cacheTTL is set, successful responses are cached for that long and shared with any other task on the
+// node making the same request. If a request fails, a cached response up to stalenessCap old is used instead.
 type HTTPTask struct {
 	BaseTask                       `mapstructure:",squash"`
 	Method                         string
@@ -24,10 +28,14 @@ type HTTPTask struct {
 	RequestData                    string `json:"requestData"`
 	AllowUnrestrictedNetworkAccess string
 	Headers                        string
+	CacheTTL                       string `json:"cacheTTL"`
 
 	config                 Config
 	httpClient             *http.Client
 	unrestrictedHTTPClient *http.Client
+	cache                  *HTTPResponseCache
+	// simulate, if set, does not cache the response
+	simulate bool
 }
 
 var _ Task = (*HTTPTask)(nil)
@@ -45,6 +53,24 @@ var (
 	},
 		[]string{"pipeline_task_spec_id"},
 	)
+	promHTTPCacheHits = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "pipeline_task_http_cache_hits_total",
+		Help: "Number of HTTP task runs served from the response cache without making a request",
+	},
+		[]string{"pipeline_task_spec_id"},
+	)
+	promHTTPCacheMisses = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "pipeline_task_http_cache_misses_total",
+		Help: "Number of HTTP task runs with a cacheTTL that found no fresh cached response",
+	},
+		[]string{"pipeline_task_spec_id"},
+	)
+	promHTTPCacheStaleHits = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "pipeline_task_http_cache_stale_hits_total",
+		Help: "Number of failed HTTP requests that fell back to a stale cached response",
+	},
+		[]string{"pipeline_task_spec_id"},
+	)
 )
 
 func (t *HTTPTask) Type() TaskType {
@@ -63,6 +89,7 @@ func (t *HTTPTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, input
 		requestData                    MapParam
 		allowUnrestrictedNetworkAccess BoolParam
 		reqHeaders                     StringSliceParam
+		cacheTTL                       Uint64Param
 	)
 	err = multierr.Combine(
 		errors.Wrap(ResolveParam(&method, From(NonemptyString(t.Method), "GET")), "method"),
@@ -73,6 +100,7 @@ func (t *HTTPTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, input
 		// You must set allowUnrestrictedNetworkAccess=true on the task to enable variable-interpolated URLs to make restricted network requests
 		errors.Wrap(ResolveParam(&allowUnrestrictedNetworkAccess, From(NonemptyString(t.AllowUnrestrictedNetworkAccess), !variableRegexp.MatchString(t.URL))), "allowUnrestrictedNetworkAccess"),
 		errors.Wrap(ResolveParam(&reqHeaders, From(NonemptyString(t.Headers), "[]")), "reqHeaders"),
+		errors.Wrap(ResolveParam(&cacheTTL, From(ValidDurationInSeconds(t.CacheTTL), 0)), "cacheTTL"),
 	)
 	if err != nil {
 		return Result{Error: err}, runInfo
@@ -86,6 +114,30 @@ func (t *HTTPTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, input
 	if err != nil {
 		return Result{Error: err}, runInfo
 	}
+
+	var cacheKey []byte
+	cacheDuration := time.Duration(cacheTTL) * time.Second
+	if cacheDuration > stalenessCap {
+		lggr.Warnf("http task cacheTTL exceeds stalenessCap %s, overriding value to stalenessCap", stalenessCap)
+		cacheDuration = stalenessCap
+	}
+	if cacheDuration > 0 && t.cache != nil {
+		cacheKey, err = httpCacheKey(method, url, requestData, reqHeaders, allowUnrestrictedNetworkAccess)
+		if err != nil {
+			return Result{Error: err}, runInfo
+		}
+		if responseBytes, ok := t.cache.Get(ctx, cacheKey, cacheDuration); ok {
+			promHTTPCacheHits.WithLabelValues(t.DotID()).Inc()
+			lggr.Debugw("HTTP task: using cached response",
+				"response", string(responseBytes),
+				"url", url.String(),
+				"dotID", t.DotID(),
+			)
+			return Result{Value: string(responseBytes)}, runInfo
+		}
+		promHTTPCacheMisses.WithLabelValues(t.DotID()).Inc()
+	}
+
 	lggr.Debugw("HTTP task: sending request",
 		"requestData", string(requestDataJSON),
 		"url", url.String(),
@@ -108,6 +160,17 @@ func (t *HTTPTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, input
 		if errors.Is(errors.Cause(err), clhttp.ErrDisallowedIP) {
 			err = errors.Wrap(err, `connections to local resources are disabled by default, if you are sure this is safe, you can enable on a per-task basis by setting allowUnrestrictedNetworkAccess="true" in the pipeline task spec, e.g. fetch [type="http" method=GET url="$(decode_cbor.url)" allowUnrestrictedNetworkAccess="true"]`)
 		}
+		if cacheKey != nil {
+			if responseBytes, ok := t.cache.Get(ctx, cacheKey, stalenessCap); ok {
+				promHTTPCacheStaleHits.WithLabelValues(t.DotID()).Inc()
+				lggr.Warnw("HTTP task: request failed, falling back to cache",
+					"err", err,
+					"url", url.String(),
+					"dotID", t.DotID(),
+				)
+				return Result{Value: string(responseBytes)}, runInfo
+			}
+		}
 		return Result{Error: err}, RunInfo{IsRetryable: isRetryableHTTPError(statusCode, err)}
 	}
 
@@ -121,6 +184,10 @@ func (t *HTTPTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, input
 	promHTTPFetchTime.WithLabelValues(t.DotID()).Set(float64(elapsed))
 	promHTTPResponseBodySize.WithLabelValues(t.DotID()).Set(float64(len(responseBytes)))
 
+	if cacheKey != nil && !t.simulate {
+		t.cache.Put(ctx, cacheKey, responseBytes)
+	}
+
 	// NOTE: We always stringify the response since this is required for all current jobs.
 	// If a binary response is required we might consider adding an adapter
 	// flag such as  "BinaryMode: true" which passes through raw binary as the
@@ -6,6 +6,7 @@ import (
 	"net/http/httptest"
 	"net/url"
 	"sort"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -413,3 +414,78 @@ func TestHTTPTask_Headers(t *testing.T) {
 		assert.Equal(t, []string{"Content-Length", "38", "Content-Type", "footype", "User-Agent", "Go-http-client/1.1", "X-Header-1", "foo", "X-Header-2", "bar"}, allHeaders(headers))
 	})
 }
+
+func TestHTTPTask_Cache(t *testing.T) {
+	t.Parallel()
+
+	config := configtest.NewTestGeneralConfig(t)
+	var (
+		calls   atomic.Int32
+		failing atomic.Bool
+	)
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		if failing.Load() {
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, err := w.Write([]byte(`{"result": 42}`))
+		require.NoError(t, err)
+	})
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	c := clhttptest.NewTestLocalOnlyHTTPClient()
+	cache := pipeline.NewHTTPResponseCache(nil, logger.TestLogger(t))
+	newTask := func(cacheTTL string, requestData string) *pipeline.HTTPTask {
+		task := &pipeline.HTTPTask{
+			BaseTask:    pipeline.NewBaseTask(0, "http", nil, nil, 0),
+			Method:      "POST",
+			URL:         server.URL,
+			RequestData: requestData,
+			CacheTTL:    cacheTTL,
+		}
+		task.HelperSetDependencies(config.JobPipeline(), c, c)
+		task.HelperSetCache(cache)
+		return task
+	}
+	run := func(task *pipeline.HTTPTask) pipeline.Result {
+		result, _ := task.Run(testutils.Context(t), logger.TestLogger(t), pipeline.NewVarsFrom(nil), nil)
+		return result
+	}
+
+	result := run(newTask("1m", ethUSDPairing))
+	require.NoError(t, result.Error)
+	assert.Equal(t, `{"result": 42}`, result.Value)
+	assert.Equal(t, int32(1), calls.Load())
+
+	// a fresh response is shared by any task making the same request
+	result = run(newTask("1m", ethUSDPairing))
+	require.NoError(t, result.Error)
+	assert.Equal(t, `{"result": 42}`, result.Value)
+	assert.Equal(t, int32(1), calls.Load())
+
+	// a different request body is a different cache entry
+	result = run(newTask("1m", btcUSDPairing))
+	require.NoError(t, result.Error)
+	assert.Equal(t, int32(2), calls.Load())
+
+	// without a cacheTTL, the cache is not used
+	result = run(newTask("", ethUSDPairing))
+	require.NoError(t, result.Error)
+	assert.Equal(t, int32(3), calls.Load())
+
+	// the response is not fresh for a task with a shorter TTL, but is used when the request fails
+	failing.Store(true)
+	time.Sleep(time.Second)
+	result = run(newTask("1s", ethUSDPairing))
+	require.NoError(t, result.Error)
+	assert.Equal(t, `{"result": 42}`, result.Value)
+	assert.Equal(t, int32(4), calls.Load())
+
+	// no stale fallback without a cached response
+	result = run(newTask("1s", `{"other": "request"}`))
+	require.Error(t, result.Error)
+	assert.Equal(t, int32(5), calls.Load())
+}
@@ -0,0 +1,43 @@
+package pipeline
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+)
+
+// Return types:
+//
+//	string
+type JSONEncodeTask struct {
+	BaseTask `mapstructure:",squash"`
+	Input    string `json:"input"`
+}
+
+var _ Task = (*JSONEncodeTask)(nil)
+
+func (t *JSONEncodeTask) Type() TaskType {
+	return TaskTypeJSONEncode
+}
+
+func (t *JSONEncodeTask) Run(_ context.Context, _ logger.Logger, vars Vars, inputs []Result) (result Result, runInfo RunInfo) {
+	_, err := CheckInputs(inputs, 0, 1, 0)
+	if err != nil {
+		return Result{Error: errors.Wrap(err, "task inputs")}, runInfo
+	}
+
+	var input AnyParam
+	err = errors.Wrap(ResolveParam(&input, From(VarExpr(t.Input, vars), JSONWithVarExprs(t.Input, vars, false), Input(inputs, 0))), "input")
+	if err != nil {
+		return Result{Error: err}, runInfo
+	}
+
+	encoded, err := json.Marshal(input.Value())
+	if err != nil {
+		return Result{Error: errors.Wrapf(ErrBadInput, "input: %v", err)}, runInfo
+	}
+	return Result{Value: string(encoded)}, runInfo
+}
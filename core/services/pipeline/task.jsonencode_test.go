@@ -0,0 +1,57 @@
+package pipeline_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
+)
+
+func TestJSONEncodeTask(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name           string
+		input          string
+		vars           pipeline.Vars
+		inputs         []pipeline.Result
+		want           string
+		wantErrorCause error
+	}{
+		{"map from inputs", "", pipeline.NewVarsFrom(nil), []pipeline.Result{{Value: map[string]interface{}{"b": 1, "a": []interface{}{"x", true}}}}, `{"a":["x",true],"b":1}`, nil},
+		{"slice from inputs", "", pipeline.NewVarsFrom(nil), []pipeline.Result{{Value: []interface{}{1.5, nil}}}, `[1.5,null]`, nil},
+		{"string from inputs", "", pipeline.NewVarsFrom(nil), []pipeline.Result{{Value: `say "hi"`}}, `"say \"hi\""`, nil},
+		{"big int from inputs", "", pipeline.NewVarsFrom(nil), []pipeline.Result{{Value: big.NewInt(42)}}, `42`, nil},
+		{"var", "$(foo.bar)", pipeline.NewVarsFrom(map[string]interface{}{"foo": map[string]interface{}{"bar": map[string]interface{}{"x": "y"}}}), nil, `{"x":"y"}`, nil},
+		{"json with vars", `{"id": $(foo), "static": [1, 2]}`, pipeline.NewVarsFrom(map[string]interface{}{"foo": "abc"}), nil, `{"id":"abc","static":[1,2]}`, nil},
+		{"missing var", "$(foo)", pipeline.NewVarsFrom(nil), nil, "", pipeline.ErrKeypathNotFound},
+		{"no input", "", pipeline.NewVarsFrom(nil), nil, "", pipeline.ErrIndexOutOfRange},
+		{"errored input", "", pipeline.NewVarsFrom(nil), []pipeline.Result{{Error: errors.New("uh oh")}}, "", pipeline.ErrTooManyErrors},
+		{"unencodable input", "", pipeline.NewVarsFrom(nil), []pipeline.Result{{Value: make(chan int)}}, "", pipeline.ErrBadInput},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			task := pipeline.JSONEncodeTask{
+				BaseTask: pipeline.NewBaseTask(0, "task", nil, nil, 0),
+				Input:    test.input,
+			}
+			result, runInfo := task.Run(testutils.Context(t), logger.TestLogger(t), test.vars, test.inputs)
+			assert.False(t, runInfo.IsPending)
+			assert.False(t, runInfo.IsRetryable)
+			if test.wantErrorCause != nil {
+				require.Equal(t, test.wantErrorCause, errors.Cause(result.Error))
+				return
+			}
+			require.NoError(t, result.Error)
+			require.Equal(t, test.want, result.Value)
+		})
+	}
+}
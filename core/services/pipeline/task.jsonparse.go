@@ -26,6 +26,8 @@ type JSONParseTask struct {
 	Path      string `json:"path"`
 	Separator string `json:"separator"`
 	Data      string `json:"data"`
+	// Selector is an alternative to Path supporting wildcards, slices and filters, see Selector
+	Selector string `json:"selector"`
 	// Lax when disabled will return an error if the path does not exist
 	// Lax when enabled will return nil with no error if the path does not exist
 	Lax string
@@ -46,18 +48,23 @@ func (t *JSONParseTask) Run(_ context.Context, l logger.Logger, vars Vars, input
 	var sep StringParam
 	err = errors.Wrap(ResolveParam(&sep, From(t.Separator)), "separator")
 	var (
-		path = NewJSONPathParam(string(sep))
-		data BytesParam
-		lax  BoolParam
+		path     = NewJSONPathParam(string(sep))
+		selector StringParam
+		data     BytesParam
+		lax      BoolParam
 	)
 	err = multierr.Combine(err,
 		errors.Wrap(ResolveParam(&path, From(VarExpr(t.Path, vars), t.Path)), "path"),
+		errors.Wrap(ResolveParam(&selector, From(VarExpr(t.Selector, vars), t.Selector)), "selector"),
 		errors.Wrap(ResolveParam(&data, From(VarExpr(t.Data, vars), Input(inputs, 0))), "data"),
 		errors.Wrap(ResolveParam(&lax, From(NonemptyString(t.Lax), false)), "lax"),
 	)
 	if err != nil {
 		return Result{Error: err}, runInfo
 	}
+	if len(path) > 0 && selector != "" {
+		return Result{Error: errors.Wrap(ErrBadInput, "path and selector cannot both be set")}, runInfo
+	}
 
 	var decoded interface{}
 	d := json.NewDecoder(bytes.NewReader(data))
@@ -67,6 +74,10 @@ func (t *JSONParseTask) Run(_ context.Context, l logger.Logger, vars Vars, input
 		return Result{Error: err}, runInfo
 	}
 
+	if selector != "" {
+		return t.runSelector(string(selector), decoded, bool(lax), data), runInfo
+	}
+
 	for _, part := range path {
 		switch d := decoded.(type) {
 		case map[string]interface{}:
@@ -116,3 +127,25 @@ func (t *JSONParseTask) Run(_ context.Context, l logger.Logger, vars Vars, input
 
 	return Result{Value: decoded}, runInfo
 }
+
+func (t *JSONParseTask) runSelector(selectorStr string, decoded interface{}, lax bool, data []byte) Result {
+	selector, err := NewSelectorFromString(selectorStr)
+	if err != nil {
+		return Result{Error: errors.Wrapf(ErrBadInput, "selector: %v", err)}
+	}
+	selected, err := selector.Select(decoded)
+	if errors.Is(err, ErrKeypathNotFound) || errors.Is(err, ErrIndexOutOfRange) {
+		if lax {
+			return Result{}
+		}
+		return Result{Error: errors.Wrapf(err, "could not resolve selector %q in %s", selectorStr, data)}
+	} else if err != nil {
+		return Result{Error: err}
+	}
+
+	selected, err = reinterpetJsonNumbers(selected)
+	if err != nil {
+		return Result{Error: multierr.Combine(ErrBadInput, err)}
+	}
+	return Result{Value: selected}
+}
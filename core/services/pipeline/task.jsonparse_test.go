@@ -420,3 +420,78 @@ func TestJSONParseTask(t *testing.T) {
 		})
 	}
 }
+
+func TestJSONParseTask_Selector(t *testing.T) {
+	t.Parallel()
+
+	data := `{"data":{"items":[
+		{"name":"a","price":5,"tags":["x"]},
+		{"name":"b","price":15,"tags":["y","z"]},
+		{"name":"c","price":25.5}
+	],"meta":{"count":3}}}`
+
+	tests := []struct {
+		name              string
+		selector          string
+		lax               string
+		wantData          interface{}
+		wantErrorCause    error
+		wantErrorContains string
+	}{
+		{"keypath", "data.meta.count", "", int64(3), nil, ""},
+		{"array index", "data.items[1].name", "", "b", nil, ""},
+		{"dotted array index", "data.items.1.name", "", "b", nil, ""},
+		{"negative index", "data.items[-1].price", "", 25.5, nil, ""},
+		{"wildcard", "data.items[*].name", "", []interface{}{"a", "b", "c"}, nil, ""},
+		{"dotted wildcard", "data.items.*.name", "", []interface{}{"a", "b", "c"}, nil, ""},
+		{"wildcard skips missing keys", "data.items[*].tags[0]", "", []interface{}{"x", "y"}, nil, ""},
+		{"map wildcard", "data.meta.*", "", []interface{}{int64(3)}, nil, ""},
+		{"slice", "data.items[1:].name", "", []interface{}{"b", "c"}, nil, ""},
+		{"negative slice", "data.items[:-1].price", "", []interface{}{int64(5), int64(15)}, nil, ""},
+		{"filter", "data.items[?(@.price > 10)].name", "", []interface{}{"b", "c"}, nil, ""},
+		{"filter on string", `data.items[?(@.name == 'a' || @.name == "c")].price`, "", []interface{}{int64(5), 25.5}, nil, ""},
+		{"filter without matches", "data.items[?(@.price > 100)]", "", []interface{}{}, nil, ""},
+		{"filter skipping missing keys", "data.items[?(@.tags.1 == 'z')].name", "", []interface{}{"b"}, nil, ""},
+		{"missing key", "data.nope", "", nil, pipeline.ErrKeypathNotFound, "could not resolve selector"},
+		{"missing key lax", "data.nope", "true", nil, nil, ""},
+		{"index out of range", "data.items[5]", "", nil, pipeline.ErrIndexOutOfRange, ""},
+		{"index out of range lax", "data.items[5]", "true", nil, nil, ""},
+		{"invalid selector", "data..items", "", nil, pipeline.ErrBadInput, "selector"},
+		{"invalid filter", "data.items[?(@.price >)]", "", nil, pipeline.ErrBadInput, "selector"},
+	}
+
+	for _, tt := range tests {
+		test := tt
+		t.Run(test.name, func(t *testing.T) {
+			task := pipeline.JSONParseTask{
+				BaseTask: pipeline.NewBaseTask(0, "json", nil, nil, 0),
+				Selector: test.selector,
+				Lax:      test.lax,
+			}
+			result, runInfo := task.Run(testutils.Context(t), logger.TestLogger(t), pipeline.NewVarsFrom(nil), []pipeline.Result{{Value: data}})
+			assert.False(t, runInfo.IsPending)
+			assert.False(t, runInfo.IsRetryable)
+
+			if test.wantErrorCause != nil {
+				require.Equal(t, test.wantErrorCause, errors.Cause(result.Error))
+				if test.wantErrorContains != "" {
+					require.Contains(t, result.Error.Error(), test.wantErrorContains)
+				}
+				require.Nil(t, result.Value)
+			} else {
+				require.NoError(t, result.Error)
+				require.Equal(t, test.wantData, result.Value)
+			}
+		})
+	}
+
+	t.Run("path and selector", func(t *testing.T) {
+		task := pipeline.JSONParseTask{
+			BaseTask: pipeline.NewBaseTask(0, "json", nil, nil, 0),
+			Path:     "data",
+			Selector: "data",
+		}
+		result, _ := task.Run(testutils.Context(t), logger.TestLogger(t), pipeline.NewVarsFrom(nil), []pipeline.Result{{Value: data}})
+		require.Equal(t, pipeline.ErrBadInput, errors.Cause(result.Error))
+	})
+}
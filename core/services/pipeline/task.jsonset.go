@@ -0,0 +1,111 @@
+package pipeline
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/pkg/errors"
+	"go.uber.org/multierr"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+)
+
+// Return types:
+//
+//	map[string]interface{}
+//
+// JSONSetTask sets the value at a keypath of a map, e.g. path="data.items.0.price", creating the
+// maps along the path that do not exist. The input map is not modified.
+type JSONSetTask struct {
+	BaseTask `mapstructure:",squash"`
+	Data     string `json:"data"`
+	Path     string `json:"path"`
+	Value    string `json:"value"`
+}
+
+var _ Task = (*JSONSetTask)(nil)
+
+func (t *JSONSetTask) Type() TaskType {
+	return TaskTypeJSONSet
+}
+
+func (t *JSONSetTask) Run(_ context.Context, _ logger.Logger, vars Vars, inputs []Result) (result Result, runInfo RunInfo) {
+	_, err := CheckInputs(inputs, 0, 1, 0)
+	if err != nil {
+		return Result{Error: errors.Wrap(err, "task inputs")}, runInfo
+	}
+
+	var (
+		data  MapParam
+		path  StringParam
+		value AnyParam
+	)
+	err = multierr.Combine(
+		errors.Wrap(ResolveParam(&data, From(VarExpr(t.Data, vars), JSONWithVarExprs(t.Data, vars, false), Input(inputs, 0))), "data"),
+		errors.Wrap(ResolveParam(&path, From(NonemptyString(t.Path))), "path"),
+		errors.Wrap(ResolveParam(&value, From(VarExpr(t.Value, vars), JSONWithVarExprs(t.Value, vars, false))), "value"),
+	)
+	if err != nil {
+		return Result{Error: err}, runInfo
+	}
+
+	keypath, err := NewKeypathFromString(string(path))
+	if err != nil {
+		return Result{Error: errors.Wrap(err, "path")}, runInfo
+	}
+
+	set, err := jsonSet(data.Map(), keypath.Parts, value.Value())
+	if err != nil {
+		return Result{Error: errors.Wrapf(err, "path %s", path)}, runInfo
+	}
+	return Result{Value: set}, runInfo
+}
+
+// jsonSet returns a copy of v with value set at the keypath parts. Only the maps and slices along
+// the path are copied.
+func jsonSet(v interface{}, parts []string, value interface{}) (interface{}, error) {
+	if len(parts) == 0 {
+		return value, nil
+	}
+	switch v := v.(type) {
+	case nil:
+		child, err := jsonSet(nil, parts[1:], value)
+		if err != nil {
+			return nil, err
+		}
+		return map[string]interface{}{parts[0]: child}, nil
+
+	case map[string]interface{}:
+		child, err := jsonSet(v[parts[0]], parts[1:], value)
+		if err != nil {
+			return nil, err
+		}
+		m := make(map[string]interface{}, len(v)+1)
+		for key, val := range v {
+			m[key] = val
+		}
+		m[parts[0]] = child
+		return m, nil
+
+	case []interface{}:
+		idx, err := strconv.Atoi(parts[0])
+		if err != nil {
+			return nil, errors.Wrapf(ErrBadInput, "%v is not a valid array index", parts[0])
+		}
+		if idx < 0 {
+			idx += len(v)
+		}
+		if idx < 0 || idx >= len(v) {
+			return nil, errors.Wrapf(ErrIndexOutOfRange, "index %v out of range (length %v)", parts[0], len(v))
+		}
+		child, err := jsonSet(v[idx], parts[1:], value)
+		if err != nil {
+			return nil, err
+		}
+		s := make([]interface{}, len(v))
+		copy(s, v)
+		s[idx] = child
+		return s, nil
+	}
+	return nil, errors.Wrapf(ErrBadInput, "cannot set %v of a %T", parts[0], v)
+}
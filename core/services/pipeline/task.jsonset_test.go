@@ -0,0 +1,81 @@
+package pipeline_test
+
+import (
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
+)
+
+func TestJSONSetTask(t *testing.T) {
+	t.Parallel()
+
+	newData := func() map[string]interface{} {
+		return map[string]interface{}{
+			"a": "b",
+			"items": []interface{}{
+				map[string]interface{}{"price": 1},
+				map[string]interface{}{"price": 2},
+			},
+		}
+	}
+
+	tests := []struct {
+		name           string
+		data           string
+		path           string
+		value          string
+		vars           map[string]interface{}
+		want           map[string]interface{}
+		wantErrorCause error
+	}{
+		{"top level key", "", "a", `"c"`, nil, map[string]interface{}{"a": "c", "items": newData()["items"]}, nil},
+		{"new key", "", "x", `true`, nil, map[string]interface{}{"a": "b", "x": true, "items": newData()["items"]}, nil},
+		{"new nested keys", "", "x.y.z", `[1]`, nil, map[string]interface{}{"a": "b", "x": map[string]interface{}{"y": map[string]interface{}{"z": []interface{}{int64(1)}}}, "items": newData()["items"]}, nil},
+		{"array element", "", "items.1.price", "$(price)", map[string]interface{}{"price": "3.5"}, map[string]interface{}{"a": "b", "items": []interface{}{
+			map[string]interface{}{"price": 1},
+			map[string]interface{}{"price": "3.5"},
+		}}, nil},
+		{"negative array index", "", "items.-1", `{"price": $(price)}`, map[string]interface{}{"price": 9}, map[string]interface{}{"a": "b", "items": []interface{}{
+			map[string]interface{}{"price": 1},
+			map[string]interface{}{"price": 9},
+		}}, nil},
+		{"data from JSON", `{"x": {"y": 1}}`, "x.z", `null`, nil, map[string]interface{}{"x": map[string]interface{}{"y": int64(1), "z": nil}}, nil},
+		{"data from var", "$(obj)", "k", `"v"`, map[string]interface{}{"obj": map[string]interface{}{}}, map[string]interface{}{"k": "v"}, nil},
+		{"array index out of range", "", "items.2.price", `1`, nil, nil, pipeline.ErrIndexOutOfRange},
+		{"invalid array index", "", "items.x", `1`, nil, nil, pipeline.ErrBadInput},
+		{"through a scalar", "", "a.b", `1`, nil, nil, pipeline.ErrBadInput},
+		{"missing path", "", "", `1`, nil, nil, pipeline.ErrParameterEmpty},
+		{"wrong path", "", "a..b", `1`, nil, nil, pipeline.ErrWrongKeypath},
+		{"missing value", "", "a", "", nil, nil, pipeline.ErrParameterEmpty},
+		{"missing var", "", "a", "$(nope)", nil, nil, pipeline.ErrKeypathNotFound},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			data := newData()
+			task := pipeline.JSONSetTask{
+				BaseTask: pipeline.NewBaseTask(0, "task", nil, nil, 0),
+				Data:     test.data,
+				Path:     test.path,
+				Value:    test.value,
+			}
+			result, runInfo := task.Run(testutils.Context(t), logger.TestLogger(t), pipeline.NewVarsFrom(test.vars), []pipeline.Result{{Value: data}})
+			assert.False(t, runInfo.IsPending)
+			assert.False(t, runInfo.IsRetryable)
+			require.Equal(t, newData(), data, "input must not be modified")
+			if test.wantErrorCause != nil {
+				require.Equal(t, test.wantErrorCause, errors.Cause(result.Error))
+				return
+			}
+			require.NoError(t, result.Error)
+			require.Equal(t, test.want, result.Value)
+		})
+	}
+}
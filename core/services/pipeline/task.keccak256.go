@@ -0,0 +1,39 @@
+package pipeline
+
+import (
+	"context"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+)
+
+// Return types:
+//
+//	[]byte
+type Keccak256Task struct {
+	BaseTask `mapstructure:",squash"`
+	Input    string `json:"input"`
+}
+
+var _ Task = (*Keccak256Task)(nil)
+
+func (t *Keccak256Task) Type() TaskType {
+	return TaskTypeKeccak256
+}
+
+func (t *Keccak256Task) Run(_ context.Context, _ logger.Logger, vars Vars, inputs []Result) (result Result, runInfo RunInfo) {
+	_, err := CheckInputs(inputs, 0, 1, 0)
+	if err != nil {
+		return Result{Error: errors.Wrap(err, "task inputs")}, runInfo
+	}
+
+	var input BytesParam
+	err = errors.Wrap(ResolveParam(&input, From(VarExpr(t.Input, vars), NonemptyString(t.Input), Input(inputs, 0))), "input")
+	if err != nil {
+		return Result{Error: err}, runInfo
+	}
+
+	return Result{Value: crypto.Keccak256(input)}, runInfo
+}
@@ -0,0 +1,110 @@
+package pipeline_test
+
+import (
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
+)
+
+func TestMaxTask(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		inputs        []pipeline.Result
+		allowedFaults string
+		want          pipeline.Result
+	}{
+		{
+			"happy",
+			[]pipeline.Result{{Value: mustDecimal(t, "2")}, {Value: mustDecimal(t, "-1.5")}, {Value: mustDecimal(t, "3")}},
+			"1",
+			pipeline.Result{Value: mustDecimal(t, "3")},
+		},
+		{
+			"happy (one input)",
+			[]pipeline.Result{{Value: mustDecimal(t, "1")}},
+			"0",
+			pipeline.Result{Value: mustDecimal(t, "1")},
+		},
+		{
+			"mixed types",
+			[]pipeline.Result{{Value: "10"}, {Value: int64(4)}, {Value: 4.5}},
+			"0",
+			pipeline.Result{Value: mustDecimal(t, "10")},
+		},
+		{
+			"zero inputs",
+			[]pipeline.Result{},
+			"0",
+			pipeline.Result{Error: pipeline.ErrWrongInputCardinality},
+		},
+		{
+			"fewer errors than threshold",
+			[]pipeline.Result{{Error: errors.New("")}, {Value: mustDecimal(t, "2")}, {Value: mustDecimal(t, "3")}},
+			"1",
+			pipeline.Result{Value: mustDecimal(t, "3")},
+		},
+		{
+			"more errors than threshold",
+			[]pipeline.Result{{Error: errors.New("")}, {Error: errors.New("")}, {Value: mustDecimal(t, "3")}},
+			"1",
+			pipeline.Result{Error: pipeline.ErrTooManyErrors},
+		},
+		{
+			"bad input",
+			[]pipeline.Result{{Value: "foo"}, {Value: mustDecimal(t, "3")}},
+			"0",
+			pipeline.Result{Error: pipeline.ErrBadInput},
+		},
+	}
+
+	for _, test := range tests {
+		assertOK := func(output pipeline.Result, runInfo pipeline.RunInfo) {
+			assert.False(t, runInfo.IsPending)
+			assert.False(t, runInfo.IsRetryable)
+			if test.want.Error != nil {
+				require.Equal(t, test.want.Error, errors.Cause(output.Error))
+				require.Nil(t, output.Value)
+			} else {
+				require.NoError(t, output.Error)
+				require.Equal(t, test.want.Value.(*decimal.Decimal).String(), output.Value.(decimal.Decimal).String())
+			}
+		}
+		t.Run(test.name, func(t *testing.T) {
+			t.Run("without vars", func(t *testing.T) {
+				task := pipeline.MaxTask{
+					BaseTask:      pipeline.NewBaseTask(0, "task", nil, nil, 0),
+					AllowedFaults: test.allowedFaults,
+				}
+				assertOK(task.Run(testutils.Context(t), logger.TestLogger(t), pipeline.NewVarsFrom(nil), test.inputs))
+			})
+			t.Run("with vars", func(t *testing.T) {
+				var inputs []interface{}
+				for _, input := range test.inputs {
+					if input.Error != nil {
+						inputs = append(inputs, input.Error)
+					} else {
+						inputs = append(inputs, input.Value)
+					}
+				}
+				vars := pipeline.NewVarsFrom(map[string]interface{}{
+					"foo": map[string]interface{}{"bar": inputs},
+				})
+				task := pipeline.MaxTask{
+					BaseTask:      pipeline.NewBaseTask(0, "task", nil, nil, 0),
+					Values:        "$(foo.bar)",
+					AllowedFaults: test.allowedFaults,
+				}
+				assertOK(task.Run(testutils.Context(t), logger.TestLogger(t), vars, nil))
+			})
+		})
+	}
+}
@@ -0,0 +1,50 @@
+package pipeline
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"go.uber.org/multierr"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+)
+
+// Return types:
+//
+//	*decimal.Decimal
+//
+// RoundTask rounds half away from zero to the given number of decimal places, which may be
+// negative to round to tens, hundreds etc. The precision defaults to 0.
+type RoundTask struct {
+	BaseTask  `mapstructure:",squash"`
+	Input     string `json:"input"`
+	Precision string `json:"precision"`
+}
+
+var _ Task = (*RoundTask)(nil)
+
+func (t *RoundTask) Type() TaskType {
+	return TaskTypeRound
+}
+
+func (t *RoundTask) Run(_ context.Context, _ logger.Logger, vars Vars, inputs []Result) (result Result, runInfo RunInfo) {
+	_, err := CheckInputs(inputs, 0, 1, 0)
+	if err != nil {
+		return Result{Error: errors.Wrap(err, "task inputs")}, runInfo
+	}
+
+	var (
+		a              DecimalParam
+		maybePrecision MaybeInt32Param
+	)
+	err = multierr.Combine(
+		errors.Wrap(ResolveParam(&a, From(VarExpr(t.Input, vars), NonemptyString(t.Input), Input(inputs, 0))), "input"),
+		errors.Wrap(ResolveParam(&maybePrecision, From(VarExpr(t.Precision, vars), t.Precision)), "precision"),
+	)
+	if err != nil {
+		return Result{Error: err}, runInfo
+	}
+
+	precision, _ := maybePrecision.Int32()
+	return Result{Value: a.Decimal().Round(precision)}, runInfo
+}
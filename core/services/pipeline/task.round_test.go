@@ -0,0 +1,68 @@
+package pipeline_test
+
+import (
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
+)
+
+func TestRoundTask(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		input     interface{}
+		precision string
+		want      *decimal.Decimal
+		error     error
+	}{
+		{"default precision", "1.5", "", mustDecimal(t, "2"), nil},
+		{"default precision, negative", "-1.5", "", mustDecimal(t, "-2"), nil},
+		{"zero precision", "1.49", "0", mustDecimal(t, "1"), nil},
+		{"positive precision", "1.2345", "2", mustDecimal(t, "1.23"), nil},
+		{"positive precision, half", "1.235", "2", mustDecimal(t, "1.24"), nil},
+		{"negative precision", "1250", "-2", mustDecimal(t, "1300"), nil},
+		{"int", int(7), "-1", mustDecimal(t, "10"), nil},
+		{"float64", float64(2.675), "1", mustDecimal(t, "2.7"), nil},
+		{"bad input", "foo", "", nil, pipeline.ErrBadInput},
+		{"bad precision", "1.5", "foo", nil, pipeline.ErrBadInput},
+	}
+
+	for _, test := range tests {
+		assertOK := func(result pipeline.Result, runInfo pipeline.RunInfo) {
+			assert.False(t, runInfo.IsPending)
+			assert.False(t, runInfo.IsRetryable)
+			if test.error != nil {
+				require.Equal(t, test.error, errors.Cause(result.Error))
+				return
+			}
+			require.NoError(t, result.Error)
+			require.Equal(t, test.want.String(), result.Value.(decimal.Decimal).String())
+		}
+		t.Run(test.name, func(t *testing.T) {
+			t.Run("without vars through job DAG", func(t *testing.T) {
+				task := pipeline.RoundTask{BaseTask: pipeline.NewBaseTask(0, "task", nil, nil, 0), Precision: test.precision}
+				assertOK(task.Run(testutils.Context(t), logger.TestLogger(t), pipeline.NewVarsFrom(nil), []pipeline.Result{{Value: test.input}}))
+			})
+			t.Run("with vars", func(t *testing.T) {
+				vars := pipeline.NewVarsFrom(map[string]interface{}{
+					"foo":   map[string]interface{}{"bar": test.input},
+					"chain": map[string]interface{}{"link": test.precision},
+				})
+				task := pipeline.RoundTask{
+					BaseTask:  pipeline.NewBaseTask(0, "task", nil, nil, 0),
+					Input:     "$(foo.bar)",
+					Precision: "$(chain.link)",
+				}
+				assertOK(task.Run(testutils.Context(t), logger.TestLogger(t), vars, []pipeline.Result{}))
+			})
+		})
+	}
+}
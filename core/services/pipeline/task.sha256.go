@@ -0,0 +1,40 @@
+package pipeline
+
+import (
+	"context"
+	"crypto/sha256"
+
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+)
+
+// Return types:
+//
+//	[]byte
+type SHA256Task struct {
+	BaseTask `mapstructure:",squash"`
+	Input    string `json:"input"`
+}
+
+var _ Task = (*SHA256Task)(nil)
+
+func (t *SHA256Task) Type() TaskType {
+	return TaskTypeSHA256
+}
+
+func (t *SHA256Task) Run(_ context.Context, _ logger.Logger, vars Vars, inputs []Result) (result Result, runInfo RunInfo) {
+	_, err := CheckInputs(inputs, 0, 1, 0)
+	if err != nil {
+		return Result{Error: errors.Wrap(err, "task inputs")}, runInfo
+	}
+
+	var input BytesParam
+	err = errors.Wrap(ResolveParam(&input, From(VarExpr(t.Input, vars), NonemptyString(t.Input), Input(inputs, 0))), "input")
+	if err != nil {
+		return Result{Error: err}, runInfo
+	}
+
+	sum := sha256.Sum256(input)
+	return Result{Value: sum[:]}, runInfo
+}
@@ -0,0 +1,83 @@
+package pipeline
+
+import (
+	"context"
+	"slices"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+	"go.uber.org/multierr"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+)
+
+// Return types:
+//
+//	[]byte
+//
+// SignTask signs a 32 byte digest with an eth key of the node, returning a 65 byte [R || S || V]
+// signature with V being 27 or 28, as expected by ecrecover. If eip191 is set, the digest is
+// prefixed with "\x19Ethereum Signed Message:\n32" and hashed again before signing.
+//
+// Only the keys listed in the signingKeys of the job can be used.
+type SignTask struct {
+	BaseTask `mapstructure:",squash"`
+	Address  string `json:"address"`
+	Data     string `json:"data"`
+	EIP191   string `json:"eip191"`
+
+	keyStore    ETHKeyStore
+	signingKeys []common.Address
+	// simulate, if set, fails the task instead of signing
+	simulate bool
+}
+
+var _ Task = (*SignTask)(nil)
+
+func (t *SignTask) Type() TaskType {
+	return TaskTypeSign
+}
+
+func (t *SignTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, inputs []Result) (result Result, runInfo RunInfo) {
+	_, err := CheckInputs(inputs, 0, 1, 0)
+	if err != nil {
+		return Result{Error: errors.Wrap(err, "task inputs")}, runInfo
+	}
+
+	var (
+		address AddressParam
+		data    BytesParam
+		eip191  BoolParam
+	)
+	err = multierr.Combine(
+		errors.Wrap(ResolveParam(&address, From(VarExpr(t.Address, vars), NonemptyString(t.Address))), "address"),
+		errors.Wrap(ResolveParam(&data, From(VarExpr(t.Data, vars), NonemptyString(t.Data), Input(inputs, 0))), "data"),
+		errors.Wrap(ResolveParam(&eip191, From(NonemptyString(t.EIP191), false)), "eip191"),
+	)
+	if err != nil {
+		return Result{Error: err}, runInfo
+	}
+
+	if len(data) != common.HashLength {
+		return Result{Error: errors.Wrapf(ErrBadInput, "data must be a %d byte digest, got %d bytes", common.HashLength, len(data))}, runInfo
+	}
+	if t.simulate {
+		return Result{Error: errors.New("sign tasks cannot be simulated")}, runInfo
+	}
+	if !slices.Contains(t.signingKeys, common.Address(address)) {
+		return Result{Error: errors.Errorf("key %s is not in the signingKeys of the job", common.Address(address))}, runInfo
+	}
+
+	digest := []byte(data)
+	if eip191 {
+		digest = accounts.TextHash(digest)
+	}
+	signature, err := t.keyStore.SignHash(ctx, common.Address(address), common.BytesToHash(digest))
+	if err != nil {
+		return Result{Error: errors.Wrap(err, "failed to sign")}, runInfo
+	}
+	signature[64] += 27
+
+	return Result{Value: signature}, runInfo
+}
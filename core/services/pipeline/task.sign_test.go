@@ -0,0 +1,101 @@
+package pipeline_test
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	keystoremocks "github.com/smartcontractkit/chainlink/v2/core/services/keystore/mocks"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
+)
+
+func TestSignTask(t *testing.T) {
+	t.Parallel()
+
+	privateKey, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	address := crypto.PubkeyToAddress(privateKey.PublicKey)
+	digest := crypto.Keccak256([]byte("hello"))
+
+	tests := []struct {
+		name        string
+		address     string
+		data        string
+		eip191      string
+		vars        map[string]interface{}
+		inputs      []pipeline.Result
+		signingKeys []common.Address
+		signed      []byte
+		error       string
+	}{
+		{"data from input", address.Hex(), "", "", nil, []pipeline.Result{{Value: digest}}, []common.Address{address}, digest, ""},
+		{"data from vars", "$(address)", "$(digest)", "", map[string]interface{}{"address": address.Hex(), "digest": hexutil.Encode(digest)}, nil, []common.Address{address}, digest, ""},
+		{"eip191", address.Hex(), hexutil.Encode(digest), "true", nil, nil, []common.Address{address}, accounts.TextHash(digest), ""},
+		{"key not allowed", address.Hex(), hexutil.Encode(digest), "", nil, nil, []common.Address{testutils.NewAddress()}, nil, "is not in the signingKeys of the job"},
+		{"no signing keys", address.Hex(), hexutil.Encode(digest), "", nil, nil, nil, nil, "is not in the signingKeys of the job"},
+		{"data is not a digest", address.Hex(), "0x68656c6c6f", "", nil, nil, []common.Address{address}, nil, "data must be a 32 byte digest"},
+		{"invalid address", "0xdeadbeef", hexutil.Encode(digest), "", nil, nil, []common.Address{address}, nil, "address"},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			keyStore := keystoremocks.NewEth(t)
+			if test.signed != nil {
+				signature, err := crypto.Sign(test.signed, privateKey)
+				require.NoError(t, err)
+				keyStore.On("SignHash", mock.Anything, address, common.BytesToHash(test.signed)).Return(signature, nil).Once()
+			}
+
+			task := pipeline.SignTask{
+				BaseTask: pipeline.NewBaseTask(0, "sign", nil, nil, 0),
+				Address:  test.address,
+				Data:     test.data,
+				EIP191:   test.eip191,
+			}
+			task.HelperSetDependencies(keyStore, test.signingKeys)
+
+			result, runInfo := task.Run(testutils.Context(t), logger.TestLogger(t), pipeline.NewVarsFrom(test.vars), test.inputs)
+			assert.False(t, runInfo.IsPending)
+			assert.False(t, runInfo.IsRetryable)
+			if test.error != "" {
+				require.ErrorContains(t, result.Error, test.error)
+				return
+			}
+			require.NoError(t, result.Error)
+			signature := result.Value.([]byte)
+			require.Len(t, signature, 65)
+			require.Contains(t, []byte{27, 28}, signature[64])
+
+			recoverable := append([]byte{}, signature...)
+			recoverable[64] -= 27
+			pubKey, err := crypto.SigToPub(test.signed, recoverable)
+			require.NoError(t, err)
+			assert.Equal(t, address, crypto.PubkeyToAddress(*pubKey))
+		})
+	}
+
+	t.Run("key store error", func(t *testing.T) {
+		keyStore := keystoremocks.NewEth(t)
+		keyStore.On("SignHash", mock.Anything, address, common.BytesToHash(digest)).Return(nil, errors.New("keystore is locked")).Once()
+
+		task := pipeline.SignTask{
+			BaseTask: pipeline.NewBaseTask(0, "sign", nil, nil, 0),
+			Address:  address.Hex(),
+			Data:     hexutil.Encode(digest),
+		}
+		task.HelperSetDependencies(keyStore, []common.Address{address})
+
+		result, _ := task.Run(testutils.Context(t), logger.TestLogger(t), pipeline.NewVarsFrom(nil), nil)
+		require.ErrorContains(t, result.Error, "failed to sign: keystore is locked")
+	})
+}
@@ -0,0 +1,48 @@
+package pipeline
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"go.uber.org/multierr"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+)
+
+// Return types:
+//
+//	*decimal.Decimal
+type SubtractTask struct {
+	BaseTask   `mapstructure:",squash"`
+	Input      string `json:"input"`
+	Subtrahend string `json:"subtrahend"`
+}
+
+var _ Task = (*SubtractTask)(nil)
+
+func (t *SubtractTask) Type() TaskType {
+	return TaskTypeSubtract
+}
+
+func (t *SubtractTask) Run(_ context.Context, _ logger.Logger, vars Vars, inputs []Result) (result Result, runInfo RunInfo) {
+	_, err := CheckInputs(inputs, 0, 1, 0)
+	if err != nil {
+		return Result{Error: errors.Wrap(err, "task inputs")}, runInfo
+	}
+
+	var (
+		a DecimalParam
+		b DecimalParam
+	)
+
+	err = multierr.Combine(
+		errors.Wrap(ResolveParam(&a, From(VarExpr(t.Input, vars), NonemptyString(t.Input), Input(inputs, 0))), "input"),
+		errors.Wrap(ResolveParam(&b, From(VarExpr(t.Subtrahend, vars), NonemptyString(t.Subtrahend))), "subtrahend"),
+	)
+	if err != nil {
+		return Result{Error: err}, runInfo
+	}
+
+	value := a.Decimal().Sub(b.Decimal())
+	return Result{Value: value}, runInfo
+}
@@ -0,0 +1,110 @@
+package pipeline_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
+)
+
+func TestSubtractTask_Happy(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		input      interface{}
+		subtrahend string
+		want       *decimal.Decimal
+	}{
+		{"string", "1.23", "0.23", mustDecimal(t, "1")},
+		{"string, negative result", "1.23", "10", mustDecimal(t, "-8.77")},
+		{"string, negative subtrahend", "1.23", "-1", mustDecimal(t, "2.23")},
+		{"large string", "10000000000000000000000000000000000001", "1", mustDecimal(t, "10000000000000000000000000000000000000")},
+		{"int", int(2), "3", mustDecimal(t, "-1")},
+		{"int64", int64(2), "1.5", mustDecimal(t, "0.5")},
+		{"uint64", uint64(2), "2", mustDecimal(t, "0")},
+		{"float64", float64(1.5), "0.25", mustDecimal(t, "1.25")},
+		{"decimal", mustDecimal(t, "0.1"), "0.3", mustDecimal(t, "-0.2")},
+	}
+
+	for _, test := range tests {
+		assertOK := func(result pipeline.Result, runInfo pipeline.RunInfo) {
+			assert.False(t, runInfo.IsPending)
+			assert.False(t, runInfo.IsRetryable)
+			require.NoError(t, result.Error)
+			require.Equal(t, test.want.String(), result.Value.(decimal.Decimal).String())
+		}
+		t.Run(test.name, func(t *testing.T) {
+			t.Run("without vars through job DAG", func(t *testing.T) {
+				vars := pipeline.NewVarsFrom(nil)
+				task := pipeline.SubtractTask{BaseTask: pipeline.NewBaseTask(0, "task", nil, nil, 0), Subtrahend: test.subtrahend}
+				assertOK(task.Run(testutils.Context(t), logger.TestLogger(t), vars, []pipeline.Result{{Value: test.input}}))
+			})
+			t.Run("without vars through input param", func(t *testing.T) {
+				vars := pipeline.NewVarsFrom(nil)
+				task := pipeline.SubtractTask{
+					BaseTask:   pipeline.NewBaseTask(0, "task", nil, nil, 0),
+					Input:      fmt.Sprintf("%v", test.input),
+					Subtrahend: test.subtrahend,
+				}
+				assertOK(task.Run(testutils.Context(t), logger.TestLogger(t), vars, []pipeline.Result{}))
+			})
+			t.Run("with vars", func(t *testing.T) {
+				vars := pipeline.NewVarsFrom(map[string]interface{}{
+					"foo":   map[string]interface{}{"bar": test.input},
+					"chain": map[string]interface{}{"link": test.subtrahend},
+				})
+				task := pipeline.SubtractTask{
+					BaseTask:   pipeline.NewBaseTask(0, "task", nil, nil, 0),
+					Input:      "$(foo.bar)",
+					Subtrahend: "$(chain.link)",
+				}
+				assertOK(task.Run(testutils.Context(t), logger.TestLogger(t), vars, []pipeline.Result{}))
+			})
+		})
+	}
+}
+
+func TestSubtractTask_Unhappy(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name              string
+		input             string
+		subtrahend        string
+		inputs            []pipeline.Result
+		vars              pipeline.Vars
+		wantErrorCause    error
+		wantErrorContains string
+	}{
+		{"map as input from inputs", "", "100", []pipeline.Result{{Value: map[string]interface{}{"chain": "link"}}}, pipeline.NewVarsFrom(nil), pipeline.ErrBadInput, "input"},
+		{"slice as input from var", "$(foo)", "100", nil, pipeline.NewVarsFrom(map[string]interface{}{"foo": []interface{}{"chain", "link"}}), pipeline.ErrBadInput, "input"},
+		{"input as missing var", "$(foo)", "100", nil, pipeline.NewVarsFrom(nil), pipeline.ErrKeypathNotFound, "input"},
+		{"subtrahend as missing var", "", "$(foo)", []pipeline.Result{{Value: "123"}}, pipeline.NewVarsFrom(nil), pipeline.ErrKeypathNotFound, "subtrahend"},
+		{"errored input", "", "100", []pipeline.Result{{Error: errors.New("uh oh")}}, pipeline.NewVarsFrom(nil), pipeline.ErrTooManyErrors, "task inputs"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			task := pipeline.SubtractTask{
+				BaseTask:   pipeline.NewBaseTask(0, "task", nil, nil, 0),
+				Input:      test.input,
+				Subtrahend: test.subtrahend,
+			}
+			result, runInfo := task.Run(testutils.Context(t), logger.TestLogger(t), test.vars, test.inputs)
+			assert.False(t, runInfo.IsPending)
+			assert.False(t, runInfo.IsRetryable)
+			require.Equal(t, test.wantErrorCause, errors.Cause(result.Error))
+			if test.wantErrorContains != "" {
+				require.Contains(t, result.Error.Error(), test.wantErrorContains)
+			}
+		})
+	}
+}
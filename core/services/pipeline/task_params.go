@@ -520,6 +520,20 @@ func (m MapParam) Map() map[string]interface{} {
 	return (map[string]interface{})(m)
 }
 
+// AnyParam accepts any value, for tasks passing values through unchanged.
+type AnyParam struct {
+	value interface{}
+}
+
+func (a *AnyParam) UnmarshalPipelineParam(val interface{}) error {
+	a.value = val
+	return nil
+}
+
+func (a AnyParam) Value() interface{} {
+	return a.value
+}
+
 type SliceParam []interface{}
 
 func (s *SliceParam) UnmarshalPipelineParam(val interface{}) error {
@@ -48,6 +48,9 @@ type contractTransmitter struct {
 	lp                  logpoller.LogPoller
 	lggr                logger.Logger
 	reportToEvmTxMeta   ReportToEthMetadata
+	// contractABIs, if set, has the contract ABI registered until Close, so that the Txm can decode the custom
+	// errors reverted transmissions fail with.
+	contractABIs *txmgr.ContractABIs
 }
 
 func transmitterFilterName(addr common.Address) string {
@@ -198,7 +201,12 @@ func (oc *contractTransmitter) FromAccount() (ocrtypes.Account, error) {
 }
 
 func (oc *contractTransmitter) Start(ctx context.Context) error { return nil }
-func (oc *contractTransmitter) Close() error                    { return nil }
+func (oc *contractTransmitter) Close() error {
+	if oc.contractABIs != nil {
+		oc.contractABIs.Unregister(oc.contractAddress)
+	}
+	return nil
+}
 
 // Has no state/lifecycle so it's always healthy and ready
 func (oc *contractTransmitter) Ready() error { return nil }
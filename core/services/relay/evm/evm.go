@@ -517,7 +517,7 @@ func newOnChainContractTransmitter(ctx context.Context, lggr logger.Logger, rarg
 	strategy := txmgrcommon.NewQueueingTxStrategy(subject, scoped.OCR2().DefaultTransactionQueueDepth(), scoped.Database().DefaultQueryTimeout())
 
 	var checker txm.TransmitCheckerSpec
-	if configWatcher.chain.Config().OCR2().SimulateTransactions() {
+	if configWatcher.chain.Config().OCR2().SimulateTransactions() || relayConfig.SimulateTransactions {
 		checker.CheckerType = txm.TransmitCheckerTypeSimulate
 	}
 
@@ -545,7 +545,7 @@ func newOnChainContractTransmitter(ctx context.Context, lggr logger.Logger, rarg
 		return nil, pkgerrors.Wrap(err, "failed to create transmitter")
 	}
 
-	return NewOCRContractTransmitter(
+	ct, err := NewOCRContractTransmitter(
 		ctx,
 		configWatcher.contractAddress,
 		configWatcher.chain.Client(),
@@ -555,6 +555,14 @@ func newOnChainContractTransmitter(ctx context.Context, lggr logger.Logger, rarg
 		lggr,
 		nil,
 	)
+	if err != nil {
+		return nil, err
+	}
+	if r, ok := configWatcher.chain.TxManager().(txm.ContractABIRegistry); ok {
+		ct.contractABIs = r.ContractABIs()
+		ct.contractABIs.Register(ct.contractAddress, transmissionContractABI)
+	}
+	return ct, nil
 }
 
 func (r *Relayer) NewMedianProvider(rargs commontypes.RelayArgs, pargs commontypes.PluginArgs) (commontypes.MedianProvider, error) {
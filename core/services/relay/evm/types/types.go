@@ -115,6 +115,8 @@ type RelayConfig struct {
 
 	// Contract-specific
 	SendingKeys pq.StringArray `json:"sendingKeys"`
+	// SimulateTransactions simulates the transmissions before they are sent, as set on the job.
+	SimulateTransactions bool `json:"simulateTransactions"`
 
 	// Mercury-specific
 	FeedID *common.Hash `json:"feedID"`
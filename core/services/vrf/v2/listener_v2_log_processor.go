@@ -604,6 +604,7 @@ func (lsn *listenerV2) enqueueForceFulfillment(
 			EncodedPayload: txData,
 			FeeLimit:       estimateGasLimit,
 			Strategy:       txmgrcommon.NewSendEveryStrategy(),
+			Checker:        txmgr.TransmitCheckerSpec{Simulate: lsn.job.SimulateTransactions},
 			Meta: &txmgr.TxMeta{
 				RequestID:     &requestID,
 				SubID:         ptr(subID.Uint64()),
@@ -828,6 +829,7 @@ func (lsn *listenerV2) processRequestsPerSubHelper(
 						CheckerType:           lsn.transmitCheckerType(),
 						VRFCoordinatorAddress: &coordinatorAddress,
 						VRFRequestBlockNumber: new(big.Int).SetUint64(p.req.req.Raw().BlockNumber),
+						Simulate:              lsn.job.SimulateTransactions,
 					},
 				})
 				return err
@@ -242,6 +242,7 @@ func (lsn *listenerV2) processBatch(
 			EncodedPayload: payload,
 			FeeLimit:       uint64(totalGasLimitBumped),
 			Strategy:       txmgrcommon.NewSendEveryStrategy(),
+			Checker:        txmgr.TransmitCheckerSpec{Simulate: lsn.job.SimulateTransactions},
 			Meta: &txmgr.TxMeta{
 				RequestIDs:      reqIDHashes,
 				MaxLink:         &maxLink,
@@ -709,6 +709,7 @@ func (lsn *listenerV2) enqueueForceFulfillmentForRevertedTxn(
 		EncodedPayload: txData,
 		FeeLimit:       estimateGasLimit,
 		Strategy:       txmgrcommon.NewSendEveryStrategy(),
+		Checker:        txmgr.TransmitCheckerSpec{Simulate: lsn.job.SimulateTransactions},
 		Meta: &txmgr.TxMeta{
 			RequestID:               &reqID,
 			SubID:                   &revertedTxn.DBReceipt.SubID,
@@ -7,8 +7,11 @@ import (
 	"github.com/google/uuid"
 	"github.com/pelletier/go-toml"
 
+	"github.com/smartcontractkit/chainlink-common/pkg/sqlutil"
 	"github.com/smartcontractkit/chainlink-common/pkg/types"
+	"github.com/smartcontractkit/chainlink/v2/core/capabilities/actions"
 	"github.com/smartcontractkit/chainlink/v2/core/capabilities/targets"
+	"github.com/smartcontractkit/chainlink/v2/core/capabilities/triggers"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/legacyevm"
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
 	"github.com/smartcontractkit/chainlink/v2/core/services/job"
@@ -17,6 +20,7 @@ import (
 
 type Delegate struct {
 	registry types.CapabilitiesRegistry
+	orm      ORM
 	logger   logger.Logger
 }
 
@@ -32,25 +36,59 @@ func (d *Delegate) AfterJobCreated(jb job.Job) {}
 
 func (d *Delegate) BeforeJobDeleted(spec job.Job) {}
 
-func (d *Delegate) OnDeleteJob(ctx context.Context, jb job.Job, q pg.Queryer) error { return nil }
+// OnDeleteJob deletes the last blocks delivered by the log triggers of the workflow, which they keep when unregistered
+// to resume from them after a node restart.
+func (d *Delegate) OnDeleteJob(ctx context.Context, jb job.Job, q pg.Queryer) error {
+	if jb.WorkflowSpec == nil {
+		d.logger.Errorf("workflows.Delegate.OnDeleteJob called with wrong job type, ignoring non-workflow spec %v", jb)
+		return nil
+	}
+	workflow, err := Parse(jb.WorkflowSpec.Workflow)
+	if err != nil {
+		// the job deletion is not blocked by a malformed spec, whose triggers were never registered
+		d.logger.Errorw("Failed to parse workflow of deleted job", "jobID", jb.ID, "err", err)
+		return nil
+	}
+	triggerIDs := make([]string, len(workflow.Triggers))
+	for i := range workflow.Triggers {
+		triggerIDs[i] = generateTriggerID(jb.WorkflowSpec.WorkflowID, i)
+	}
+	return triggers.NewLogTriggerORM(q).DeleteLastDeliveredBlocks(ctx, triggerIDs)
+}
 
 // ServicesForSpec satisfies the job.Delegate interface.
 func (d *Delegate) ServicesForSpec(ctx context.Context, spec job.Job) ([]job.ServiceCtx, error) {
-	engine, err := NewEngine(d.logger, d.registry)
+	if spec.WorkflowSpec == nil {
+		return nil, fmt.Errorf("services.Delegate expects a *job.WorkflowSpec to be present, got %v", spec)
+	}
+
+	cfg := Config{
+		Lggr:          d.logger,
+		Registry:      d.registry,
+		ORM:           d.orm,
+		Workflow:      spec.WorkflowSpec.Workflow,
+		WorkflowID:    spec.WorkflowSpec.WorkflowID,
+		WorkflowOwner: spec.WorkflowSpec.WorkflowOwner,
+	}
+	engine, err := NewEngine(cfg)
 	if err != nil {
 		return nil, err
 	}
 	return []job.ServiceCtx{engine}, nil
 }
 
-func NewDelegate(logger logger.Logger, registry types.CapabilitiesRegistry, legacyEVMChains legacyevm.LegacyChainContainer) *Delegate {
+func NewDelegate(logger logger.Logger, registry types.CapabilitiesRegistry, legacyEVMChains legacyevm.LegacyChainContainer, ds sqlutil.DataSource) *Delegate {
 	// NOTE: we temporarily do registration inside NewDelegate, this will be moved out of job specs in the future
 	_ = targets.InitializeWrite(registry, legacyEVMChains, logger)
+	_ = actions.InitializeRead(registry, legacyEVMChains, logger)
+	_ = triggers.InitializeLogTrigger(registry, legacyEVMChains, ds, logger)
 
-	return &Delegate{logger: logger, registry: registry}
+	return &Delegate{logger: logger, registry: registry, orm: NewORM(ds)}
 }
 
-func ValidatedWorkflowSpec(tomlString string) (job.Job, error) {
+// ValidatedWorkflowSpec parses and validates a workflow job spec. Errors found
+// in the workflow itself are returned as ValidationErrors.
+func ValidatedWorkflowSpec(ctx context.Context, tomlString string, registry types.CapabilitiesRegistry) (job.Job, error) {
 	var jb = job.Job{ExternalJobID: uuid.New()}
 
 	tree, err := toml.Load(tomlString)
@@ -67,5 +105,24 @@ func ValidatedWorkflowSpec(tomlString string) (job.Job, error) {
 		return jb, fmt.Errorf("unsupported type %s", jb.Type)
 	}
 
+	var spec job.WorkflowSpec
+	err = tree.Unmarshal(&spec)
+	if err != nil {
+		return jb, fmt.Errorf("toml unmarshal error on workflow spec: %w", err)
+	}
+
+	owner, err := normalizeWorkflowOwner(spec.WorkflowOwner)
+	if err != nil {
+		return jb, err
+	}
+	spec.WorkflowOwner = owner
+
+	_, err = Validate(ctx, spec.Workflow, registry, spec.AllowUnregisteredCapabilities)
+	if err != nil {
+		return jb, err
+	}
+
+	spec.WorkflowID = generateWorkflowID(spec.WorkflowOwner, spec.Workflow)
+	jb.WorkflowSpec = &spec
 	return jb, nil
 }
@@ -3,11 +3,39 @@ package workflows_test
 import (
 	"testing"
 
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"github.com/smartcontractkit/chainlink-common/pkg/capabilities"
+	coreCap "github.com/smartcontractkit/chainlink/v2/core/capabilities"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
 	"github.com/smartcontractkit/chainlink/v2/core/services/workflows"
 )
 
+type testTrigger struct {
+	capabilities.CapabilityInfo
+	capabilities.TriggerExecutable
+}
+
+type testTarget struct {
+	capabilities.CapabilityInfo
+	capabilities.CallbackExecutable
+}
+
+// newTestRegistry returns a registry holding the capabilities used by the specs in these tests.
+func newTestRegistry(t *testing.T) *coreCap.Registry {
+	ctx := testutils.Context(t)
+	reg := coreCap.NewRegistry(logger.TestLogger(t))
+	require.NoError(t, reg.Add(ctx, testTrigger{CapabilityInfo: capabilities.MustNewCapabilityInfo(
+		"on_mercury_report", capabilities.CapabilityTypeTrigger, "a trigger", "v1.0.0")}))
+	for _, id := range []string{"write_polygon-testnet-mumbai", "write_ethereum-testnet-sepolia"} {
+		require.NoError(t, reg.Add(ctx, testTarget{CapabilityInfo: capabilities.MustNewCapabilityInfo(
+			id, capabilities.CapabilityTypeTarget, "a target", "v1.0.0")}))
+	}
+	return reg
+}
+
 func TestDelegate_JobSpecValidator(t *testing.T) {
 	t.Parallel()
 
@@ -21,6 +49,16 @@ func TestDelegate_JobSpecValidator(t *testing.T) {
 			`
 type = "workflow"
 schemaVersion = 1
+workflowOwner = "0x00000000000000000000000000000000000000aA"
+workflow = """
+triggers:
+  - type: "on_mercury_report"
+    ref: report_data
+targets:
+  - type: "write_polygon-testnet-mumbai"
+    inputs:
+      report: $(report_data.outputs)
+"""
 `,
 			true,
 		},
@@ -39,11 +77,76 @@ schemaVersion = 1
 `,
 			false,
 		},
+		{
+			"missing workflow owner",
+			`
+type = "workflow"
+schemaVersion = 1
+workflow = """
+triggers:
+  - type: "on_mercury_report"
+    ref: report_data
+"""
+`,
+			false,
+		},
+		{
+			"invalid workflow",
+			`
+type = "workflow"
+schemaVersion = 1
+workflowOwner = "0x00000000000000000000000000000000000000aA"
+workflow = """
+targets:
+  - type: "write_polygon-testnet-mumbai"
+    inputs:
+      report: $(report_data.outputs)
+"""
+`,
+			false,
+		},
+		{
+			"unregistered capability",
+			`
+type = "workflow"
+schemaVersion = 1
+workflowOwner = "0x00000000000000000000000000000000000000aA"
+workflow = """
+triggers:
+  - type: "on_mercury_report"
+    ref: report_data
+targets:
+  - type: "write_unknown-chain"
+    inputs:
+      report: $(report_data.outputs)
+"""
+`,
+			false,
+		},
+		{
+			"unregistered capability allowed",
+			`
+type = "workflow"
+schemaVersion = 1
+workflowOwner = "0x00000000000000000000000000000000000000aA"
+allowUnregisteredCapabilities = ["write_unknown-chain"]
+workflow = """
+triggers:
+  - type: "on_mercury_report"
+    ref: report_data
+targets:
+  - type: "write_unknown-chain"
+    inputs:
+      report: $(report_data.outputs)
+"""
+`,
+			true,
+		},
 	}
 	for _, tc := range tt {
 		tc := tc
 		t.Run(tc.name, func(t *testing.T) {
-			_, err := workflows.ValidatedWorkflowSpec(tc.toml)
+			_, err := workflows.ValidatedWorkflowSpec(testutils.Context(t), tc.toml, newTestRegistry(t))
 			if tc.valid {
 				require.NoError(t, err)
 			} else {
@@ -52,3 +155,78 @@ schemaVersion = 1
 		})
 	}
 }
+
+func TestDelegate_WorkflowID(t *testing.T) {
+	t.Parallel()
+
+	spec := func(owner string, targetType string) string {
+		return `
+type = "workflow"
+schemaVersion = 1
+workflowOwner = "` + owner + `"
+workflow = """
+triggers:
+  - type: "on_mercury_report"
+    ref: report_data
+targets:
+  - type: "` + targetType + `"
+    inputs:
+      report: $(report_data.outputs)
+"""
+`
+	}
+
+	ctx := testutils.Context(t)
+	registry := newTestRegistry(t)
+
+	jb, err := workflows.ValidatedWorkflowSpec(ctx, spec("0x00000000000000000000000000000000000000aA", "write_polygon-testnet-mumbai"), registry)
+	require.NoError(t, err)
+	require.NotNil(t, jb.WorkflowSpec)
+	assert.Equal(t, "00000000000000000000000000000000000000aa", jb.WorkflowSpec.WorkflowOwner)
+	assert.Len(t, jb.WorkflowSpec.WorkflowID, 64)
+
+	// the ID is deterministic and independent of the owner's encoding
+	same, err := workflows.ValidatedWorkflowSpec(ctx, spec("00000000000000000000000000000000000000AA", "write_polygon-testnet-mumbai"), registry)
+	require.NoError(t, err)
+	assert.Equal(t, jb.WorkflowSpec.WorkflowID, same.WorkflowSpec.WorkflowID)
+
+	otherOwner, err := workflows.ValidatedWorkflowSpec(ctx, spec("0x00000000000000000000000000000000000000bb", "write_polygon-testnet-mumbai"), registry)
+	require.NoError(t, err)
+	assert.NotEqual(t, jb.WorkflowSpec.WorkflowID, otherOwner.WorkflowSpec.WorkflowID)
+
+	otherWorkflow, err := workflows.ValidatedWorkflowSpec(ctx, spec("0x00000000000000000000000000000000000000aA", "write_ethereum-testnet-sepolia"), registry)
+	require.NoError(t, err)
+	assert.NotEqual(t, jb.WorkflowSpec.WorkflowID, otherWorkflow.WorkflowSpec.WorkflowID)
+}
+
+func TestDelegate_ValidationErrors(t *testing.T) {
+	t.Parallel()
+
+	spec := `
+type = "workflow"
+schemaVersion = 1
+workflowOwner = "0x00000000000000000000000000000000000000aA"
+workflow = """
+triggers:
+  - type: "write_polygon-testnet-mumbai"
+    ref: report_data
+consensus:
+  - type: "offchain_reporting"
+    ref: evm_median
+    inputs:
+      reports: $(report_data.outputs)
+targets:
+  - type: "write_ethereum-testnet-sepolia"
+    inputs:
+      report: $(evm_median.outputs.reports)
+"""
+`
+	_, err := workflows.ValidatedWorkflowSpec(testutils.Context(t), spec, newTestRegistry(t))
+	var validationErrs workflows.ValidationErrors
+	require.ErrorAs(t, err, &validationErrs)
+	assert.Equal(t, workflows.ValidationErrors{
+		{Ref: "evm_median", Msg: "capability offchain_reporting is not registered"},
+		{Ref: "evm_median", Msg: "missing required input observations"},
+		{Ref: "report_data", Msg: "capability write_polygon-testnet-mumbai is a target, expected a trigger"},
+	}, validationErrs)
+}
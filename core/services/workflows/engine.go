@@ -2,9 +2,15 @@ package workflows
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
 	"fmt"
+	"sync"
 	"time"
 
+	"google.golang.org/protobuf/proto"
+
 	"github.com/smartcontractkit/chainlink-common/pkg/capabilities"
 	"github.com/smartcontractkit/chainlink-common/pkg/services"
 	"github.com/smartcontractkit/chainlink-common/pkg/types"
@@ -13,22 +19,36 @@ import (
 )
 
 const (
-	// NOTE: max 32 bytes per ID - consider enforcing exactly 32 bytes?
-	mockedWorkflowID  = "aaaaaaaaaa0000000000000000000000"
-	mockedExecutionID = "bbbbbbbbbb0000000000000000000000"
-	mockedTriggerID   = "cccccccccc0000000000000000000000"
+	// defaultStepTimeout bounds each attempt at executing a step which does not set a timeout.
+	defaultStepTimeout = 10 * time.Second
+	// maxStepBackoff caps the wait between retries of a step, which doubles on every retry.
+	maxStepBackoff = 5 * time.Minute
 )
 
-type Engine struct {
-	services.StateMachine
-	logger     logger.Logger
-	registry   types.CapabilitiesRegistry
+type triggerCapability struct {
+	Capability
 	trigger    capabilities.TriggerCapability
-	consensus  capabilities.ConsensusCapability
-	targets    []capabilities.TargetCapability
-	workflow   *Workflow
+	triggerID  string
 	callbackCh chan capabilities.CapabilityResponse
-	cancel     func()
+	registered bool
+}
+
+type Engine struct {
+	services.StateMachine
+	logger        logger.Logger
+	registry      types.CapabilitiesRegistry
+	orm           ORM
+	workflow      *Workflow
+	workflowID    string
+	workflowOwner string
+	triggers      []*triggerCapability
+	// stepCapabilities holds the capability backing each non-trigger step, keyed by ref.
+	// It is fully populated before any trigger is registered.
+	stepCapabilities map[string]capabilities.CallbackExecutable
+	// wg tracks the goroutines of the engine: the initialization goroutine, which populates the
+	// capabilities above, the trigger handler loops and the executions, which all stop on Close.
+	wg     sync.WaitGroup
+	cancel func()
 }
 
 func (e *Engine) Start(ctx context.Context) error {
@@ -36,8 +56,11 @@ func (e *Engine) Start(ctx context.Context) error {
 		// create a new context, since the one passed in via Start is short-lived.
 		ctx, cancel := context.WithCancel(context.Background())
 		e.cancel = cancel
-		go e.init(ctx)
-		go e.triggerHandlerLoop(ctx)
+		e.wg.Add(1)
+		go func() {
+			defer e.wg.Done()
+			e.init(ctx)
+		}()
 		return nil
 	})
 }
@@ -47,184 +70,385 @@ func (e *Engine) init(ctx context.Context) {
 	ticker := time.NewTicker(time.Duration(retrySec) * time.Second)
 	defer ticker.Stop()
 
-	// Note: in our hardcoded workflow, there is only one trigger,
-	// and one consensus step.
-	trigger := e.workflow.Triggers[0]
-	consensus := e.workflow.Consensus[0]
-
-	var err error
 LOOP:
 	for {
 		select {
 		case <-ctx.Done():
 			return
 		case <-ticker.C:
-			e.trigger, err = e.registry.GetTrigger(ctx, trigger.Type)
+			err := e.resolveCapabilities(ctx)
 			if err != nil {
-				e.logger.Errorf("failed to get trigger capability: %s, retrying in %d seconds", err, retrySec)
+				e.logger.Errorf("%s, retrying in %d seconds", err, retrySec)
 				break
 			}
+			break LOOP
+		}
+	}
 
-			e.consensus, err = e.registry.GetConsensus(ctx, consensus.Type)
-			if err != nil {
-				e.logger.Errorf("failed to get consensus capability: %s, retrying in %d seconds", err, retrySec)
-				break
-			}
-			failed := false
-			e.targets = make([]capabilities.TargetCapability, len(e.workflow.Targets))
-			for i, target := range e.workflow.Targets {
-				e.targets[i], err = e.registry.GetTarget(ctx, target.Type)
-				if err != nil {
-					e.logger.Errorf("failed to get target capability: %s, retrying in %d seconds", err, retrySec)
-					failed = true
-					break
-				}
-			}
-			if !failed {
-				break LOOP
-			}
+	// we have all needed capabilities, now we can register the steps to the workflow
+	for _, s := range e.workflow.steps {
+		err := e.registerStep(ctx, s)
+		if err != nil {
+			e.logger.Errorf("failed to register step %s: %s", s.Ref, err)
 		}
 	}
 
-	// we have all needed capabilities, now we can register for trigger events
-	err = e.registerTrigger(ctx)
-	if err != nil {
-		e.logger.Errorf("failed to register trigger: %s", err)
+	// pick up any executions interrupted by a restart
+	e.resumeUnfinishedExecutions(ctx)
+
+	// and finally register for trigger events
+	for _, t := range e.triggers {
+		// start listening first, since a trigger may emit events during registration
+		e.wg.Add(1)
+		go func() {
+			defer e.wg.Done()
+			e.triggerHandlerLoop(ctx, t)
+		}()
+		err := e.registerTrigger(ctx, t)
+		if err != nil {
+			e.logger.Errorf("failed to register trigger %s: %s", t.Ref, err)
+		}
 	}
 
-	// also register for consensus
-	cm, err := values.NewMap(consensus.Config)
+	e.logger.Info("engine initialized")
+}
+
+// resolveCapabilities fetches every capability referenced by the workflow from the registry.
+func (e *Engine) resolveCapabilities(ctx context.Context) error {
+	triggers := make([]*triggerCapability, len(e.workflow.Triggers))
+	for i, t := range e.workflow.Triggers {
+		tc, err := e.registry.GetTrigger(ctx, t.Type)
+		if err != nil {
+			return fmt.Errorf("failed to get trigger capability %s: %w", t.Type, err)
+		}
+		triggers[i] = &triggerCapability{
+			Capability: t,
+			trigger:    tc,
+			triggerID:  generateTriggerID(e.workflowID, i),
+			callbackCh: make(chan capabilities.CapabilityResponse),
+		}
+	}
+
+	stepCapabilities := make(map[string]capabilities.CallbackExecutable, len(e.workflow.steps))
+	for ref, s := range e.workflow.steps {
+		var (
+			c   capabilities.CallbackExecutable
+			err error
+		)
+		switch s.capabilityType {
+		case capabilities.CapabilityTypeAction:
+			c, err = e.registry.GetAction(ctx, s.Type)
+		case capabilities.CapabilityTypeConsensus:
+			c, err = e.registry.GetConsensus(ctx, s.Type)
+		case capabilities.CapabilityTypeTarget:
+			c, err = e.registry.GetTarget(ctx, s.Type)
+		default:
+			err = fmt.Errorf("unsupported capability type %s", s.capabilityType)
+		}
+		if err != nil {
+			return fmt.Errorf("failed to get %s capability %s: %w", s.capabilityType, s.Type, err)
+		}
+		stepCapabilities[ref] = c
+	}
+
+	e.triggers = triggers
+	e.stepCapabilities = stepCapabilities
+	return nil
+}
+
+func (e *Engine) registerStep(ctx context.Context, s *step) error {
+	cm, err := values.NewMap(s.Config)
 	if err != nil {
-		e.logger.Errorf("failed to convert config to values.Map: %s", err)
+		return fmt.Errorf("failed to convert config to values.Map: %w", err)
 	}
 	reg := capabilities.RegisterToWorkflowRequest{
 		Metadata: capabilities.RegistrationMetadata{
-			WorkflowID: mockedWorkflowID,
+			WorkflowID: e.workflowID,
 		},
 		Config: cm,
 	}
-	err = e.consensus.RegisterToWorkflow(ctx, reg)
-	if err != nil {
-		e.logger.Errorf("failed to register consensus: %s", err)
-	}
-
-	e.logger.Info("engine initialized")
+	return e.stepCapabilities[s.Ref].RegisterToWorkflow(ctx, reg)
 }
 
-func (e *Engine) registerTrigger(ctx context.Context) error {
-	trigger := e.workflow.Triggers[0]
+func (e *Engine) registerTrigger(ctx context.Context, t *triggerCapability) error {
 	triggerInputs, err := values.NewMap(
 		map[string]any{
-			"triggerId": mockedTriggerID,
+			"triggerId": t.triggerID,
 		},
 	)
 	if err != nil {
 		return err
 	}
 
-	tc, err := values.NewMap(trigger.Config)
+	tc, err := values.NewMap(t.Config)
 	if err != nil {
 		return err
 	}
 
 	triggerRegRequest := capabilities.CapabilityRequest{
 		Metadata: capabilities.RequestMetadata{
-			WorkflowID: mockedWorkflowID,
+			WorkflowID: e.workflowID,
 		},
 		Config: tc,
 		Inputs: triggerInputs,
 	}
-	err = e.trigger.RegisterTrigger(ctx, e.callbackCh, triggerRegRequest)
+	err = t.trigger.RegisterTrigger(ctx, t.callbackCh, triggerRegRequest)
 	if err != nil {
-		return fmt.Errorf("failed to instantiate mercury_trigger, %s", err)
+		return fmt.Errorf("failed to instantiate trigger %s, %s", t.Type, err)
 	}
+	t.registered = true
 	return nil
 }
 
-func (e *Engine) triggerHandlerLoop(ctx context.Context) {
+func (e *Engine) triggerHandlerLoop(ctx context.Context, t *triggerCapability) {
 	for {
 		select {
 		case <-ctx.Done():
 			return
-		case resp := <-e.callbackCh:
-			go e.handleExecution(ctx, resp)
+		case resp := <-t.callbackCh:
+			e.wg.Add(1)
+			go func() {
+				defer e.wg.Done()
+				e.handleExecution(ctx, t, resp)
+			}()
 		}
 	}
 }
 
-func (e *Engine) handleExecution(ctx context.Context, event capabilities.CapabilityResponse) {
-	e.logger.Debugw("executing on a trigger event", "event", event)
-	trigger := e.workflow.Triggers[0]
+func (e *Engine) handleExecution(ctx context.Context, trigger *triggerCapability, event capabilities.CapabilityResponse) {
+	e.logger.Debugw("executing on a trigger event", "trigger", trigger.Ref, "event", event)
 	if event.Err != nil {
 		e.logger.Errorf("trigger event was an error; not executing", event.Err)
 		return
 	}
 
-	ec := &executionState{
+	executionID, err := generateExecutionID(e.workflowID, event.Value)
+	if err != nil {
+		e.logger.Errorf("failed to generate execution ID; not executing: %v", err)
+		return
+	}
+
+	es := &executionState{
 		steps: map[string]*stepState{
 			trigger.Ref: {
+				status: statusCompleted,
 				outputs: &stepOutput{
 					value: event.Value,
 				},
 			},
 		},
-		workflowID:  mockedWorkflowID,
-		executionID: mockedExecutionID,
+		workflowID:  e.workflowID,
+		executionID: executionID,
+		status:      statusStarted,
 	}
 
-	consensus := e.workflow.Consensus[0]
-	err := e.handleStep(ctx, ec, consensus)
+	err = e.orm.CreateExecution(ctx, es.toWorkflowExecution())
 	if err != nil {
-		e.logger.Errorf("error in handleConsensus %v", err)
+		e.logger.Errorf("failed to persist execution %s; not executing: %v", es.executionID, err)
 		return
 	}
 
-	for _, trg := range e.workflow.Targets {
-		err := e.handleStep(ctx, ec, trg)
-		if err != nil {
-			e.logger.Errorf("error in handleTargets %v", err)
-			return
-		}
+	e.runExecution(ctx, es)
+}
+
+// resumeUnfinishedExecutions picks up any executions of this workflow that were
+// interrupted, e.g. by a node restart, and runs their remaining steps.
+func (e *Engine) resumeUnfinishedExecutions(ctx context.Context) {
+	executions, err := e.orm.FindUnfinishedExecutions(ctx, e.workflowID)
+	if err != nil {
+		e.logger.Errorf("failed to load unfinished executions: %v", err)
+		return
+	}
+
+	for _, execution := range executions {
+		e.logger.Infow("resuming unfinished execution", "executionID", execution.ID)
+		es := newExecutionState(execution)
+		e.wg.Add(1)
+		go func() {
+			defer e.wg.Done()
+			e.runExecution(ctx, es)
+		}()
 	}
 }
 
-func (e *Engine) handleStep(ctx context.Context, es *executionState, node Capability) error {
-	stepState := &stepState{
-		outputs: &stepOutput{},
+// runExecution executes the remaining steps of an execution and records its status, unless the
+// engine is closed first, which leaves the execution unfinished so that it is resumed on restart.
+func (e *Engine) runExecution(ctx context.Context, es *executionState) {
+	es.status = statusCompleted
+	err := e.executeSteps(ctx, es)
+	if ctx.Err() != nil {
+		e.logger.Infow("engine closed; execution will be resumed on restart", "executionID", es.executionID)
+		return
+	}
+	if err != nil {
+		es.status = statusErrored
+		e.logger.Errorf("error executing workflow: %v", err)
 	}
-	es.steps[node.Ref] = stepState
 
-	// Let's get the capability. If we fail here, we'll bail out
-	// and try to handle it at the next execution.
-	cp, err := e.registry.Get(ctx, node.Type)
+	err = e.orm.UpdateExecutionStatus(ctx, es.executionID, es.status)
 	if err != nil {
-		return err
+		e.logger.Errorf("failed to update status of execution %s: %v", es.executionID, err)
+	}
+}
+
+type stepResult struct {
+	ref   string
+	state *stepState
+	err   error
+}
+
+// executeSteps runs the workflow's steps in topological order, executing
+// steps concurrently as soon as all of their dependencies have completed.
+// Steps that have already completed, e.g. before a restart, are not rerun.
+// Steps that depend (directly or transitively) on a trigger that did not
+// fire for this execution are skipped.
+// A failed step is handled according to its OnError policy. If it fails the
+// execution, no further steps are started and the error is returned once all
+// in-flight steps have completed.
+func (e *Engine) executeSteps(ctx context.Context, es *executionState) error {
+	skipped := map[string]bool{}
+	remaining := map[string]int{}
+	var ready []string
+	for _, ref := range e.workflow.order {
+		if ss, ok := es.steps[ref]; ok && ss.status == statusCompleted {
+			continue
+		}
+
+		s := e.workflow.steps[ref]
+		for _, d := range s.dependencies {
+			ds, ok := es.steps[d]
+			if skipped[d] || (e.workflow.isTrigger(d) && !ok) {
+				skipped[ref] = true
+				break
+			}
+			if !ok || ds.status != statusCompleted {
+				remaining[ref]++
+			}
+		}
+
+		if skipped[ref] {
+			e.logger.Debugw("skipping step: not all of its triggers fired", "ref", ref)
+			continue
+		}
+		if remaining[ref] == 0 {
+			ready = append(ready, ref)
+		}
+	}
+
+	results := make(chan stepResult)
+	inFlight := 0
+	var errs error
+	for {
+		// don't start any new steps once a step has failed the execution
+		if errs == nil {
+			for _, ref := range ready {
+				s := e.workflow.steps[ref]
+				ss, err := e.prepareStep(es, s)
+				es.steps[ref] = ss
+				e.persistStep(ctx, es, ref)
+
+				inFlight++
+				go func() {
+					// a step whose inputs could not be prepared is handled like any other failed step
+					if err == nil {
+						err = e.executeStep(ctx, es, s, ss)
+					}
+					results <- stepResult{ref: s.Ref, state: ss, err: err}
+				}()
+			}
+		}
+		ready = nil
+
+		if inFlight == 0 {
+			return errs
+		}
+
+		r := <-results
+		inFlight--
+		if ctx.Err() != nil {
+			// the engine is closing: the steps interrupted by it are rerun when the execution is resumed
+			continue
+		}
+		if r.err != nil {
+			s := e.workflow.steps[r.ref]
+			fallback := s.fallbackRef()
+			if fallback == "" {
+				r.state.status = statusErrored
+				r.state.outputs.err = r.err
+				e.persistStep(ctx, es, r.ref)
+				if s.OnError == onErrorContinue {
+					// the steps depending on this one are never ready, so the rest of the execution carries on without them
+					e.logger.Warnw("step failed; continuing without it", "ref", r.ref, "executionID", es.executionID, "err", r.err)
+				} else {
+					errs = errors.Join(errs, fmt.Errorf("step %s: %w", r.ref, r.err))
+				}
+				continue
+			}
+
+			e.logger.Warnw("step failed; using the outputs of its fallback", "ref", r.ref, "fallback", fallback, "executionID", es.executionID, "err", r.err)
+			r.state.outputs = &stepOutput{value: es.steps[fallback].outputs.value}
+		}
+
+		r.state.status = statusCompleted
+		e.persistStep(ctx, es, r.ref)
+		for _, d := range e.workflow.steps[r.ref].dependents {
+			if skipped[d] {
+				continue
+			}
+			remaining[d]--
+			if remaining[d] == 0 {
+				ready = append(ready, d)
+			}
+		}
+	}
+}
+
+// persistStep saves the current state of a step. Failing to persist a step
+// does not fail the execution; it only limits what can be recovered after a restart.
+func (e *Engine) persistStep(ctx context.Context, es *executionState, ref string) {
+	err := e.orm.UpsertStep(ctx, es.steps[ref].toWorkflowExecutionStep(es.executionID, ref))
+	if err != nil {
+		e.logger.Errorf("failed to persist step %s of execution %s: %v", ref, es.executionID, err)
 	}
+}
 
-	api, ok := cp.(capabilities.CallbackExecutable)
-	if !ok {
-		return fmt.Errorf("capability %s must be an action, consensus or target", node.Type)
+// prepareStep interpolates the inputs of a step against the current execution state.
+// It must not be called concurrently with updates to `es`.
+func (e *Engine) prepareStep(es *executionState, s *step) (*stepState, error) {
+	ss := &stepState{
+		status:  statusStarted,
+		outputs: &stepOutput{},
 	}
 
-	i, err := findAndInterpolateAllKeys(node.Inputs, es)
+	i, err := findAndInterpolateAllKeys(s.Inputs, es)
 	if err != nil {
-		return err
+		ss.status = statusErrored
+		ss.outputs.err = err
+		return ss, err
 	}
 
 	inputs, err := values.NewMap(i.(map[string]any))
 	if err != nil {
-		return err
+		ss.status = statusErrored
+		ss.outputs.err = err
+		return ss, err
 	}
 
-	stepState.inputs = inputs
+	ss.inputs = inputs
+	return ss, nil
+}
 
-	config, err := values.NewMap(node.Config)
+// executeStep calls the capability backing `s` with the inputs already resolved in `ss`,
+// retrying according to the step's retry settings, and records the output in `ss`.
+func (e *Engine) executeStep(ctx context.Context, es *executionState, s *step, ss *stepState) error {
+	config, err := values.NewMap(s.Config)
 	if err != nil {
+		ss.outputs.err = err
 		return err
 	}
 
 	tr := capabilities.CapabilityRequest{
-		Inputs: inputs,
+		Inputs: ss.inputs,
 		Config: config,
 		Metadata: capabilities.RequestMetadata{
 			WorkflowID:          es.workflowID,
@@ -232,106 +456,194 @@ func (e *Engine) handleStep(ctx context.Context, es *executionState, node Capabi
 		},
 	}
 
-	resp, err := capabilities.ExecuteSync(ctx, api, tr)
+	var resp *values.List
+	backoff := s.Retry.Backoff
+	for attempt := 0; ; attempt++ {
+		resp, err = e.executeAttempt(ctx, s, tr)
+		if err == nil || attempt >= s.Retry.Count || ctx.Err() != nil {
+			break
+		}
+
+		e.logger.Warnw("step failed; retrying", "ref", s.Ref, "executionID", es.executionID, "attempt", attempt+1, "backoff", backoff, "err", err)
+		select {
+		case <-ctx.Done():
+		case <-time.After(backoff):
+		}
+		backoff = min(2*backoff, maxStepBackoff)
+	}
 	if err != nil {
-		stepState.outputs.err = err
+		ss.outputs.err = err
 		return err
 	}
 
 	// `ExecuteSync` returns a `values.List` even if there was
 	// just one return value. If that is the case, let's unwrap the
 	// single value to make it easier to use in -- for example -- variable interpolation.
-	if len(resp.Underlying) > 1 {
-		stepState.outputs.value = resp
+	if len(resp.Underlying) == 1 {
+		ss.outputs.value = resp.Underlying[0]
 	} else {
-		stepState.outputs.value = resp.Underlying[0]
+		ss.outputs.value = resp
 	}
 	return nil
 }
 
+// executeAttempt calls the capability backing `s` once, bounded by the step's timeout, and
+// collects its responses like capabilities.ExecuteSync, which caps every call at its own timeout.
+func (e *Engine) executeAttempt(ctx context.Context, s *step, req capabilities.CapabilityRequest) (*values.List, error) {
+	timeout := s.Timeout
+	if timeout == 0 {
+		timeout = defaultStepTimeout
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	responseCh := make(chan capabilities.CapabilityResponse)
+	setupCh := make(chan error, 1)
+	go func() {
+		setupCh <- e.stepCapabilities[s.Ref].Execute(ctx, responseCh, req)
+	}()
+
+	var vs []values.Value
+	for {
+		select {
+		case resp, ok := <-responseCh:
+			if !ok {
+				if len(vs) == 0 {
+					return nil, errors.New("capability did not return any values")
+				}
+				return &values.List{Underlying: vs}, nil
+			}
+			if resp.Err != nil {
+				return nil, resp.Err
+			}
+			vs = append(vs, resp.Value)
+		case err := <-setupCh:
+			if err != nil {
+				return nil, err
+			}
+			setupCh = nil
+		case <-ctx.Done():
+			if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+				return nil, fmt.Errorf("step timed out after %s: %w", timeout, ctx.Err())
+			}
+			return nil, ctx.Err()
+		}
+	}
+}
+
 func (e *Engine) Close() error {
 	return e.StopOnce("Engine", func() error {
-		defer e.cancel()
+		e.cancel()
+		e.wg.Wait()
 
-		triggerInputs, err := values.NewMap(
-			map[string]any{
-				"triggerId": mockedTriggerID,
-			},
-		)
-		if err != nil {
-			return err
+		var errs error
+		for _, t := range e.triggers {
+			if !t.registered {
+				continue
+			}
+
+			triggerInputs, err := values.NewMap(
+				map[string]any{
+					"triggerId": t.triggerID,
+				},
+			)
+			if err != nil {
+				errs = errors.Join(errs, err)
+				continue
+			}
+			deregRequest := capabilities.CapabilityRequest{
+				Metadata: capabilities.RequestMetadata{
+					WorkflowID: e.workflowID,
+				},
+				Inputs: triggerInputs,
+			}
+			errs = errors.Join(errs, t.trigger.UnregisterTrigger(context.Background(), deregRequest))
 		}
-		deregRequest := capabilities.CapabilityRequest{
-			Metadata: capabilities.RequestMetadata{
-				WorkflowID: mockedWorkflowID,
-			},
-			Inputs: triggerInputs,
+
+		for _, s := range e.workflow.steps {
+			c, ok := e.stepCapabilities[s.Ref]
+			if !ok {
+				continue
+			}
+
+			cm, err := values.NewMap(s.Config)
+			if err != nil {
+				errs = errors.Join(errs, err)
+				continue
+			}
+			unreg := capabilities.UnregisterFromWorkflowRequest{
+				Metadata: capabilities.RegistrationMetadata{
+					WorkflowID: e.workflowID,
+				},
+				Config: cm,
+			}
+			errs = errors.Join(errs, c.UnregisterFromWorkflow(context.Background(), unreg))
 		}
-		return e.trigger.UnregisterTrigger(context.Background(), deregRequest)
+		return errs
 	})
 }
 
-func NewEngine(lggr logger.Logger, registry types.CapabilitiesRegistry) (engine *Engine, err error) {
-	yamlWorkflowSpec := `
-triggers:
-  - type: "on_mercury_report"
-    ref: report_data
-    config:
-      feedlist:
-        - "0x1111111111111111111100000000000000000000000000000000000000000000" # ETHUSD
-        - "0x2222222222222222222200000000000000000000000000000000000000000000" # LINKUSD
-        - "0x3333333333333333333300000000000000000000000000000000000000000000" # BTCUSD
-        
-consensus:
-  - type: "offchain_reporting"
-    ref: evm_median
-    inputs:
-      observations:
-        - $(report_data.outputs)
-    config:
-      aggregation_method: data_feeds_2_0
-      aggregation_config:
-        0x1111111111111111111100000000000000000000000000000000000000000000:
-          deviation: "0.001"
-          heartbeat: "30m"
-        0x2222222222222222222200000000000000000000000000000000000000000000:
-          deviation: "0.001"
-          heartbeat: "30m"
-        0x3333333333333333333300000000000000000000000000000000000000000000:
-          deviation: "0.001"
-          heartbeat: "30m"
-      encoder: EVM
-      encoder_config:
-        abi: "mercury_reports bytes[]"
-
-targets:
-  - type: write_polygon-testnet-mumbai
-    inputs:
-      report:
-        - $(evm_median.outputs.reports)
-    config:
-      address: "0x3F3554832c636721F1fD1822Ccca0354576741Ef"
-      params: [($inputs.report)]
-      abi: "receive(report bytes)"
-  - type: write_ethereum-testnet-sepolia
-    inputs:
-      report:
-        - $(evm_median.outputs.reports)
-    config:
-      address: "0x54e220867af6683aE6DcBF535B4f952cB5116510"
-      params: ["$(inputs.report)"]
-      abi: "receive(report bytes)"
-`
-
-	workflow, err := Parse(yamlWorkflowSpec)
+type Config struct {
+	Lggr          logger.Logger
+	Registry      types.CapabilitiesRegistry
+	ORM           ORM
+	Workflow      string
+	WorkflowID    string
+	WorkflowOwner string
+}
+
+func NewEngine(cfg Config) (engine *Engine, err error) {
+	workflow, err := Parse(cfg.Workflow)
 	if err != nil {
 		return nil, err
 	}
 	engine = &Engine{
-		logger:     lggr.Named("WorkflowEngine"),
-		registry:   registry,
-		workflow:   workflow,
-		callbackCh: make(chan capabilities.CapabilityResponse),
+		logger:        cfg.Lggr.Named("WorkflowEngine").With("workflowID", cfg.WorkflowID),
+		registry:      cfg.Registry,
+		orm:           cfg.ORM,
+		workflow:      workflow,
+		workflowID:    cfg.WorkflowID,
+		workflowOwner: cfg.WorkflowOwner,
 	}
 	return engine, nil
 }
+
+// generateTriggerID derives the ID a workflow registers its trigger at index idx with.
+func generateTriggerID(workflowID string, idx int) string {
+	return fmt.Sprintf("wf_%s_trigger_%d", workflowID, idx)
+}
+
+// generateExecutionID deterministically derives the ID of an execution from the
+// workflow ID and the trigger event that started it, so that every node assigns
+// the same ID to the same execution and a repeated event is not executed twice.
+func generateExecutionID(workflowID string, event values.Value) (string, error) {
+	eventID, err := triggerEventID(event)
+	if err != nil {
+		return "", err
+	}
+
+	h := sha256.New()
+	h.Write([]byte(workflowID))
+	h.Write([]byte(eventID))
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// triggerEventID returns the `ID` of a trigger event (see mercury.TriggerEvent).
+// Events which carry no ID are identified by a hash of their contents.
+func triggerEventID(event values.Value) (string, error) {
+	if m, ok := event.(*values.Map); ok {
+		if id, ok := m.Underlying["ID"].(*values.String); ok && id.Underlying != "" {
+			return id.Underlying, nil
+		}
+	}
+
+	if event == nil {
+		return "", errors.New("trigger event has no value")
+	}
+	b, err := proto.MarshalOptions{Deterministic: true}.Marshal(values.Proto(event))
+	if err != nil {
+		return "", fmt.Errorf("failed to hash trigger event: %w", err)
+	}
+	sum := sha256.Sum256(b)
+	return hex.EncodeToString(sum[:]), nil
+}
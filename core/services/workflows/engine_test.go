@@ -2,6 +2,10 @@ package workflows
 
 import (
 	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"sync"
 	"testing"
 
 	"github.com/shopspring/decimal"
@@ -66,6 +70,72 @@ func (m *mockTriggerCapability) UnregisterTrigger(ctx context.Context, req capab
 	return nil
 }
 
+const hardcodedWorkflow = `
+triggers:
+  - type: "on_mercury_report"
+    ref: report_data
+    config:
+      feedlist:
+        - "0x1111111111111111111100000000000000000000000000000000000000000000" # ETHUSD
+        - "0x2222222222222222222200000000000000000000000000000000000000000000" # LINKUSD
+        - "0x3333333333333333333300000000000000000000000000000000000000000000" # BTCUSD
+        
+consensus:
+  - type: "offchain_reporting"
+    ref: evm_median
+    inputs:
+      observations:
+        - $(report_data.outputs)
+    config:
+      aggregation_method: data_feeds_2_0
+      aggregation_config:
+        0x1111111111111111111100000000000000000000000000000000000000000000:
+          deviation: "0.001"
+          heartbeat: "30m"
+        0x2222222222222222222200000000000000000000000000000000000000000000:
+          deviation: "0.001"
+          heartbeat: "30m"
+        0x3333333333333333333300000000000000000000000000000000000000000000:
+          deviation: "0.001"
+          heartbeat: "30m"
+      encoder: EVM
+      encoder_config:
+        abi: "mercury_reports bytes[]"
+
+targets:
+  - type: write_polygon-testnet-mumbai
+    inputs:
+      report:
+        - $(evm_median.outputs.reports)
+    config:
+      address: "0x3F3554832c636721F1fD1822Ccca0354576741Ef"
+      params: [($inputs.report)]
+      abi: "receive(report bytes)"
+  - type: write_ethereum-testnet-sepolia
+    inputs:
+      report:
+        - $(evm_median.outputs.reports)
+    config:
+      address: "0x54e220867af6683aE6DcBF535B4f952cB5116510"
+      params: ["$(inputs.report)"]
+      abi: "receive(report bytes)"
+`
+
+const testWorkflowOwner = "00000000000000000000000000000000000000aa"
+
+func newTestEngine(t *testing.T, reg *coreCap.Registry, orm ORM, workflow string) *Engine {
+	eng, err := NewEngine(Config{
+		Lggr:          logger.TestLogger(t),
+		Registry:      reg,
+		ORM:           orm,
+		Workflow:      workflow,
+		WorkflowID:    generateWorkflowID(testWorkflowOwner, workflow),
+		WorkflowOwner: testWorkflowOwner,
+	})
+	require.NoError(t, err)
+	return eng
+}
+
 func TestEngineWithHardcodedWorkflow(t *testing.T) {
 	ctx := testutils.Context(t)
 	reg := coreCap.NewRegistry(logger.TestLogger(t))
@@ -137,9 +207,7 @@ func TestEngineWithHardcodedWorkflow(t *testing.T) {
 	)
 	require.NoError(t, reg.Add(ctx, target2))
 
-	lggr := logger.TestLogger(t)
-	eng, err := NewEngine(lggr, reg)
-	require.NoError(t, err)
+	eng := newTestEngine(t, reg, newTestORM(), hardcodedWorkflow)
 
 	resp, err := values.NewMap(map[string]any{
 		"123": decimal.NewFromFloat(1.00),
@@ -158,3 +226,591 @@ func TestEngineWithHardcodedWorkflow(t *testing.T) {
 	assert.Equal(t, cr, <-target1.response)
 	assert.Equal(t, cr, <-target2.response)
 }
+
+const dagWorkflow = `
+triggers:
+  - type: "on_mercury_report"
+    ref: report_data
+  - type: "on_cron"
+    ref: cron
+
+actions:
+  - type: "read_chain_a"
+    ref: read_a
+    inputs:
+      feeds: $(report_data.outputs)
+  - type: "read_chain_b"
+    ref: read_b
+    inputs:
+      feeds: $(report_data.outputs)
+  - type: "heartbeat"
+    ref: heartbeat
+    inputs:
+      tick: $(cron.outputs)
+
+consensus:
+  - type: "offchain_reporting"
+    ref: evm_median
+    inputs:
+      observations:
+        - $(read_a.outputs)
+        - $(read_b.outputs)
+
+targets:
+  - type: "write_chain_a"
+    inputs:
+      report:
+        - $(evm_median.outputs.reports)
+`
+
+func TestEngine_ExecutesDAG(t *testing.T) {
+	ctx := testutils.Context(t)
+	reg := coreCap.NewRegistry(logger.TestLogger(t))
+
+	resp, err := values.NewMap(map[string]any{
+		"123": decimal.NewFromFloat(1.00),
+	})
+	require.NoError(t, err)
+	cr := capabilities.CapabilityResponse{
+		Value: resp,
+	}
+
+	mercuryTrigger := &mockTriggerCapability{
+		CapabilityInfo: capabilities.MustNewCapabilityInfo(
+			"on_mercury_report",
+			capabilities.CapabilityTypeTrigger,
+			"issues a trigger when a mercury report is received.",
+			"v1.0.0",
+		),
+		triggerEvent: cr,
+	}
+	require.NoError(t, reg.Add(ctx, mercuryTrigger))
+
+	// the cron trigger never fires, so the heartbeat action must not execute.
+	cronTrigger := &mockSilentTriggerCapability{
+		CapabilityInfo: capabilities.MustNewCapabilityInfo(
+			"on_cron",
+			capabilities.CapabilityTypeTrigger,
+			"issues a trigger on a schedule.",
+			"v1.0.0",
+		),
+	}
+	require.NoError(t, reg.Add(ctx, cronTrigger))
+
+	passthrough := func(req capabilities.CapabilityRequest) (capabilities.CapabilityResponse, error) {
+		return capabilities.CapabilityResponse{
+			Value: req.Inputs.Underlying["feeds"],
+		}, nil
+	}
+	// readA only returns once readB has started, proving independent steps run concurrently.
+	readBStarted := make(chan struct{})
+	readA := newMockCapability(
+		capabilities.MustNewCapabilityInfo("read_chain_a", capabilities.CapabilityTypeAction, "a read action", "v1.0.0"),
+		func(req capabilities.CapabilityRequest) (capabilities.CapabilityResponse, error) {
+			<-readBStarted
+			return passthrough(req)
+		},
+	)
+	require.NoError(t, reg.Add(ctx, readA))
+	readB := newMockCapability(
+		capabilities.MustNewCapabilityInfo("read_chain_b", capabilities.CapabilityTypeAction, "a read action", "v1.0.0"),
+		func(req capabilities.CapabilityRequest) (capabilities.CapabilityResponse, error) {
+			close(readBStarted)
+			return passthrough(req)
+		},
+	)
+	require.NoError(t, reg.Add(ctx, readB))
+	heartbeat := newMockCapability(
+		capabilities.MustNewCapabilityInfo("heartbeat", capabilities.CapabilityTypeAction, "a heartbeat action", "v1.0.0"),
+		func(req capabilities.CapabilityRequest) (capabilities.CapabilityResponse, error) {
+			return capabilities.CapabilityResponse{}, nil
+		},
+	)
+	require.NoError(t, reg.Add(ctx, heartbeat))
+
+	consensus := newMockCapability(
+		capabilities.MustNewCapabilityInfo("offchain_reporting", capabilities.CapabilityTypeConsensus, "an ocr3 consensus capability", "v3.0.0"),
+		func(req capabilities.CapabilityRequest) (capabilities.CapabilityResponse, error) {
+			obs := req.Inputs.Underlying["observations"].(*values.List)
+			rv, err := values.NewMap(map[string]any{
+				"reports": obs.Underlying[1],
+			})
+			if err != nil {
+				return capabilities.CapabilityResponse{}, err
+			}
+			return capabilities.CapabilityResponse{Value: rv}, nil
+		},
+	)
+	require.NoError(t, reg.Add(ctx, consensus))
+
+	target := newMockCapability(
+		capabilities.MustNewCapabilityInfo("write_chain_a", capabilities.CapabilityTypeTarget, "a write target", "v1.0.0"),
+		func(req capabilities.CapabilityRequest) (capabilities.CapabilityResponse, error) {
+			list := req.Inputs.Underlying["report"].(*values.List)
+			return capabilities.CapabilityResponse{
+				Value: list.Underlying[0],
+			}, nil
+		},
+	)
+	require.NoError(t, reg.Add(ctx, target))
+
+	eng := newTestEngine(t, reg, newTestORM(), dagWorkflow)
+
+	require.NoError(t, eng.Start(ctx))
+	defer eng.Close()
+
+	assert.Equal(t, cr, <-readA.response)
+	assert.Equal(t, cr, <-readB.response)
+	assert.Equal(t, cr, <-target.response)
+	assert.Empty(t, heartbeat.response)
+}
+
+type mockSilentTriggerCapability struct {
+	capabilities.CapabilityInfo
+}
+
+var _ capabilities.TriggerCapability = (*mockSilentTriggerCapability)(nil)
+
+func (m *mockSilentTriggerCapability) RegisterTrigger(ctx context.Context, ch chan<- capabilities.CapabilityResponse, req capabilities.CapabilityRequest) error {
+	return nil
+}
+
+func (m *mockSilentTriggerCapability) UnregisterTrigger(ctx context.Context, req capabilities.CapabilityRequest) error {
+	return nil
+}
+
+func TestEngine_ResumesUnfinishedExecutions(t *testing.T) {
+	ctx := testutils.Context(t)
+	reg := coreCap.NewRegistry(logger.TestLogger(t))
+
+	trigger := &mockSilentTriggerCapability{
+		CapabilityInfo: capabilities.MustNewCapabilityInfo(
+			"on_mercury_report",
+			capabilities.CapabilityTypeTrigger,
+			"issues a trigger when a mercury report is received.",
+			"v1.0.0",
+		),
+	}
+	require.NoError(t, reg.Add(ctx, trigger))
+
+	// consensus already completed before the restart, so it must not be executed again.
+	consensus := newMockCapability(
+		capabilities.MustNewCapabilityInfo("offchain_reporting", capabilities.CapabilityTypeConsensus, "an ocr3 consensus capability", "v3.0.0"),
+		func(req capabilities.CapabilityRequest) (capabilities.CapabilityResponse, error) {
+			return capabilities.CapabilityResponse{}, nil
+		},
+	)
+	require.NoError(t, reg.Add(ctx, consensus))
+
+	newTarget := func(id string) *mockCapability {
+		target := newMockCapability(
+			capabilities.MustNewCapabilityInfo(id, capabilities.CapabilityTypeTarget, "a write target", "v1.0.0"),
+			func(req capabilities.CapabilityRequest) (capabilities.CapabilityResponse, error) {
+				list := req.Inputs.Underlying["report"].(*values.List)
+				return capabilities.CapabilityResponse{
+					Value: list.Underlying[0],
+				}, nil
+			},
+		)
+		require.NoError(t, reg.Add(ctx, target))
+		return target
+	}
+	target1 := newTarget("write_polygon-testnet-mumbai")
+	target2 := newTarget("write_ethereum-testnet-sepolia")
+
+	report := values.NewString("<a report>")
+	consensusOutput, err := values.NewMap(map[string]any{"reports": "<a report>"})
+	require.NoError(t, err)
+
+	orm := newTestORM()
+	require.NoError(t, orm.CreateExecution(ctx, &WorkflowExecution{
+		ID:         "an-execution",
+		WorkflowID: generateWorkflowID(testWorkflowOwner, hardcodedWorkflow),
+		Status:     statusStarted,
+		Steps: map[string]*WorkflowExecutionStep{
+			"report_data": {Ref: "report_data", Status: statusCompleted},
+			"evm_median":  {Ref: "evm_median", Status: statusCompleted, OutputValue: consensusOutput},
+			// this target was in-flight when the node stopped
+			"write_polygon-testnet-mumbai_0": {Ref: "write_polygon-testnet-mumbai_0", Status: statusStarted},
+		},
+	}))
+
+	eng := newTestEngine(t, reg, orm, hardcodedWorkflow)
+	require.NoError(t, eng.Start(ctx))
+	defer eng.Close()
+
+	assert.Equal(t, report, (<-target1.response).Value)
+	assert.Equal(t, report, (<-target2.response).Value)
+	assert.Empty(t, consensus.response)
+
+	require.Eventually(t, func() bool {
+		execution, err := orm.FindExecution(ctx, "an-execution")
+		require.NoError(t, err)
+		return execution.Status == statusCompleted
+	}, testutils.WaitTimeout(t), testutils.TestInterval)
+
+	execution, err := orm.FindExecution(ctx, "an-execution")
+	require.NoError(t, err)
+	for _, ref := range []string{"write_polygon-testnet-mumbai_0", "write_ethereum-testnet-sepolia_1"} {
+		assert.Equal(t, statusCompleted, execution.Steps[ref].Status)
+		assert.Equal(t, report, execution.Steps[ref].OutputValue)
+	}
+}
+
+type mockBlockingCapability struct {
+	capabilities.CapabilityInfo
+	capabilities.CallbackExecutable
+	started chan struct{}
+}
+
+func (m *mockBlockingCapability) Execute(ctx context.Context, ch chan<- capabilities.CapabilityResponse, req capabilities.CapabilityRequest) error {
+	close(m.started)
+	<-ctx.Done()
+	return ctx.Err()
+}
+
+func (m *mockBlockingCapability) RegisterToWorkflow(ctx context.Context, request capabilities.RegisterToWorkflowRequest) error {
+	return nil
+}
+
+func (m *mockBlockingCapability) UnregisterFromWorkflow(ctx context.Context, request capabilities.UnregisterFromWorkflowRequest) error {
+	return nil
+}
+
+func TestEngine_CloseStopsExecutions(t *testing.T) {
+	ctx := testutils.Context(t)
+	reg := coreCap.NewRegistry(logger.TestLogger(t))
+
+	trigger := &mockSilentTriggerCapability{
+		CapabilityInfo: capabilities.MustNewCapabilityInfo(
+			"on_mercury_report",
+			capabilities.CapabilityTypeTrigger,
+			"issues a trigger when a mercury report is received.",
+			"v1.0.0",
+		),
+	}
+	require.NoError(t, reg.Add(ctx, trigger))
+
+	consensus := newMockCapability(
+		capabilities.MustNewCapabilityInfo("offchain_reporting", capabilities.CapabilityTypeConsensus, "an ocr3 consensus capability", "v3.0.0"),
+		func(req capabilities.CapabilityRequest) (capabilities.CapabilityResponse, error) {
+			return capabilities.CapabilityResponse{}, nil
+		},
+	)
+	require.NoError(t, reg.Add(ctx, consensus))
+
+	// both targets only return once the engine is closed
+	newTarget := func(id string) *mockBlockingCapability {
+		target := &mockBlockingCapability{
+			CapabilityInfo: capabilities.MustNewCapabilityInfo(id, capabilities.CapabilityTypeTarget, "a write target", "v1.0.0"),
+			started:        make(chan struct{}),
+		}
+		require.NoError(t, reg.Add(ctx, target))
+		return target
+	}
+	target1 := newTarget("write_polygon-testnet-mumbai")
+	target2 := newTarget("write_ethereum-testnet-sepolia")
+
+	consensusOutput, err := values.NewMap(map[string]any{"reports": "<a report>"})
+	require.NoError(t, err)
+
+	orm := newTestORM()
+	require.NoError(t, orm.CreateExecution(ctx, &WorkflowExecution{
+		ID:         "an-execution",
+		WorkflowID: generateWorkflowID(testWorkflowOwner, hardcodedWorkflow),
+		Status:     statusStarted,
+		Steps: map[string]*WorkflowExecutionStep{
+			"report_data": {Ref: "report_data", Status: statusCompleted},
+			"evm_median":  {Ref: "evm_median", Status: statusCompleted, OutputValue: consensusOutput},
+		},
+	}))
+
+	eng := newTestEngine(t, reg, orm, hardcodedWorkflow)
+	require.NoError(t, eng.Start(ctx))
+	<-target1.started
+	<-target2.started
+	require.NoError(t, eng.Close())
+
+	// the interrupted execution is left unfinished, to be resumed on restart
+	execution, err := orm.FindExecution(ctx, "an-execution")
+	require.NoError(t, err)
+	assert.Equal(t, statusStarted, execution.Status)
+	for _, ref := range []string{"write_polygon-testnet-mumbai_0", "write_ethereum-testnet-sepolia_1"} {
+		assert.Equal(t, statusStarted, execution.Steps[ref].Status)
+	}
+}
+
+const errorPolicyWorkflow = `
+triggers:
+  - type: "on_mercury_report"
+    ref: report_data
+
+actions:
+  - type: "flaky_read"
+    ref: read
+    retry:
+      count: 2
+      backoff: 10ms
+    inputs:
+      feeds: $(report_data.outputs)
+  - type: "failing_read"
+    ref: read_fallback
+    onError: report_data
+    inputs:
+      feeds: $(report_data.outputs)
+
+consensus:
+  - type: "offchain_reporting"
+    ref: evm_median
+    inputs:
+      observations:
+        - $(read.outputs)
+        - $(read_fallback.outputs)
+
+targets:
+  - type: "write_chain_a"
+    timeout: 100ms
+    onError: continue
+    inputs:
+      report: $(evm_median.outputs.reports)
+  - type: "write_chain_b"
+    onError: continue
+    retry:
+      count: 1
+    inputs:
+      report: $(evm_median.outputs.reports)
+  - type: "write_chain_c"
+    inputs:
+      report: $(evm_median.outputs.reports)
+`
+
+func TestEngine_StepErrorPolicies(t *testing.T) {
+	ctx := testutils.Context(t)
+	reg := coreCap.NewRegistry(logger.TestLogger(t))
+
+	resp, err := values.NewMap(map[string]any{
+		"123": decimal.NewFromFloat(1.00),
+	})
+	require.NoError(t, err)
+	cr := capabilities.CapabilityResponse{
+		Value: resp,
+	}
+
+	trigger := &mockTriggerCapability{
+		CapabilityInfo: capabilities.MustNewCapabilityInfo(
+			"on_mercury_report",
+			capabilities.CapabilityTypeTrigger,
+			"issues a trigger when a mercury report is received.",
+			"v1.0.0",
+		),
+		triggerEvent: cr,
+	}
+	require.NoError(t, reg.Add(ctx, trigger))
+
+	// flakyRead fails twice before succeeding on its last retry.
+	var attempts int
+	flakyRead := newMockCapability(
+		capabilities.MustNewCapabilityInfo("flaky_read", capabilities.CapabilityTypeAction, "a read action", "v1.0.0"),
+		func(req capabilities.CapabilityRequest) (capabilities.CapabilityResponse, error) {
+			attempts++
+			if attempts < 3 {
+				return capabilities.CapabilityResponse{}, fmt.Errorf("attempt %d failed", attempts)
+			}
+			return capabilities.CapabilityResponse{Value: req.Inputs.Underlying["feeds"]}, nil
+		},
+	)
+	require.NoError(t, reg.Add(ctx, flakyRead))
+	failingRead := newMockCapability(
+		capabilities.MustNewCapabilityInfo("failing_read", capabilities.CapabilityTypeAction, "a read action", "v1.0.0"),
+		func(req capabilities.CapabilityRequest) (capabilities.CapabilityResponse, error) {
+			return capabilities.CapabilityResponse{}, errors.New("read failed")
+		},
+	)
+	require.NoError(t, reg.Add(ctx, failingRead))
+
+	var observations *values.List
+	consensus := newMockCapability(
+		capabilities.MustNewCapabilityInfo("offchain_reporting", capabilities.CapabilityTypeConsensus, "an ocr3 consensus capability", "v3.0.0"),
+		func(req capabilities.CapabilityRequest) (capabilities.CapabilityResponse, error) {
+			observations = req.Inputs.Underlying["observations"].(*values.List)
+			rv, err := values.NewMap(map[string]any{"reports": "<a report>"})
+			if err != nil {
+				return capabilities.CapabilityResponse{}, err
+			}
+			return capabilities.CapabilityResponse{Value: rv}, nil
+		},
+	)
+	require.NoError(t, reg.Add(ctx, consensus))
+
+	unblock := make(chan struct{})
+	t.Cleanup(func() { close(unblock) })
+	hangingTarget := newMockCapability(
+		capabilities.MustNewCapabilityInfo("write_chain_a", capabilities.CapabilityTypeTarget, "a write target", "v1.0.0"),
+		func(req capabilities.CapabilityRequest) (capabilities.CapabilityResponse, error) {
+			<-unblock
+			return capabilities.CapabilityResponse{}, errors.New("unblocked")
+		},
+	)
+	require.NoError(t, reg.Add(ctx, hangingTarget))
+	failingTarget := newMockCapability(
+		capabilities.MustNewCapabilityInfo("write_chain_b", capabilities.CapabilityTypeTarget, "a write target", "v1.0.0"),
+		func(req capabilities.CapabilityRequest) (capabilities.CapabilityResponse, error) {
+			return capabilities.CapabilityResponse{}, errors.New("write failed")
+		},
+	)
+	require.NoError(t, reg.Add(ctx, failingTarget))
+	target := newMockCapability(
+		capabilities.MustNewCapabilityInfo("write_chain_c", capabilities.CapabilityTypeTarget, "a write target", "v1.0.0"),
+		func(req capabilities.CapabilityRequest) (capabilities.CapabilityResponse, error) {
+			return capabilities.CapabilityResponse{Value: req.Inputs.Underlying["report"]}, nil
+		},
+	)
+	require.NoError(t, reg.Add(ctx, target))
+
+	orm := newTestORM()
+	eng := newTestEngine(t, reg, orm, errorPolicyWorkflow)
+	require.NoError(t, eng.Start(ctx))
+	defer eng.Close()
+
+	assert.Equal(t, values.NewString("<a report>"), (<-target.response).Value)
+
+	var execution *WorkflowExecution
+	require.Eventually(t, func() bool {
+		orm.mu.Lock()
+		defer orm.mu.Unlock()
+		for _, ex := range orm.executions {
+			execution = orm.copyExecution(ex)
+		}
+		return execution != nil && execution.Status == statusCompleted
+	}, testutils.WaitTimeout(t), testutils.TestInterval)
+
+	assert.Equal(t, 3, attempts)
+	// the failed read was replaced by the outputs of the trigger
+	require.NotNil(t, observations)
+	assert.Equal(t, []values.Value{resp, resp}, observations.Underlying)
+	assert.Equal(t, statusCompleted, execution.Steps["read_fallback"].Status)
+
+	assert.Equal(t, statusErrored, execution.Steps["write_chain_a_0"].Status)
+	assert.ErrorContains(t, execution.Steps["write_chain_a_0"].OutputErr, "step timed out after 100ms")
+	assert.Equal(t, statusErrored, execution.Steps["write_chain_b_1"].Status)
+	assert.EqualError(t, execution.Steps["write_chain_b_1"].OutputErr, "write failed")
+	assert.Equal(t, statusCompleted, execution.Steps["write_chain_c_2"].Status)
+}
+
+// testORM is an in-memory ORM.
+type testORM struct {
+	mu         sync.Mutex
+	executions map[string]*WorkflowExecution
+}
+
+var _ ORM = (*testORM)(nil)
+
+func newTestORM() *testORM {
+	return &testORM{executions: map[string]*WorkflowExecution{}}
+}
+
+func (o *testORM) CreateExecution(ctx context.Context, execution *WorkflowExecution) error {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	if _, ok := o.executions[execution.ID]; ok {
+		return fmt.Errorf("execution %s already exists", execution.ID)
+	}
+	ex := *execution
+	ex.Steps = map[string]*WorkflowExecutionStep{}
+	for ref, s := range execution.Steps {
+		step := *s
+		step.ExecutionID = ex.ID
+		ex.Steps[ref] = &step
+	}
+	o.executions[ex.ID] = &ex
+	return nil
+}
+
+func (o *testORM) UpsertStep(ctx context.Context, step *WorkflowExecutionStep) error {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	ex, ok := o.executions[step.ExecutionID]
+	if !ok {
+		return sql.ErrNoRows
+	}
+	s := *step
+	ex.Steps[s.Ref] = &s
+	return nil
+}
+
+func (o *testORM) UpdateExecutionStatus(ctx context.Context, executionID string, status string) error {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	ex, ok := o.executions[executionID]
+	if !ok {
+		return sql.ErrNoRows
+	}
+	ex.Status = status
+	return nil
+}
+
+func (o *testORM) FindExecution(ctx context.Context, executionID string) (*WorkflowExecution, error) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	ex, ok := o.executions[executionID]
+	if !ok {
+		return nil, sql.ErrNoRows
+	}
+	return o.copyExecution(ex), nil
+}
+
+func (o *testORM) FindUnfinishedExecutions(ctx context.Context, workflowID string) ([]*WorkflowExecution, error) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	var executions []*WorkflowExecution
+	for _, ex := range o.executions {
+		if ex.WorkflowID == workflowID && !isFinalStatus(ex.Status) {
+			executions = append(executions, o.copyExecution(ex))
+		}
+	}
+	return executions, nil
+}
+
+func (o *testORM) copyExecution(ex *WorkflowExecution) *WorkflowExecution {
+	c := *ex
+	c.Steps = map[string]*WorkflowExecutionStep{}
+	for ref, s := range ex.Steps {
+		step := *s
+		c.Steps[ref] = &step
+	}
+	return &c
+}
+
+func TestGenerateExecutionID(t *testing.T) {
+	withID, err := values.NewMap(map[string]any{"ID": "an-event", "Payload": "a"})
+	require.NoError(t, err)
+	sameID, err := values.NewMap(map[string]any{"ID": "an-event", "Payload": "b"})
+	require.NoError(t, err)
+	withoutID, err := values.NewMap(map[string]any{"Payload": "a"})
+	require.NoError(t, err)
+
+	id1, err := generateExecutionID("a-workflow", withID)
+	require.NoError(t, err)
+	assert.Len(t, id1, 64)
+
+	// the same trigger event yields the same execution
+	id2, err := generateExecutionID("a-workflow", sameID)
+	require.NoError(t, err)
+	assert.Equal(t, id1, id2)
+
+	// ...but not across workflows
+	id3, err := generateExecutionID("another-workflow", withID)
+	require.NoError(t, err)
+	assert.NotEqual(t, id1, id3)
+
+	// events without an ID are identified by their contents
+	id4, err := generateExecutionID("a-workflow", withoutID)
+	require.NoError(t, err)
+	id5, err := generateExecutionID("a-workflow", withoutID)
+	require.NoError(t, err)
+	assert.Equal(t, id4, id5)
+	assert.NotEqual(t, id1, id4)
+
+	_, err = generateExecutionID("a-workflow", nil)
+	assert.Error(t, err)
+}
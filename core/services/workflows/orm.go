@@ -0,0 +1,278 @@
+package workflows
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/sqlutil"
+	"github.com/smartcontractkit/chainlink-common/pkg/values"
+	"github.com/smartcontractkit/chainlink-common/pkg/values/pb"
+)
+
+// ORM persists workflow executions and the state of their steps, so that
+// in-flight executions can be resumed after a node restart.
+type ORM interface {
+	// CreateExecution persists a new execution along with any steps already present on it.
+	CreateExecution(ctx context.Context, execution *WorkflowExecution) error
+	// UpsertStep creates or updates the state of a single step of an execution.
+	UpsertStep(ctx context.Context, step *WorkflowExecutionStep) error
+	// UpdateExecutionStatus sets the status of an execution, marking it as finished if the status is final.
+	UpdateExecutionStatus(ctx context.Context, executionID string, status string) error
+	// FindExecution returns the execution with the given ID along with all of its steps.
+	FindExecution(ctx context.Context, executionID string) (*WorkflowExecution, error)
+	// FindUnfinishedExecutions returns all executions of a workflow that have not completed or errored.
+	FindUnfinishedExecutions(ctx context.Context, workflowID string) ([]*WorkflowExecution, error)
+}
+
+// WorkflowExecution is the persisted state of a single run of a workflow.
+type WorkflowExecution struct {
+	ID         string
+	WorkflowID string
+	Status     string
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+	FinishedAt *time.Time
+	// Steps are keyed by ref and include the trigger that started the execution.
+	Steps map[string]*WorkflowExecutionStep
+}
+
+// WorkflowExecutionStep is the persisted state of a single step of a WorkflowExecution.
+type WorkflowExecutionStep struct {
+	ExecutionID string
+	Ref         string
+	Status      string
+	Inputs      *values.Map
+	OutputValue values.Value
+	OutputErr   error
+	UpdatedAt   time.Time
+}
+
+type orm struct {
+	ds sqlutil.DataSource
+}
+
+var _ ORM = (*orm)(nil)
+
+func NewORM(ds sqlutil.DataSource) ORM {
+	return &orm{ds: ds}
+}
+
+func (o *orm) transact(ctx context.Context, fn func(*orm) error) error {
+	return sqlutil.Transact(ctx, func(ds sqlutil.DataSource) *orm { return &orm{ds: ds} }, o.ds, nil, fn)
+}
+
+func (o *orm) CreateExecution(ctx context.Context, execution *WorkflowExecution) error {
+	return o.transact(ctx, func(tx *orm) error {
+		stmt := `INSERT INTO workflow_executions (id, workflow_id, status, created_at, updated_at)
+			VALUES ($1, $2, $3, NOW(), NOW())
+			RETURNING created_at, updated_at`
+		err := tx.ds.QueryRowxContext(ctx, stmt, execution.ID, execution.WorkflowID, execution.Status).
+			Scan(&execution.CreatedAt, &execution.UpdatedAt)
+		if err != nil {
+			return fmt.Errorf("failed to create workflow execution %s: %w", execution.ID, err)
+		}
+
+		for _, step := range execution.Steps {
+			step.ExecutionID = execution.ID
+			if err = tx.UpsertStep(ctx, step); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func (o *orm) UpsertStep(ctx context.Context, step *WorkflowExecutionStep) error {
+	row, err := newStepRow(step)
+	if err != nil {
+		return fmt.Errorf("failed to serialize step %s of workflow execution %s: %w", step.Ref, step.ExecutionID, err)
+	}
+
+	stmt := `INSERT INTO workflow_steps (workflow_execution_id, ref, status, inputs, output_value, output_err, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, NOW())
+		ON CONFLICT (workflow_execution_id, ref) DO UPDATE SET
+			status = EXCLUDED.status,
+			inputs = EXCLUDED.inputs,
+			output_value = EXCLUDED.output_value,
+			output_err = EXCLUDED.output_err,
+			updated_at = EXCLUDED.updated_at
+		RETURNING updated_at`
+	err = o.ds.QueryRowxContext(ctx, stmt, row.ExecutionID, row.Ref, row.Status, row.Inputs, row.OutputValue, row.OutputErr).
+		Scan(&step.UpdatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to upsert step %s of workflow execution %s: %w", step.Ref, step.ExecutionID, err)
+	}
+	return nil
+}
+
+func (o *orm) UpdateExecutionStatus(ctx context.Context, executionID string, status string) error {
+	var finishedAt *time.Time
+	if isFinalStatus(status) {
+		now := time.Now()
+		finishedAt = &now
+	}
+
+	stmt := `UPDATE workflow_executions SET status = $2, updated_at = NOW(), finished_at = $3 WHERE id = $1`
+	res, err := o.ds.ExecContext(ctx, stmt, executionID, status, finishedAt)
+	if err != nil {
+		return fmt.Errorf("failed to update status of workflow execution %s: %w", executionID, err)
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return fmt.Errorf("failed to update status of workflow execution %s: %w", executionID, sql.ErrNoRows)
+	}
+	return nil
+}
+
+func (o *orm) FindExecution(ctx context.Context, executionID string) (*WorkflowExecution, error) {
+	var row executionRow
+	stmt := `SELECT * FROM workflow_executions WHERE id = $1`
+	if err := o.ds.GetContext(ctx, &row, stmt, executionID); err != nil {
+		return nil, fmt.Errorf("failed to find workflow execution %s: %w", executionID, err)
+	}
+
+	executions, err := o.withSteps(ctx, []executionRow{row})
+	if err != nil {
+		return nil, err
+	}
+	return executions[0], nil
+}
+
+func (o *orm) FindUnfinishedExecutions(ctx context.Context, workflowID string) ([]*WorkflowExecution, error) {
+	var rows []executionRow
+	stmt := `SELECT * FROM workflow_executions WHERE workflow_id = $1 AND status = $2 ORDER BY created_at ASC`
+	if err := o.ds.SelectContext(ctx, &rows, stmt, workflowID, statusStarted); err != nil {
+		return nil, fmt.Errorf("failed to find unfinished executions of workflow %s: %w", workflowID, err)
+	}
+
+	return o.withSteps(ctx, rows)
+}
+
+// withSteps loads the steps of each of the given executions.
+func (o *orm) withSteps(ctx context.Context, rows []executionRow) ([]*WorkflowExecution, error) {
+	executions := make([]*WorkflowExecution, len(rows))
+	for i, row := range rows {
+		var stepRows []stepRow
+		stmt := `SELECT workflow_execution_id, ref, status, inputs, output_value, output_err, updated_at
+			FROM workflow_steps WHERE workflow_execution_id = $1`
+		if err := o.ds.SelectContext(ctx, &stepRows, stmt, row.ID); err != nil {
+			return nil, fmt.Errorf("failed to load steps of workflow execution %s: %w", row.ID, err)
+		}
+
+		execution := &WorkflowExecution{
+			ID:         row.ID,
+			WorkflowID: row.WorkflowID,
+			Status:     row.Status,
+			CreatedAt:  row.CreatedAt,
+			UpdatedAt:  row.UpdatedAt,
+			FinishedAt: row.FinishedAt,
+			Steps:      make(map[string]*WorkflowExecutionStep, len(stepRows)),
+		}
+		for _, sr := range stepRows {
+			step, err := sr.toStep()
+			if err != nil {
+				return nil, fmt.Errorf("failed to deserialize step %s of workflow execution %s: %w", sr.Ref, row.ID, err)
+			}
+			execution.Steps[step.Ref] = step
+		}
+		executions[i] = execution
+	}
+	return executions, nil
+}
+
+type executionRow struct {
+	ID         string     `db:"id"`
+	WorkflowID string     `db:"workflow_id"`
+	Status     string     `db:"status"`
+	CreatedAt  time.Time  `db:"created_at"`
+	UpdatedAt  time.Time  `db:"updated_at"`
+	FinishedAt *time.Time `db:"finished_at"`
+}
+
+type stepRow struct {
+	ExecutionID string         `db:"workflow_execution_id"`
+	Ref         string         `db:"ref"`
+	Status      string         `db:"status"`
+	Inputs      []byte         `db:"inputs"`
+	OutputValue []byte         `db:"output_value"`
+	OutputErr   sql.NullString `db:"output_err"`
+	UpdatedAt   time.Time      `db:"updated_at"`
+}
+
+func newStepRow(step *WorkflowExecutionStep) (stepRow, error) {
+	row := stepRow{
+		ExecutionID: step.ExecutionID,
+		Ref:         step.Ref,
+		Status:      step.Status,
+	}
+
+	var err error
+	if step.Inputs != nil {
+		row.Inputs, err = marshalValue(step.Inputs)
+		if err != nil {
+			return row, err
+		}
+	}
+	if step.OutputValue != nil {
+		row.OutputValue, err = marshalValue(step.OutputValue)
+		if err != nil {
+			return row, err
+		}
+	}
+	if step.OutputErr != nil {
+		row.OutputErr = sql.NullString{String: step.OutputErr.Error(), Valid: true}
+	}
+	return row, nil
+}
+
+func (r stepRow) toStep() (*WorkflowExecutionStep, error) {
+	step := &WorkflowExecutionStep{
+		ExecutionID: r.ExecutionID,
+		Ref:         r.Ref,
+		Status:      r.Status,
+		UpdatedAt:   r.UpdatedAt,
+	}
+
+	if r.Inputs != nil {
+		v, err := unmarshalValue(r.Inputs)
+		if err != nil {
+			return nil, err
+		}
+		m, ok := v.(*values.Map)
+		if !ok {
+			return nil, fmt.Errorf("expected inputs to be a map, got %T", v)
+		}
+		step.Inputs = m
+	}
+	if r.OutputValue != nil {
+		v, err := unmarshalValue(r.OutputValue)
+		if err != nil {
+			return nil, err
+		}
+		step.OutputValue = v
+	}
+	if r.OutputErr.Valid {
+		step.OutputErr = errors.New(r.OutputErr.String)
+	}
+	return step, nil
+}
+
+func marshalValue(v values.Value) ([]byte, error) {
+	return proto.Marshal(values.Proto(v))
+}
+
+func unmarshalValue(b []byte) (values.Value, error) {
+	pv := &pb.Value{}
+	if err := proto.Unmarshal(b, pv); err != nil {
+		return nil, err
+	}
+	return values.FromProto(pv), nil
+}
@@ -0,0 +1,73 @@
+package workflows
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/values"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/pgtest"
+)
+
+func TestORM(t *testing.T) {
+	ctx := testutils.Context(t)
+	db := pgtest.NewSqlxDB(t)
+	orm := NewORM(db)
+
+	trigger, err := values.NewMap(map[string]any{"feed": "0x1111"})
+	require.NoError(t, err)
+
+	execution := &WorkflowExecution{
+		ID:         "an-execution",
+		WorkflowID: "a-workflow",
+		Status:     statusStarted,
+		Steps: map[string]*WorkflowExecutionStep{
+			"trigger": {Ref: "trigger", Status: statusCompleted, OutputValue: trigger},
+		},
+	}
+	require.NoError(t, orm.CreateExecution(ctx, execution))
+	assert.False(t, execution.CreatedAt.IsZero())
+
+	inputs, err := values.NewMap(map[string]any{"observations": []any{"0x1111"}})
+	require.NoError(t, err)
+	require.NoError(t, orm.UpsertStep(ctx, &WorkflowExecutionStep{
+		ExecutionID: execution.ID,
+		Ref:         "consensus",
+		Status:      statusStarted,
+		Inputs:      inputs,
+	}))
+
+	unfinished, err := orm.FindUnfinishedExecutions(ctx, "a-workflow")
+	require.NoError(t, err)
+	require.Len(t, unfinished, 1)
+	assert.Equal(t, execution.ID, unfinished[0].ID)
+	require.Len(t, unfinished[0].Steps, 2)
+	assert.Equal(t, trigger, unfinished[0].Steps["trigger"].OutputValue)
+	assert.Equal(t, inputs, unfinished[0].Steps["consensus"].Inputs)
+	assert.Equal(t, statusStarted, unfinished[0].Steps["consensus"].Status)
+
+	require.NoError(t, orm.UpsertStep(ctx, &WorkflowExecutionStep{
+		ExecutionID: execution.ID,
+		Ref:         "consensus",
+		Status:      statusErrored,
+		Inputs:      inputs,
+		OutputErr:   errors.New("consensus failed"),
+	}))
+	require.NoError(t, orm.UpdateExecutionStatus(ctx, execution.ID, statusErrored))
+
+	found, err := orm.FindExecution(ctx, execution.ID)
+	require.NoError(t, err)
+	assert.Equal(t, statusErrored, found.Status)
+	assert.NotNil(t, found.FinishedAt)
+	assert.Equal(t, statusErrored, found.Steps["consensus"].Status)
+	assert.EqualError(t, found.Steps["consensus"].OutputErr, "consensus failed")
+
+	unfinished, err = orm.FindUnfinishedExecutions(ctx, "a-workflow")
+	require.NoError(t, err)
+	assert.Empty(t, unfinished)
+
+	assert.Error(t, orm.UpdateExecutionStatus(ctx, "unknown", statusCompleted))
+}
@@ -3,18 +3,30 @@ package workflows
 import (
 	"fmt"
 	"regexp"
+	"sort"
 	"strconv"
 	"strings"
 
 	"github.com/smartcontractkit/chainlink-common/pkg/values"
 )
 
+const (
+	statusStarted   = "started"
+	statusErrored   = "errored"
+	statusCompleted = "completed"
+)
+
+func isFinalStatus(status string) bool {
+	return status == statusCompleted || status == statusErrored
+}
+
 type stepOutput struct {
 	err   error
 	value values.Value
 }
 
 type stepState struct {
+	status  string
 	inputs  *values.Map
 	outputs *stepOutput
 }
@@ -23,6 +35,53 @@ type executionState struct {
 	steps       map[string]*stepState
 	executionID string
 	workflowID  string
+	status      string
+}
+
+// toWorkflowExecution converts the in-memory state of an execution into its persisted form.
+func (es *executionState) toWorkflowExecution() *WorkflowExecution {
+	steps := make(map[string]*WorkflowExecutionStep, len(es.steps))
+	for ref, ss := range es.steps {
+		steps[ref] = ss.toWorkflowExecutionStep(es.executionID, ref)
+	}
+	return &WorkflowExecution{
+		ID:         es.executionID,
+		WorkflowID: es.workflowID,
+		Status:     es.status,
+		Steps:      steps,
+	}
+}
+
+func (ss *stepState) toWorkflowExecutionStep(executionID string, ref string) *WorkflowExecutionStep {
+	return &WorkflowExecutionStep{
+		ExecutionID: executionID,
+		Ref:         ref,
+		Status:      ss.status,
+		Inputs:      ss.inputs,
+		OutputValue: ss.outputs.value,
+		OutputErr:   ss.outputs.err,
+	}
+}
+
+// newExecutionState restores the in-memory state of a persisted execution.
+func newExecutionState(execution *WorkflowExecution) *executionState {
+	steps := make(map[string]*stepState, len(execution.Steps))
+	for ref, s := range execution.Steps {
+		steps[ref] = &stepState{
+			status: s.Status,
+			inputs: s.Inputs,
+			outputs: &stepOutput{
+				value: s.OutputValue,
+				err:   s.OutputErr,
+			},
+		}
+	}
+	return &executionState{
+		steps:       steps,
+		executionID: execution.ID,
+		workflowID:  execution.WorkflowID,
+		status:      execution.Status,
+	}
 }
 
 // interpolateKey takes a multi-part, dot-separated key and attempts to replace
@@ -142,3 +201,52 @@ func findAndInterpolateAllKeys(input any, state *executionState) (any, error) {
 
 	return nil, fmt.Errorf("cannot interpolate item %+v of type %T", input, input)
 }
+
+// findRefs returns the distinct step refs referenced by any `$(ref.part...)`
+// tokens found (recursively) in `inputs`, in the order they are first seen.
+func findRefs(inputs map[string]any) ([]string, error) {
+	var refs []string
+	seen := map[string]bool{}
+	var walk func(v any) error
+	walk = func(v any) error {
+		switch tv := v.(type) {
+		case string:
+			matches := interpolationTokenRe.FindStringSubmatch(tv)
+			if len(matches) < 2 {
+				return nil
+			}
+
+			parts := strings.Split(matches[1], ".")
+			if len(parts) < 2 {
+				return fmt.Errorf("cannot interpolate %s: must have at least two parts", matches[1])
+			}
+
+			if !seen[parts[0]] {
+				seen[parts[0]] = true
+				refs = append(refs, parts[0])
+			}
+		case map[string]any:
+			// sort keys so that the result is deterministic
+			keys := make([]string, 0, len(tv))
+			for k := range tv {
+				keys = append(keys, k)
+			}
+			sort.Strings(keys)
+			for _, k := range keys {
+				if err := walk(tv[k]); err != nil {
+					return err
+				}
+			}
+		case []any:
+			for _, el := range tv {
+				if err := walk(el); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	}
+
+	err := walk(inputs)
+	return refs, err
+}
@@ -0,0 +1,106 @@
+package workflows
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/capabilities"
+	"github.com/smartcontractkit/chainlink-common/pkg/types"
+)
+
+// requiredInputs are the inputs each type of step must provide to the built-in capabilities.
+var requiredInputs = map[capabilities.CapabilityType][]string{
+	capabilities.CapabilityTypeConsensus: {"observations"},
+	capabilities.CapabilityTypeTarget:    {"report"},
+}
+
+// ValidationError is a single problem found while validating a workflow.
+type ValidationError struct {
+	// Ref is the ref of the step the problem was found in, or empty if it
+	// concerns the workflow as a whole.
+	Ref string
+	Msg string
+}
+
+func (e ValidationError) Error() string {
+	if e.Ref == "" {
+		return e.Msg
+	}
+	return fmt.Sprintf("step %s: %s", e.Ref, e.Msg)
+}
+
+// ValidationErrors holds every problem found while validating a workflow, so
+// that they can all be reported at once when the job is created.
+type ValidationErrors []ValidationError
+
+func (e ValidationErrors) Error() string {
+	msgs := make([]string, len(e))
+	for i, err := range e {
+		msgs[i] = err.Error()
+	}
+	return "invalid workflow: " + strings.Join(msgs, "; ")
+}
+
+// Validate parses the workflow and checks that it is well-formed: refs must be
+// unique and exist, the steps must form an acyclic graph, every capability must
+// be registered with the expected type and every step must provide its required
+// inputs. Capabilities whose type is listed in allowUnregistered may be missing
+// from the registry, since they can be registered after the job is created.
+func Validate(ctx context.Context, yamlWorkflow string, registry types.CapabilitiesRegistry, allowUnregistered []string) (*Workflow, error) {
+	wf, err := Parse(yamlWorkflow)
+	if err != nil {
+		return nil, ValidationErrors{{Msg: err.Error()}}
+	}
+
+	allowed := map[string]bool{}
+	for _, t := range allowUnregistered {
+		allowed[t] = true
+	}
+
+	var errs ValidationErrors
+	for _, t := range wf.Triggers {
+		if msg := validateCapability(ctx, registry, t.Type, capabilities.CapabilityTypeTrigger, allowed); msg != "" {
+			errs = append(errs, ValidationError{Ref: t.Ref, Msg: msg})
+		}
+	}
+	for _, ref := range wf.order {
+		s := wf.steps[ref]
+		if msg := validateCapability(ctx, registry, s.Type, s.capabilityType, allowed); msg != "" {
+			errs = append(errs, ValidationError{Ref: ref, Msg: msg})
+		}
+		for _, input := range requiredInputs[s.capabilityType] {
+			if _, ok := s.Inputs[input]; !ok {
+				errs = append(errs, ValidationError{Ref: ref, Msg: fmt.Sprintf("missing required input %s", input)})
+			}
+		}
+	}
+
+	sort.SliceStable(errs, func(i, j int) bool { return errs[i].Ref < errs[j].Ref })
+	if len(errs) > 0 {
+		return nil, errs
+	}
+	return wf, nil
+}
+
+// validateCapability returns a description of the problem with the capability
+// with the given ID, or an empty string if it can be used as a capabilityType step.
+func validateCapability(ctx context.Context, registry types.CapabilitiesRegistry, id string, capabilityType capabilities.CapabilityType, allowed map[string]bool) string {
+	c, err := registry.Get(ctx, id)
+	if err != nil {
+		if allowed[id] {
+			return ""
+		}
+		return fmt.Sprintf("capability %s is not registered", id)
+	}
+
+	info, err := c.Info(ctx)
+	if err != nil {
+		return fmt.Sprintf("failed to get info of capability %s: %v", id, err)
+	}
+	if info.CapabilityType != capabilityType {
+		return fmt.Sprintf("capability %s is a %s, expected a %s", id, info.CapabilityType, capabilityType)
+	}
+	return ""
+}
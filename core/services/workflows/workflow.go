@@ -1,12 +1,60 @@
 package workflows
 
-import "gopkg.in/yaml.v3"
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/capabilities"
+)
+
+const (
+	onErrorFail     = "fail"
+	onErrorContinue = "continue"
+)
 
 type Capability struct {
 	Type   string         `yaml:"type"`
 	Ref    string         `yaml:"ref"`
 	Inputs map[string]any `yaml:"inputs"`
 	Config map[string]any `yaml:"config"`
+
+	// Timeout bounds each attempt at executing the step. It defaults to 10s.
+	Timeout time.Duration `yaml:"timeout"`
+	Retry   Retry         `yaml:"retry"`
+	// OnError is applied once a step has failed on every attempt:
+	//   - "fail" (the default) fails the execution
+	//   - "continue" marks the step as errored and skips the steps depending on it,
+	//     without affecting the rest of the execution
+	//   - any other value is the ref of a step or trigger whose outputs are used in
+	//     place of this step's outputs. The step then depends on it, like on its inputs.
+	OnError string `yaml:"onError"`
+}
+
+// Retry configures how many more times a failed step is attempted, and how long
+// to wait before the first retry. The wait doubles on every subsequent retry, up to 5m.
+type Retry struct {
+	Count   int           `yaml:"count"`
+	Backoff time.Duration `yaml:"backoff"`
+}
+
+// fallbackRef returns the ref whose outputs replace those of the step if it fails,
+// or an empty string if OnError is not a fallback.
+func (c Capability) fallbackRef() string {
+	switch c.OnError {
+	case "", onErrorFail, onErrorContinue:
+		return ""
+	default:
+		return c.OnError
+	}
 }
 
 type Workflow struct {
@@ -14,10 +62,180 @@ type Workflow struct {
 	Actions   []Capability `yaml:"actions"`
 	Consensus []Capability `yaml:"consensus"`
 	Targets   []Capability `yaml:"targets"`
+
+	// steps holds every non-trigger step keyed by its ref. It is populated by Parse.
+	steps map[string]*step
+	// order is a topological ordering of the keys of steps.
+	order []string
+}
+
+// step is a single non-trigger node in the workflow graph.
+type step struct {
+	Capability
+	capabilityType capabilities.CapabilityType
+	// dependencies are the refs (steps or triggers) this step's inputs reference.
+	dependencies []string
+	// dependents are the refs of the steps referencing this step's outputs.
+	dependents []string
+}
+
+func (w *Workflow) isTrigger(ref string) bool {
+	for _, t := range w.Triggers {
+		if t.Ref == ref {
+			return true
+		}
+	}
+	return false
 }
 
 func Parse(yamlWorkflow string) (*Workflow, error) {
 	wf := &Workflow{}
 	err := yaml.Unmarshal([]byte(yamlWorkflow), wf)
+	if err != nil {
+		return nil, err
+	}
+
+	err = wf.buildGraph()
 	return wf, err
 }
+
+// buildGraph derives the dependencies of each step from the `$(ref.outputs...)`
+// references found in its inputs and computes a topological ordering of the steps.
+func (w *Workflow) buildGraph() error {
+	if len(w.Triggers) == 0 {
+		return fmt.Errorf("workflow must have at least one trigger")
+	}
+
+	refs := map[string]bool{}
+	for _, t := range w.Triggers {
+		if t.Ref == "" {
+			return fmt.Errorf("trigger %s must have a ref", t.Type)
+		}
+		if refs[t.Ref] {
+			return fmt.Errorf("duplicate ref %s", t.Ref)
+		}
+		refs[t.Ref] = true
+	}
+
+	w.steps = map[string]*step{}
+	groups := []struct {
+		capabilities   []Capability
+		capabilityType capabilities.CapabilityType
+	}{
+		{w.Actions, capabilities.CapabilityTypeAction},
+		{w.Consensus, capabilities.CapabilityTypeConsensus},
+		{w.Targets, capabilities.CapabilityTypeTarget},
+	}
+	for _, g := range groups {
+		for i, c := range g.capabilities {
+			// Targets are leaves and are rarely referenced, so they may omit a ref.
+			if c.Ref == "" {
+				if g.capabilityType != capabilities.CapabilityTypeTarget {
+					return fmt.Errorf("%s %s must have a ref", g.capabilityType, c.Type)
+				}
+				c.Ref = fmt.Sprintf("%s_%d", c.Type, i)
+			}
+			if refs[c.Ref] {
+				return fmt.Errorf("duplicate ref %s", c.Ref)
+			}
+			refs[c.Ref] = true
+			w.steps[c.Ref] = &step{Capability: c, capabilityType: g.capabilityType}
+		}
+	}
+
+	for ref, s := range w.steps {
+		if s.Timeout < 0 || s.Retry.Count < 0 || s.Retry.Backoff < 0 {
+			return fmt.Errorf("step %s: timeout and retry settings must not be negative", ref)
+		}
+
+		deps, err := findRefs(s.Inputs)
+		if err != nil {
+			return fmt.Errorf("invalid inputs for step %s: %w", ref, err)
+		}
+		if fallback := s.fallbackRef(); fallback != "" && !slices.Contains(deps, fallback) {
+			deps = append(deps, fallback)
+		}
+		for _, d := range deps {
+			if !refs[d] {
+				return fmt.Errorf("step %s references unknown ref %s", ref, d)
+			}
+			if d == ref {
+				return fmt.Errorf("step %s references itself", ref)
+			}
+			s.dependencies = append(s.dependencies, d)
+			if dep, ok := w.steps[d]; ok {
+				dep.dependents = append(dep.dependents, ref)
+			}
+		}
+	}
+
+	order, err := topologicalSort(w.steps)
+	if err != nil {
+		return err
+	}
+	w.order = order
+	return nil
+}
+
+// topologicalSort orders the steps using Kahn's algorithm, breaking ties by ref so
+// that the ordering is deterministic. It returns an error if the graph contains a cycle.
+func topologicalSort(steps map[string]*step) ([]string, error) {
+	inDegree := map[string]int{}
+	for ref, s := range steps {
+		inDegree[ref] += 0
+		for _, d := range s.dependents {
+			inDegree[d]++
+		}
+	}
+
+	var ready []string
+	for ref, deg := range inDegree {
+		if deg == 0 {
+			ready = append(ready, ref)
+		}
+	}
+
+	order := make([]string, 0, len(steps))
+	for len(ready) > 0 {
+		sort.Strings(ready)
+		ref := ready[0]
+		ready = ready[1:]
+		order = append(order, ref)
+		for _, d := range steps[ref].dependents {
+			inDegree[d]--
+			if inDegree[d] == 0 {
+				ready = append(ready, d)
+			}
+		}
+	}
+
+	if len(order) != len(steps) {
+		var cyclic []string
+		for ref, deg := range inDegree {
+			if deg > 0 {
+				cyclic = append(cyclic, ref)
+			}
+		}
+		sort.Strings(cyclic)
+		return nil, fmt.Errorf("workflow contains a cycle between steps %v", cyclic)
+	}
+	return order, nil
+}
+
+// normalizeWorkflowOwner validates that owner is a hex-encoded address and
+// returns it lowercased and without a 0x prefix.
+func normalizeWorkflowOwner(owner string) (string, error) {
+	if !common.IsHexAddress(owner) {
+		return "", fmt.Errorf("workflowOwner must be a hex-encoded address, got %q", owner)
+	}
+	return strings.ToLower(strings.TrimPrefix(common.HexToAddress(owner).Hex(), "0x")), nil
+}
+
+// generateWorkflowID deterministically derives the ID of a workflow from its
+// owner and YAML definition, so that the same workflow gets the same ID on every node.
+func generateWorkflowID(owner string, workflow string) string {
+	h := sha256.New()
+	h.Write(common.HexToAddress(owner).Bytes())
+	h.Write([]byte(workflow))
+	return hex.EncodeToString(h.Sum(nil))
+}
@@ -0,0 +1,200 @@
+package workflows
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParse_Graph(t *testing.T) {
+	testCases := []struct {
+		name         string
+		yaml         string
+		order        []string
+		dependencies map[string][]string
+		errMsg       string
+	}{
+		{
+			name: "hardcoded workflow",
+			yaml: hardcodedWorkflow,
+			order: []string{
+				"evm_median",
+				"write_ethereum-testnet-sepolia_1",
+				"write_polygon-testnet-mumbai_0",
+			},
+			dependencies: map[string][]string{
+				"evm_median":                       {"report_data"},
+				"write_polygon-testnet-mumbai_0":   {"evm_median"},
+				"write_ethereum-testnet-sepolia_1": {"evm_median"},
+			},
+		},
+		{
+			name: "actions and multiple triggers",
+			yaml: `
+triggers:
+  - type: "a-trigger"
+    ref: trigger_a
+  - type: "b-trigger"
+    ref: trigger_b
+actions:
+  - type: "an-action"
+    ref: action_1
+    inputs:
+      a: $(trigger_a.outputs)
+  - type: "another-action"
+    ref: action_2
+    inputs:
+      b: $(trigger_b.outputs.value)
+      a: $(action_1.outputs)
+consensus:
+  - type: "offchain_reporting"
+    ref: consensus
+    inputs:
+      observations:
+        - $(action_1.outputs)
+        - $(action_2.outputs)
+targets:
+  - type: "a-target"
+    ref: target
+    inputs:
+      report: $(consensus.outputs.reports)
+`,
+			order: []string{"action_1", "action_2", "consensus", "target"},
+			dependencies: map[string][]string{
+				"action_1":  {"trigger_a"},
+				"action_2":  {"action_1", "trigger_b"},
+				"consensus": {"action_1", "action_2"},
+				"target":    {"consensus"},
+			},
+		},
+		{
+			name: "cycle",
+			yaml: `
+triggers:
+  - type: "a-trigger"
+    ref: trigger
+actions:
+  - type: "an-action"
+    ref: action_1
+    inputs:
+      a: $(action_2.outputs)
+  - type: "an-action"
+    ref: action_2
+    inputs:
+      a: $(action_1.outputs)
+      b: $(trigger.outputs)
+`,
+			errMsg: "workflow contains a cycle between steps [action_1 action_2]",
+		},
+		{
+			name: "unknown ref",
+			yaml: `
+triggers:
+  - type: "a-trigger"
+    ref: trigger
+actions:
+  - type: "an-action"
+    ref: action
+    inputs:
+      a: $(unknown.outputs)
+`,
+			errMsg: "step action references unknown ref unknown",
+		},
+		{
+			name: "duplicate ref",
+			yaml: `
+triggers:
+  - type: "a-trigger"
+    ref: trigger
+actions:
+  - type: "an-action"
+    ref: trigger
+`,
+			errMsg: "duplicate ref trigger",
+		},
+		{
+			name: "fallback",
+			yaml: `
+triggers:
+  - type: "a-trigger"
+    ref: trigger
+actions:
+  - type: "an-action"
+    ref: primary
+    onError: backup
+    retry:
+      count: 3
+      backoff: 1s
+    inputs:
+      a: $(trigger.outputs)
+  - type: "an-action"
+    ref: backup
+    onError: continue
+    inputs:
+      a: $(trigger.outputs)
+`,
+			order: []string{"backup", "primary"},
+			dependencies: map[string][]string{
+				"primary": {"backup", "trigger"},
+				"backup":  {"trigger"},
+			},
+		},
+		{
+			name: "unknown fallback",
+			yaml: `
+triggers:
+  - type: "a-trigger"
+    ref: trigger
+actions:
+  - type: "an-action"
+    ref: action
+    onError: unknown
+    inputs:
+      a: $(trigger.outputs)
+`,
+			errMsg: "step action references unknown ref unknown",
+		},
+		{
+			name: "negative retries",
+			yaml: `
+triggers:
+  - type: "a-trigger"
+    ref: trigger
+actions:
+  - type: "an-action"
+    ref: action
+    retry:
+      count: -1
+    inputs:
+      a: $(trigger.outputs)
+`,
+			errMsg: "step action: timeout and retry settings must not be negative",
+		},
+		{
+			name: "no triggers",
+			yaml: `
+actions:
+  - type: "an-action"
+    ref: action
+`,
+			errMsg: "workflow must have at least one trigger",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			wf, err := Parse(tc.yaml)
+			if tc.errMsg != "" {
+				assert.ErrorContains(t, err, tc.errMsg)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tc.order, wf.order)
+			for ref, deps := range tc.dependencies {
+				assert.ElementsMatch(t, deps, wf.steps[ref].dependencies, ref)
+			}
+		})
+	}
+}
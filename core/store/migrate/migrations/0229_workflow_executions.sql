@@ -0,0 +1,27 @@
+-- +goose Up
+CREATE TABLE workflow_executions (
+    id TEXT PRIMARY KEY,
+    workflow_id TEXT NOT NULL,
+    status TEXT NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    finished_at TIMESTAMPTZ
+);
+
+CREATE INDEX idx_workflow_executions_workflow_id_status ON workflow_executions (workflow_id, status);
+
+CREATE TABLE workflow_steps (
+    id BIGSERIAL PRIMARY KEY,
+    workflow_execution_id TEXT NOT NULL REFERENCES workflow_executions (id) ON DELETE CASCADE,
+    ref TEXT NOT NULL,
+    status TEXT NOT NULL,
+    inputs BYTEA,
+    output_value BYTEA,
+    output_err TEXT,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    UNIQUE (workflow_execution_id, ref)
+);
+
+-- +goose Down
+DROP TABLE workflow_steps;
+DROP TABLE workflow_executions;
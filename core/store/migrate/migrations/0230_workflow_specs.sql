@@ -0,0 +1,76 @@
+-- +goose Up
+CREATE TABLE workflow_specs (
+    id SERIAL PRIMARY KEY,
+    workflow TEXT NOT NULL,
+    workflow_id VARCHAR(64) NOT NULL,
+    workflow_owner VARCHAR(40) NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL,
+    updated_at TIMESTAMPTZ NOT NULL
+);
+
+-- two jobs running the same workflow for the same owner would collide in capability registrations
+CREATE UNIQUE INDEX idx_workflow_specs_workflow_id ON workflow_specs (workflow_id);
+
+-- workflow jobs created before this migration have no spec and ran a hardcoded placeholder workflow, so they cannot be
+-- migrated. Rather than deleting them, refuse to migrate until the operator has deleted and recreated them.
+-- +goose StatementBegin
+DO $$
+DECLARE
+    workflow_job_ids TEXT;
+BEGIN
+    SELECT string_agg(id::TEXT, ', ' ORDER BY id) INTO workflow_job_ids FROM jobs WHERE type = 'workflow';
+    IF workflow_job_ids IS NOT NULL THEN
+        RAISE EXCEPTION 'Workflow jobs % have no workflow spec and cannot be migrated. Delete them with the previous version of the node, then recreate them with a workflow spec after upgrading', workflow_job_ids;
+    END IF;
+END
+$$;
+-- +goose StatementEnd
+
+ALTER TABLE
+  jobs
+ADD COLUMN
+  workflow_spec_id INT REFERENCES workflow_specs (id),
+DROP
+  CONSTRAINT chk_specs,
+ADD
+  CONSTRAINT chk_specs CHECK (
+    num_nonnulls(
+      ocr_oracle_spec_id, ocr2_oracle_spec_id,
+      direct_request_spec_id, flux_monitor_spec_id,
+      keeper_spec_id, cron_spec_id, webhook_spec_id,
+      vrf_spec_id, blockhash_store_spec_id,
+      block_header_feeder_spec_id, bootstrap_spec_id,
+      gateway_spec_id,
+      legacy_gas_station_server_spec_id,
+      legacy_gas_station_sidecar_spec_id,
+      eal_spec_id,
+      workflow_spec_id,
+      CASE "type" WHEN 'stream' THEN 1 ELSE NULL END -- 'stream' type lacks a spec but should not cause validation to fail
+    ) = 1
+  );
+
+-- +goose Down
+ALTER TABLE
+  jobs
+DROP COLUMN
+  workflow_spec_id,
+DROP
+  CONSTRAINT IF EXISTS chk_specs,
+ADD
+  CONSTRAINT chk_specs CHECK (
+    num_nonnulls(
+      ocr_oracle_spec_id, ocr2_oracle_spec_id,
+      direct_request_spec_id, flux_monitor_spec_id,
+      keeper_spec_id, cron_spec_id, webhook_spec_id,
+      vrf_spec_id, blockhash_store_spec_id,
+      block_header_feeder_spec_id, bootstrap_spec_id,
+      gateway_spec_id,
+      legacy_gas_station_server_spec_id,
+      legacy_gas_station_sidecar_spec_id,
+      eal_spec_id,
+      CASE "type" WHEN 'stream' THEN 1 ELSE NULL END, -- 'stream' type lacks a spec but should not cause validation to fail
+      CASE "type" WHEN 'workflow' THEN 1 ELSE NULL END -- 'workflow' type currently lacks a spec but should not cause validation to fail
+    ) = 1
+  );
+
+DROP TABLE workflow_specs;
@@ -0,0 +1,11 @@
+-- +goose Up
+CREATE TABLE evm.log_trigger_cursors (
+    evm_chain_id NUMERIC(78,0) NOT NULL,
+    trigger_id TEXT NOT NULL,
+    block_number BIGINT NOT NULL,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (evm_chain_id, trigger_id)
+);
+
+-- +goose Down
+DROP TABLE evm.log_trigger_cursors;
@@ -0,0 +1,5 @@
+-- +goose Up
+ALTER TABLE jobs ADD COLUMN signing_keys TEXT[];
+
+-- +goose Down
+ALTER TABLE jobs DROP COLUMN signing_keys;
@@ -0,0 +1,13 @@
+-- +goose Up
+
+CREATE TABLE http_task_responses (
+    key bytea PRIMARY KEY,
+    value bytea NOT NULL,
+    finished_at timestamptz NOT NULL
+);
+
+CREATE INDEX idx_http_task_responses_finished_at ON http_task_responses USING btree (finished_at);
+
+-- +goose Down
+DROP INDEX idx_http_task_responses_finished_at;
+DROP TABLE http_task_responses;
@@ -0,0 +1,5 @@
+-- +goose Up
+ALTER TABLE jobs ADD COLUMN reaper_threshold bigint;
+
+-- +goose Down
+ALTER TABLE jobs DROP COLUMN reaper_threshold;
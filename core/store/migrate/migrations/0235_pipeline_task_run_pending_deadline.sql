@@ -0,0 +1,7 @@
+-- +goose Up
+ALTER TABLE pipeline_task_runs ADD COLUMN pending_deadline timestamptz, ADD COLUMN resume_bridge_name text;
+CREATE INDEX idx_pipeline_task_runs_pending_deadline ON pipeline_task_runs (pending_deadline) WHERE finished_at IS NULL AND pending_deadline IS NOT NULL;
+
+-- +goose Down
+DROP INDEX idx_pipeline_task_runs_pending_deadline;
+ALTER TABLE pipeline_task_runs DROP COLUMN pending_deadline, DROP COLUMN resume_bridge_name;
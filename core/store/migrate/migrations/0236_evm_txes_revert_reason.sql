@@ -0,0 +1,5 @@
+-- +goose Up
+ALTER TABLE evm.txes ADD COLUMN revert_reason TEXT;
+
+-- +goose Down
+ALTER TABLE evm.txes DROP COLUMN revert_reason;
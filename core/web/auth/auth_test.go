@@ -294,6 +294,7 @@ var routesRolesMap = [...]routeRules{
 	{"GET", "/v2/jobs", true, true, true},
 	{"GET", "/v2/jobs/MOCK", true, true, true},
 	{"POST", "/v2/jobs", false, false, true},
+	{"POST", "/v2/jobs/simulate", false, false, true},
 	{"DELETE", "/v2/jobs/MOCK", false, false, true},
 	{"GET", "/v2/pipeline/runs", true, true, true},
 	{"GET", "/v2/jobs/MOCK/runs", true, true, true},
@@ -320,6 +321,7 @@ var routesRolesMap = [...]routeRules{
 	{"GET", "/v2/build_info", true, true, true},
 	{"GET", "/v2/ping", true, true, true},
 	{"POST", "/v2/jobs/MOCK/runs", false, true, true},
+	{"POST", "/v2/workflows/MOCK/trigger", false, true, true},
 }
 
 // The following test implementations work by asserting only that "Unauthorized/Forbidden" errors are not returned (success case),
@@ -31,6 +31,7 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/services/vrf/vrfcommon"
 	"github.com/smartcontractkit/chainlink/v2/core/services/webhook"
 	"github.com/smartcontractkit/chainlink/v2/core/services/workflows"
+	"github.com/smartcontractkit/chainlink/v2/core/store/models"
 	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
 )
 
@@ -105,7 +106,7 @@ func (jc *JobsController) Create(c *gin.Context) {
 		return
 	}
 
-	jb, status, err := jc.validateJobSpec(request.TOML)
+	jb, status, err := jc.validateJobSpec(c.Request.Context(), request.TOML)
 	if err != nil {
 		jsonAPIError(c, status, err)
 		return
@@ -174,7 +175,7 @@ func (jc *JobsController) Update(c *gin.Context) {
 		return
 	}
 
-	jb, status, err := jc.validateJobSpec(request.TOML)
+	jb, status, err := jc.validateJobSpec(c.Request.Context(), request.TOML)
 	if err != nil {
 		jsonAPIError(c, status, err)
 		return
@@ -214,7 +215,39 @@ func (jc *JobsController) Update(c *gin.Context) {
 	jsonAPIResponse(c, presenters.NewJobResource(jb), jb.Type.String())
 }
 
-func (jc *JobsController) validateJobSpec(tomlString string) (jb job.Job, statusCode int, err error) {
+// SimulateJobRequest represents a request to simulate a run of a job (V2).
+type SimulateJobRequest struct {
+	TOML string                 `json:"toml"`
+	Vars map[string]interface{} `json:"vars"`
+}
+
+// Simulate validates a job and runs its pipeline in-memory, without saving the job or the run,
+// sending transactions, signing or writing caches.
+// Example:
+// "POST <application>/jobs/simulate"
+func (jc *JobsController) Simulate(c *gin.Context) {
+	request := SimulateJobRequest{}
+	if err := c.ShouldBindJSON(&request); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	jb, status, err := jc.validateJobSpec(c.Request.Context(), request.TOML)
+	if err != nil {
+		jsonAPIError(c, status, err)
+		return
+	}
+
+	run, trrs, err := jc.App.SimulateJobV2(c.Request.Context(), jb, request.Vars)
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	jsonAPIResponse(c, presenters.NewJobSimulationResource(*run, trrs, jc.App.GetLogger()), "jobSimulations")
+}
+
+func (jc *JobsController) validateJobSpec(ctx context.Context, tomlString string) (jb job.Job, statusCode int, err error) {
 	jobType, err := job.ValidateSpec(tomlString)
 	if err != nil {
 		return jb, http.StatusUnprocessableEntity, errors.Wrap(err, "failed to parse TOML")
@@ -255,11 +288,19 @@ func (jc *JobsController) validateJobSpec(tomlString string) (jb job.Job, status
 	case job.Stream:
 		jb, err = streams.ValidatedStreamSpec(tomlString)
 	case job.Workflow:
-		jb, err = workflows.ValidatedWorkflowSpec(tomlString)
+		jb, err = workflows.ValidatedWorkflowSpec(ctx, tomlString, jc.App.GetCapabilitiesRegistry())
 	default:
 		return jb, http.StatusUnprocessableEntity, errors.Errorf("unknown job type: %s", jobType)
 	}
 
+	var validationErrs workflows.ValidationErrors
+	if errors.As(err, &validationErrs) {
+		jsonErrs := models.NewJSONAPIErrors()
+		for _, e := range validationErrs {
+			jsonErrs.Add(e.Error())
+		}
+		return jb, http.StatusBadRequest, jsonErrs
+	}
 	if err != nil {
 		return jb, http.StatusBadRequest, err
 	}
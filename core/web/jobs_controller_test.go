@@ -434,6 +434,55 @@ func TestJobsController_Create_WebhookSpec(t *testing.T) {
 //go:embed webhook-spec-template.yml
 var webhookSpecTemplate string
 
+func TestJobsController_Simulate(t *testing.T) {
+	app := cltest.NewApplicationEVMDisabled(t)
+	require.NoError(t, app.Start(testutils.Context(t)))
+	t.Cleanup(func() { assert.NoError(t, app.Stop()) })
+
+	client := app.NewHTTPClient(nil)
+
+	tomlStr := fmt.Sprintf(`
+type            = "webhook"
+schemaVersion   = 1
+externalJobID   = "%s"
+observationSource   = """
+    memo     [type=memo value=2];
+    multiply [type=multiply input="$(memo)" times="$(times)"];
+    memo -> multiply;
+"""
+`, uuid.New())
+	body, err := json.Marshal(web.SimulateJobRequest{
+		TOML: tomlStr,
+		Vars: map[string]interface{}{"times": 3},
+	})
+	require.NoError(t, err)
+	response, cleanup := client.Post("/v2/jobs/simulate", bytes.NewReader(body))
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, response, http.StatusOK)
+
+	resource := presenters.JobSimulationResource{}
+	require.NoError(t, web.ParseJSONAPIResponse(cltest.ParseResponseBody(t, response), &resource))
+	require.Len(t, resource.TaskRuns, 2)
+	assert.Equal(t, "memo", resource.TaskRuns[0].DotID)
+	assert.Empty(t, resource.TaskRuns[0].Inputs)
+	assert.Equal(t, "multiply", resource.TaskRuns[1].DotID)
+	require.Len(t, resource.TaskRuns[1].Inputs, 1)
+	assert.NotEmpty(t, resource.TaskRuns[1].Duration)
+	require.Len(t, resource.Outputs, 1)
+	assert.Contains(t, *resource.Outputs[0], "6")
+
+	// the job is not saved
+	jobs, _, err := app.JobORM().FindJobs(0, 10)
+	require.NoError(t, err)
+	assert.Empty(t, jobs)
+
+	body, err = json.Marshal(web.SimulateJobRequest{TOML: "type = \"webhook\""})
+	require.NoError(t, err)
+	response, cleanup = client.Post("/v2/jobs/simulate", bytes.NewReader(body))
+	t.Cleanup(cleanup)
+	assert.Equal(t, http.StatusUnprocessableEntity, response.StatusCode)
+}
+
 func TestJobsController_FailToCreate_EmptyJsonAttribute(t *testing.T) {
 	app := cltest.NewApplicationEVMDisabled(t)
 	require.NoError(t, app.Start(testutils.Context(t)))
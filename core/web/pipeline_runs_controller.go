@@ -1,15 +1,21 @@
 package web
 
 import (
+	"database/sql"
 	"encoding/json"
+	"fmt"
 	"io"
 	"net/http"
 	"strconv"
+	"strings"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
+	"github.com/manyminds/api2go/jsonapi"
 	"github.com/pkg/errors"
 
+	"github.com/smartcontractkit/chainlink/v2/core/bridges"
 	"github.com/smartcontractkit/chainlink/v2/core/logger/audit"
 	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
 	"github.com/smartcontractkit/chainlink/v2/core/services/job"
@@ -82,6 +88,111 @@ func (prc *PipelineRunsController) Show(c *gin.Context) {
 	jsonAPIResponse(c, res, "pipelineRun")
 }
 
+// resumeSignatureHeader carries the signature of the results of async bridge tasks requiring signed resumes.
+const resumeSignatureHeader = "X-Chainlink-Signature"
+
+// Cancel finishes a suspended pipeline run as errored, without waiting for its pending tasks.
+// Example:
+// "POST <application>/pipeline/runs/:runID/cancel"
+func (prc *PipelineRunsController) Cancel(c *gin.Context) {
+	pipelineRun := pipeline.Run{}
+	err := pipelineRun.SetID(c.Param("runID"))
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	err = prc.App.CancelJobRunV2(c.Request.Context(), pipelineRun.ID)
+	if errors.Is(err, sql.ErrNoRows) {
+		jsonAPIError(c, http.StatusNotFound, errors.Errorf("pipeline run %d not found", pipelineRun.ID))
+		return
+	} else if errors.Is(err, pipeline.ErrRunNotSuspended) {
+		jsonAPIError(c, http.StatusConflict, err)
+		return
+	} else if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	pipelineRun, err = prc.App.PipelineORM().FindRun(pipelineRun.ID)
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	prc.App.GetAuditLogger().Audit(audit.JobRunCancelled, map[string]interface{}{"runID": pipelineRun.ID})
+	res := presenters.NewPipelineRunResource(pipelineRun, prc.App.GetLogger())
+	jsonAPIResponse(c, res, "pipelineRun")
+}
+
+// streamKeepAlive is how often an idle run stream sends a comment, so that proxies do not close it.
+const streamKeepAlive = 15 * time.Second
+
+// Stream sends the pipeline runs as they finish, as server-sent events. Runs can be filtered by job with the ID
+// param and by state with a comma separated status query, e.g. status=errored.
+// Example:
+// "GET <application>/jobs/:ID/runs/stream?status=errored"
+func (prc *PipelineRunsController) Stream(c *gin.Context) {
+	var filter pipeline.RunFilter
+	if id := c.Param("ID"); id != "" {
+		jobSpec := job.Job{}
+		if err := jobSpec.SetID(id); err != nil {
+			jsonAPIError(c, http.StatusUnprocessableEntity, err)
+			return
+		}
+		filter.JobID = jobSpec.ID
+	}
+	if status := c.Query("status"); status != "" {
+		for _, s := range strings.Split(status, ",") {
+			state := pipeline.RunStatus(strings.TrimSpace(s))
+			switch state {
+			case pipeline.RunStatusCompleted, pipeline.RunStatusErrored, pipeline.RunStatusSuspended:
+				filter.States = append(filter.States, state)
+			default:
+				jsonAPIError(c, http.StatusUnprocessableEntity, errors.Errorf("invalid status %q, must be one of %s, %s, %s", s, pipeline.RunStatusCompleted, pipeline.RunStatusErrored, pipeline.RunStatusSuspended))
+				return
+			}
+		}
+	}
+
+	runs, unsubscribe := prc.App.PipelineRunBroadcaster().Subscribe(filter)
+	defer unsubscribe()
+
+	// the stream stays open for as long as the client wants, so it must not be cut by the server write timeout
+	if err := http.NewResponseController(c.Writer).SetWriteDeadline(time.Time{}); err != nil {
+		prc.App.GetLogger().Debugw("Failed to clear write deadline for run stream", "err", err)
+	}
+	c.Header("Content-Type", "text/event-stream")
+	c.Header("Cache-Control", "no-cache")
+	c.Header("Connection", "keep-alive")
+	c.Status(http.StatusOK)
+	c.Writer.Flush()
+
+	keepAlive := time.NewTicker(streamKeepAlive)
+	defer keepAlive.Stop()
+	ctx := c.Request.Context()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-keepAlive.C:
+			if _, err := io.WriteString(c.Writer, ": keepalive\n\n"); err != nil {
+				return
+			}
+		case run := <-runs:
+			b, err := jsonapi.Marshal(presenters.NewPipelineRunResource(*run, prc.App.GetLogger()))
+			if err != nil {
+				prc.App.GetLogger().Errorw("Failed to marshal pipeline run", "runID", run.ID, "err", err)
+				continue
+			}
+			if _, err = fmt.Fprintf(c.Writer, "event: run\ndata: %s\n\n", b); err != nil {
+				return
+			}
+		}
+		c.Writer.Flush()
+	}
+}
+
 // Create triggers a pipeline run for a job.
 // Example:
 // "POST <application>/jobs/:ID/runs"
@@ -151,7 +262,8 @@ func (prc *PipelineRunsController) Create(c *gin.Context) {
 	jsonAPIError(c, http.StatusUnprocessableEntity, errors.New("bad job ID"))
 }
 
-// Resume finishes a task and resumes the pipeline run.
+// Resume finishes a task and resumes the pipeline run. If the task requires a signed resume, the body must be
+// signed by the bridge, see bridges.AuthenticateBridgeResume.
 // Example:
 // "PATCH <application>/jobs/:ID/runs/:runID"
 func (prc *PipelineRunsController) Resume(c *gin.Context) {
@@ -161,9 +273,34 @@ func (prc *PipelineRunsController) Resume(c *gin.Context) {
 		return
 	}
 
+	bodyBytes, err := io.ReadAll(c.Request.Body)
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	taskRun, err := prc.App.PipelineORM().FindTaskRun(taskID)
+	if errors.Is(err, sql.ErrNoRows) {
+		jsonAPIError(c, http.StatusNotFound, errors.Errorf("task run %s not found", taskID))
+		return
+	} else if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+	if taskRun.ResumeBridgeName.Valid {
+		bt, err2 := prc.App.BridgeORM().FindBridge(bridges.BridgeName(taskRun.ResumeBridgeName.String))
+		if err2 != nil {
+			jsonAPIError(c, http.StatusInternalServerError, err2)
+			return
+		}
+		if !bridges.AuthenticateBridgeResume(&bt, bodyBytes, c.GetHeader(resumeSignatureHeader)) {
+			jsonAPIError(c, http.StatusUnauthorized, errors.Errorf("invalid %s for task run %s", resumeSignatureHeader, taskID))
+			return
+		}
+	}
+
 	rr := pipeline.ResumeRequest{}
-	decoder := json.NewDecoder(c.Request.Body)
-	err = errors.Wrap(decoder.Decode(&rr), "failed to unmarshal JSON body")
+	err = errors.Wrap(json.Unmarshal(bodyBytes, &rr), "failed to unmarshal JSON body")
 	if err != nil {
 		jsonAPIError(c, http.StatusUnprocessableEntity, err)
 		return
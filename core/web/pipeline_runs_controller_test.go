@@ -248,6 +248,18 @@ func TestPipelineRunsController_ShowRun_InvalidID(t *testing.T) {
 	cltest.AssertServerResponse(t, response, http.StatusUnprocessableEntity)
 }
 
+func TestPipelineRunsController_Cancel_NotSuspended(t *testing.T) {
+	client, _, runIDs := setupPipelineRunsControllerTests(t)
+
+	response, cleanup := client.Post(fmt.Sprintf("/v2/pipeline/runs/%d/cancel", runIDs[0]), nil)
+	defer cleanup()
+	cltest.AssertServerResponse(t, response, http.StatusConflict)
+
+	response, cleanup = client.Post("/v2/pipeline/runs/999999/cancel", nil)
+	defer cleanup()
+	cltest.AssertServerResponse(t, response, http.StatusNotFound)
+}
+
 func setupPipelineRunsControllerTests(t *testing.T) (cltest.HTTPClientCleaner, int32, []int64) {
 	t.Parallel()
 	ethClient := cltest.NewEthMocksWithStartupAssertions(t)
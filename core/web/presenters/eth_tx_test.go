@@ -9,6 +9,7 @@ import (
 	"github.com/manyminds/api2go/jsonapi"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"gopkg.in/guregu/null.v4"
 
 	txmgrcommon "github.com/smartcontractkit/chainlink/v2/common/txmgr"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/assets"
@@ -54,7 +55,8 @@ func TestEthTxResource(t *testing.T) {
 			"sentAt": "",
 			"to": "0x0000000000000000000000000000000000000002",
 			"value": "0.000000000000000001",
-			"evmChainID": "54321"
+			"evmChainID": "54321",
+			"revertReason": ""
 		  }
 		}
 	  }
@@ -70,6 +72,7 @@ func TestEthTxResource(t *testing.T) {
 	)
 
 	tx.Sequence = &nonce
+	tx.RevertReason = null.StringFrom("insufficient balance")
 	txa := txmgr.TxAttempt{
 		Tx:                      tx,
 		Hash:                    hash,
@@ -100,7 +103,8 @@ func TestEthTxResource(t *testing.T) {
 			"sentAt": "300",
 			"to": "0x0000000000000000000000000000000000000002",
 			"value": "0.000000000000000001",
-			"evmChainID": "54321"
+			"evmChainID": "54321",
+			"revertReason": "insufficient balance"
 		  }
 		}
 	  }
@@ -39,6 +39,7 @@ const (
 	BlockHeaderFeederJobSpec JobSpecType = "blockheaderfeeder"
 	BootstrapJobSpec         JobSpecType = "bootstrap"
 	GatewayJobSpec           JobSpecType = "gateway"
+	WorkflowJobSpec          JobSpecType = "workflow"
 )
 
 // DirectRequestSpec defines the spec details of a DirectRequest Job
@@ -429,6 +430,24 @@ func NewGatewaySpec(spec *job.GatewaySpec) *GatewaySpec {
 	}
 }
 
+type WorkflowSpec struct {
+	Workflow      string    `json:"workflow"`
+	WorkflowID    string    `json:"workflowId"`
+	WorkflowOwner string    `json:"workflowOwner"`
+	CreatedAt     time.Time `json:"createdAt"`
+	UpdatedAt     time.Time `json:"updatedAt"`
+}
+
+func NewWorkflowSpec(spec *job.WorkflowSpec) *WorkflowSpec {
+	return &WorkflowSpec{
+		Workflow:      spec.Workflow,
+		WorkflowID:    spec.WorkflowID,
+		WorkflowOwner: spec.WorkflowOwner,
+		CreatedAt:     spec.CreatedAt,
+		UpdatedAt:     spec.UpdatedAt,
+	}
+}
+
 // JobError represents errors on the job
 type JobError struct {
 	ID          int64     `json:"id"`
@@ -457,7 +476,10 @@ type JobResource struct {
 	SchemaVersion          uint32                  `json:"schemaVersion"`
 	GasLimit               clnull.Uint32           `json:"gasLimit"`
 	ForwardingAllowed      bool                    `json:"forwardingAllowed"`
+	SimulateTransactions   bool                    `json:"simulateTransactions"`
+	SigningKeys            []string                `json:"signingKeys,omitempty"`
 	MaxTaskDuration        models.Interval         `json:"maxTaskDuration"`
+	ReaperThreshold        models.Interval         `json:"reaperThreshold,omitempty"`
 	ExternalJobID          uuid.UUID               `json:"externalJobID"`
 	DirectRequestSpec      *DirectRequestSpec      `json:"directRequestSpec"`
 	FluxMonitorSpec        *FluxMonitorSpec        `json:"fluxMonitorSpec"`
@@ -471,6 +493,7 @@ type JobResource struct {
 	BlockHeaderFeederSpec  *BlockHeaderFeederSpec  `json:"blockHeaderFeederSpec"`
 	BootstrapSpec          *BootstrapSpec          `json:"bootstrapSpec"`
 	GatewaySpec            *GatewaySpec            `json:"gatewaySpec"`
+	WorkflowSpec           *WorkflowSpec           `json:"workflowSpec"`
 	PipelineSpec           PipelineSpec            `json:"pipelineSpec"`
 	Errors                 []JobError              `json:"errors"`
 }
@@ -478,16 +501,19 @@ type JobResource struct {
 // NewJobResource initializes a new JSONAPI job resource
 func NewJobResource(j job.Job) *JobResource {
 	resource := &JobResource{
-		JAID:              NewJAIDInt32(j.ID),
-		Name:              j.Name.ValueOrZero(),
-		StreamID:          j.StreamID,
-		Type:              JobSpecType(j.Type),
-		SchemaVersion:     j.SchemaVersion,
-		GasLimit:          j.GasLimit,
-		ForwardingAllowed: j.ForwardingAllowed,
-		MaxTaskDuration:   j.MaxTaskDuration,
-		PipelineSpec:      NewPipelineSpec(j.PipelineSpec),
-		ExternalJobID:     j.ExternalJobID,
+		JAID:                 NewJAIDInt32(j.ID),
+		Name:                 j.Name.ValueOrZero(),
+		StreamID:             j.StreamID,
+		Type:                 JobSpecType(j.Type),
+		SchemaVersion:        j.SchemaVersion,
+		GasLimit:             j.GasLimit,
+		ForwardingAllowed:    j.ForwardingAllowed,
+		SimulateTransactions: j.SimulateTransactions,
+		SigningKeys:          j.SigningKeys,
+		MaxTaskDuration:      j.MaxTaskDuration,
+		ReaperThreshold:      j.ReaperThreshold,
+		PipelineSpec:         NewPipelineSpec(j.PipelineSpec),
+		ExternalJobID:        j.ExternalJobID,
 	}
 
 	switch j.Type {
@@ -518,7 +544,7 @@ func NewJobResource(j job.Job) *JobResource {
 	case job.Stream:
 		// no spec; nothing to do
 	case job.Workflow:
-		// no spec; nothing to do
+		resource.WorkflowSpec = NewWorkflowSpec(j.WorkflowSpec)
 	case job.LegacyGasStationServer, job.LegacyGasStationSidecar:
 		// unsupported
 	}
@@ -0,0 +1,104 @@
+package presenters
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gopkg.in/guregu/null.v4"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
+)
+
+// JobSimulationResource is the result of a simulated run of a job's pipeline.
+type JobSimulationResource struct {
+	JAID
+	Outputs     []*string                  `json:"outputs"`
+	AllErrors   []*string                  `json:"allErrors"`
+	FatalErrors []*string                  `json:"fatalErrors"`
+	TaskRuns    []SimulatedTaskRunResource `json:"taskRuns"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r JobSimulationResource) GetName() string {
+	return "jobSimulations"
+}
+
+// SimulatedTaskRunResource is a task run of a simulation, with the inputs it was run with and how
+// long it took.
+type SimulatedTaskRunResource struct {
+	Type       pipeline.TaskType `json:"type"`
+	DotID      string            `json:"dotId"`
+	Inputs     []*string         `json:"inputs"`
+	Output     *string           `json:"output"`
+	Error      *string           `json:"error"`
+	CreatedAt  time.Time         `json:"createdAt"`
+	FinishedAt null.Time         `json:"finishedAt"`
+	Duration   string            `json:"duration"`
+}
+
+// NewJobSimulationResource constructs a new JobSimulationResource
+func NewJobSimulationResource(run pipeline.Run, trrs pipeline.TaskRunResults, lggr logger.Logger) JobSimulationResource {
+	lggr = lggr.Named("JobSimulationResource")
+
+	outputs, err := run.StringOutputs()
+	if err != nil {
+		lggr.Errorw(err.Error(), "out", run.Outputs)
+	}
+
+	results := make(map[int]pipeline.Result, len(trrs))
+	for _, trr := range trrs {
+		results[trr.Task.ID()] = trr.Result
+	}
+
+	var taskRuns []SimulatedTaskRunResource
+	for _, trr := range trrs {
+		var inputs []*string
+		for _, dep := range trr.Task.Inputs() {
+			if !dep.PropagateResult {
+				continue
+			}
+			inputs = append(inputs, jsonString(results[dep.InputTask.ID()].OutputDB()))
+		}
+
+		var errString *string
+		if e := trr.Result.ErrorDB(); e.Valid {
+			errString = &e.String
+		}
+		var duration string
+		if trr.FinishedAt.Valid {
+			duration = trr.FinishedAt.Time.Sub(trr.CreatedAt).String()
+		}
+
+		taskRuns = append(taskRuns, SimulatedTaskRunResource{
+			Type:       trr.Task.Type(),
+			DotID:      trr.Task.DotID(),
+			Inputs:     inputs,
+			Output:     jsonString(trr.Result.OutputDB()),
+			Error:      errString,
+			CreatedAt:  trr.CreatedAt,
+			FinishedAt: trr.FinishedAt,
+			Duration:   duration,
+		})
+	}
+
+	return JobSimulationResource{
+		JAID:        NewJAID(uuid.New().String()),
+		Outputs:     outputs,
+		AllErrors:   run.StringAllErrors(),
+		FatalErrors: run.StringFatalErrors(),
+		TaskRuns:    taskRuns,
+	}
+}
+
+func jsonString(v pipeline.JSONSerializable) *string {
+	if !v.Valid {
+		return nil
+	}
+	b, err := v.MarshalJSON()
+	if err != nil {
+		return nil
+	}
+	s := string(b)
+	return &s
+}
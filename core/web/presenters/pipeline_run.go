@@ -19,6 +19,7 @@ type PipelineRunResource struct {
 	AllErrors    []*string                 `json:"allErrors"`
 	FatalErrors  []*string                 `json:"fatalErrors"`
 	Inputs       pipeline.JSONSerializable `json:"inputs"`
+	State        pipeline.RunStatus        `json:"state"`
 	TaskRuns     []PipelineTaskRunResource `json:"taskRuns"`
 	CreatedAt    time.Time                 `json:"createdAt"`
 	FinishedAt   null.Time                 `json:"finishedAt"`
@@ -51,6 +52,7 @@ func NewPipelineRunResource(pr pipeline.Run, lggr logger.Logger) PipelineRunReso
 		AllErrors:    pr.StringAllErrors(),
 		FatalErrors:  fatalErrors,
 		Inputs:       pr.Inputs,
+		State:        pr.State,
 		TaskRuns:     trs,
 		CreatedAt:    pr.CreatedAt,
 		FinishedAt:   pr.FinishedAt,
@@ -0,0 +1,21 @@
+package presenters
+
+// WorkflowTriggerResource is a JSONAPI resource for a request made to the
+// http trigger of a workflow.
+type WorkflowTriggerResource struct {
+	JAID
+	WorkflowID string `json:"workflowId"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r WorkflowTriggerResource) GetName() string {
+	return "workflow_triggers"
+}
+
+// NewWorkflowTriggerResource returns a new WorkflowTriggerResource for the request with the given ID.
+func NewWorkflowTriggerResource(requestID string, workflowID string) WorkflowTriggerResource {
+	return WorkflowTriggerResource{
+		JAID:       NewJAID(requestID),
+		WorkflowID: workflowID,
+	}
+}
@@ -1049,7 +1049,23 @@ func (r *Resolver) CreateJob(ctx context.Context, args struct {
 	case job.Gateway:
 		jb, err = gateway.ValidatedGatewaySpec(args.Input.TOML)
 	case job.Workflow:
-		jb, err = workflows.ValidatedWorkflowSpec(args.Input.TOML)
+		jb, err = workflows.ValidatedWorkflowSpec(ctx, args.Input.TOML, r.App.GetCapabilitiesRegistry())
+		var validationErrs workflows.ValidationErrors
+		if errors.As(err, &validationErrs) {
+			inputErrs := map[string]string{}
+			for _, e := range validationErrs {
+				key := "workflow"
+				if e.Ref != "" {
+					key = "workflow." + e.Ref
+				}
+				if msg, ok := inputErrs[key]; ok {
+					inputErrs[key] = msg + "; " + e.Msg
+				} else {
+					inputErrs[key] = e.Msg
+				}
+			}
+			return NewCreateJobPayload(r.App, nil, inputErrs), nil
+		}
 	default:
 		return NewCreateJobPayload(r.App, nil, map[string]string{
 			"Job Type": fmt.Sprintf("unknown job type: %s", jbt),
@@ -117,6 +117,14 @@ func (r *SpecResolver) ToGatewaySpec() (*GatewaySpecResolver, bool) {
 	return &GatewaySpecResolver{spec: *r.j.GatewaySpec}, true
 }
 
+func (r *SpecResolver) ToWorkflowSpec() (*WorkflowSpecResolver, bool) {
+	if r.j.Type != job.Workflow {
+		return nil, false
+	}
+
+	return &WorkflowSpecResolver{spec: *r.j.WorkflowSpec}, true
+}
+
 type CronSpecResolver struct {
 	spec job.CronSpec
 }
@@ -970,3 +978,31 @@ func (r *GatewaySpecResolver) GatewayConfig() gqlscalar.Map {
 func (r *GatewaySpecResolver) CreatedAt() graphql.Time {
 	return graphql.Time{Time: r.spec.CreatedAt}
 }
+
+type WorkflowSpecResolver struct {
+	spec job.WorkflowSpec
+}
+
+func (r *WorkflowSpecResolver) ID() graphql.ID {
+	return graphql.ID(stringutils.FromInt32(r.spec.ID))
+}
+
+func (r *WorkflowSpecResolver) WorkflowID() string {
+	return r.spec.WorkflowID
+}
+
+func (r *WorkflowSpecResolver) Workflow() string {
+	return r.spec.Workflow
+}
+
+func (r *WorkflowSpecResolver) WorkflowOwner() string {
+	return r.spec.WorkflowOwner
+}
+
+func (r *WorkflowSpecResolver) CreatedAt() graphql.Time {
+	return graphql.Time{Time: r.spec.CreatedAt}
+}
+
+func (r *WorkflowSpecResolver) UpdatedAt() graphql.Time {
+	return graphql.Time{Time: r.spec.UpdatedAt}
+}
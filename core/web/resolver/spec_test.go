@@ -1050,3 +1050,66 @@ func TestResolver_GatewaySpec(t *testing.T) {
 
 	RunGQLTests(t, testCases)
 }
+
+func TestResolver_WorkflowSpec(t *testing.T) {
+	var (
+		id = int32(1)
+	)
+
+	testCases := []GQLTestCase{
+		{
+			name:          "Workflow spec",
+			authenticated: true,
+			before: func(f *gqlTestFramework) {
+				f.App.On("JobORM").Return(f.Mocks.jobORM)
+				f.Mocks.jobORM.On("FindJobWithoutSpecErrors", id).Return(job.Job{
+					Type: job.Workflow,
+					WorkflowSpec: &job.WorkflowSpec{
+						ID:            id,
+						WorkflowID:    "15c631d295ef5e32deb99a10ee6804bc4af1385568f9b3363f6552ac6dbb2cef",
+						Workflow:      "triggers: []",
+						WorkflowOwner: "00000000000000000000000000000000000000aa",
+						CreatedAt:     f.Timestamp(),
+						UpdatedAt:     f.Timestamp(),
+					},
+				}, nil)
+			},
+			query: `
+				query GetJob {
+					job(id: "1") {
+						... on Job {
+							spec {
+								__typename
+								... on WorkflowSpec {
+									id
+									workflowID
+									workflow
+									workflowOwner
+									createdAt
+									updatedAt
+								}
+							}
+						}
+					}
+				}
+			`,
+			result: `
+				{
+					"job": {
+						"spec": {
+							"__typename": "WorkflowSpec",
+							"id": "1",
+							"workflowID": "15c631d295ef5e32deb99a10ee6804bc4af1385568f9b3363f6552ac6dbb2cef",
+							"workflow": "triggers: []",
+							"workflowOwner": "00000000000000000000000000000000000000aa",
+							"createdAt": "2021-01-01T00:00:00Z",
+							"updatedAt": "2021-01-01T00:00:00Z"
+						}
+					}
+				}
+			`,
+		},
+	}
+
+	RunGQLTests(t, testCases)
+}
@@ -370,13 +370,17 @@ func v2Routes(app chainlink.Application, r *gin.RouterGroup) {
 		authv2.GET("/jobs", paginatedRequest(jc.Index))
 		authv2.GET("/jobs/:ID", jc.Show)
 		authv2.POST("/jobs", auth.RequiresEditRole(jc.Create))
+		authv2.POST("/jobs/simulate", auth.RequiresEditRole(jc.Simulate))
 		authv2.PUT("/jobs/:ID", auth.RequiresEditRole(jc.Update))
 		authv2.DELETE("/jobs/:ID", auth.RequiresEditRole(jc.Delete))
 
 		// PipelineRunsController
 		authv2.GET("/pipeline/runs", paginatedRequest(prc.Index))
 		authv2.GET("/jobs/:ID/runs", paginatedRequest(prc.Index))
+		authv2.GET("/pipeline/runs/stream", prc.Stream)
+		authv2.GET("/jobs/:ID/runs/stream", prc.Stream)
 		authv2.GET("/jobs/:ID/runs/:runID", prc.Show)
+		authv2.POST("/pipeline/runs/:runID/cancel", auth.RequiresRunRole(prc.Cancel))
 
 		// FeaturesController
 		fc := FeaturesController{app}
@@ -441,6 +445,9 @@ func v2Routes(app chainlink.Application, r *gin.RouterGroup) {
 	))
 	userOrEI.GET("/ping", ping.Show)
 	userOrEI.POST("/jobs/:ID/runs", auth.RequiresRunRole(prc.Create))
+
+	wtc := WorkflowTriggersController{app}
+	userOrEI.POST("/workflows/:ID/trigger", auth.RequiresRunRole(wtc.Create))
 }
 
 // This is higher because it serves main.js and any static images. There are
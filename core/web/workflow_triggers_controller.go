@@ -0,0 +1,69 @@
+package web
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/v2/core/capabilities/triggers"
+	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/v2/core/web/auth"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+// WorkflowTriggersController triggers workflows through their http trigger.
+type WorkflowTriggersController struct {
+	App chainlink.Application
+}
+
+// Create sends the request body to the http trigger of a workflow.
+// Example:
+// "POST <application>/workflows/:ID/trigger"
+func (wtc *WorkflowTriggersController) Create(c *gin.Context) {
+	bodyBytes, err := io.ReadAll(c.Request.Body)
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+	workflowID := c.Param("ID")
+
+	// as for webhook jobs, external initiators must be enabled and allowed by the workflow
+	var initiator string
+	if ei, ok := auth.GetAuthenticatedExternalInitiator(c); ok {
+		if !wtc.App.GetConfig().JobPipeline().ExternalInitiatorsEnabled() {
+			jsonAPIError(c, http.StatusUnauthorized, errors.New("external initiators are disabled"))
+			return
+		}
+		initiator = ei.Name
+	}
+
+	ctx := c.Request.Context()
+	capability, err := wtc.App.GetCapabilitiesRegistry().GetTrigger(ctx, triggers.HTTPTriggerID)
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+	trigger, ok := capability.(*triggers.HTTPTrigger)
+	if !ok {
+		jsonAPIError(c, http.StatusInternalServerError, fmt.Errorf("unexpected %s capability %T", triggers.HTTPTriggerID, capability))
+		return
+	}
+
+	requestID, err := trigger.Trigger(ctx, workflowID, initiator, bodyBytes)
+	switch {
+	case errors.Is(err, triggers.ErrNoHTTPTrigger):
+		jsonAPIError(c, http.StatusNotFound, err)
+		return
+	case errors.Is(err, triggers.ErrNotAuthorized):
+		jsonAPIError(c, http.StatusUnauthorized, errors.Errorf("external initiator %s is not allowed to trigger workflow %s", initiator, workflowID))
+		return
+	case err != nil:
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	jsonAPIResponseWithStatus(c, presenters.NewWorkflowTriggerResource(requestID, workflowID), "workflow trigger", http.StatusAccepted)
+}
@@ -0,0 +1,87 @@
+package web_test
+
+import (
+	"bytes"
+	"net/http"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/capabilities"
+	"github.com/smartcontractkit/chainlink-common/pkg/values"
+
+	"github.com/smartcontractkit/chainlink/v2/core/auth"
+	"github.com/smartcontractkit/chainlink/v2/core/bridges"
+	"github.com/smartcontractkit/chainlink/v2/core/capabilities/triggers"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/configtest"
+	clhttptest "github.com/smartcontractkit/chainlink/v2/core/internal/testutils/httptest"
+	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/v2/core/web"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+func TestWorkflowTriggersController_Create(t *testing.T) {
+	t.Parallel()
+
+	ctx := testutils.Context(t)
+	app := cltest.NewApplicationWithConfig(t,
+		configtest.NewGeneralConfig(t, func(c *chainlink.Config, s *chainlink.Secrets) {
+			c.JobPipeline.ExternalInitiatorsEnabled = ptr(true)
+		}))
+	require.NoError(t, app.Start(ctx))
+
+	eia := &auth.Token{AccessKey: "abracadabra", Secret: "opensesame"}
+	eiURL := cltest.WebURL(t, "http://localhost:8888")
+	ei, err := bridges.NewExternalInitiator(eia, &bridges.ExternalInitiatorRequest{Name: uuid.New().String(), URL: &eiURL})
+	require.NoError(t, err)
+	require.NoError(t, app.BridgeORM().CreateExternalInitiator(ei))
+
+	trigger, err := app.GetCapabilitiesRegistry().GetTrigger(ctx, triggers.HTTPTriggerID)
+	require.NoError(t, err)
+	inputs, err := values.NewMap(map[string]any{"triggerId": "a-trigger"})
+	require.NoError(t, err)
+	config, err := values.NewMap(map[string]any{"externalInitiators": []any{ei.Name}})
+	require.NoError(t, err)
+	events := make(chan capabilities.CapabilityResponse, 1)
+	require.NoError(t, trigger.RegisterTrigger(ctx, events, capabilities.CapabilityRequest{
+		Metadata: capabilities.RequestMetadata{WorkflowID: "a-workflow"},
+		Config:   config,
+		Inputs:   inputs,
+	}))
+
+	client := app.NewHTTPClient(nil)
+
+	resp, cleanup := client.Post("/v2/workflows/another-workflow/trigger", nil)
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusNotFound)
+
+	resp, cleanup = client.Post("/v2/workflows/a-workflow/trigger", bytes.NewBufferString(`{"a":`))
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusUnprocessableEntity)
+
+	resp, cleanup = client.Post("/v2/workflows/a-workflow/trigger", bytes.NewBufferString(`{"a":"b"}`))
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusAccepted)
+	var resource presenters.WorkflowTriggerResource
+	require.NoError(t, web.ParseJSONAPIResponse(cltest.ParseResponseBody(t, resp), &resource))
+	assert.Equal(t, "a-workflow", resource.WorkflowID)
+	event := <-events
+	assert.Equal(t, values.NewString(resource.ID), event.Value.(*values.Map).Underlying["requestId"])
+
+	// the external initiator is allowed by the trigger's config
+	request, err := http.NewRequestWithContext(ctx, "POST", app.Server.URL+"/v2/workflows/a-workflow/trigger", nil)
+	require.NoError(t, err)
+	request.Header.Set("Content-Type", web.MediaType)
+	request.Header.Set("X-Chainlink-EA-AccessKey", eia.AccessKey)
+	request.Header.Set("X-Chainlink-EA-Secret", eia.Secret)
+	eiResp, err := clhttptest.NewTestLocalOnlyHTTPClient().Do(request)
+	require.NoError(t, err)
+	defer func() { assert.NoError(t, eiResp.Body.Close()) }()
+	cltest.AssertServerResponse(t, eiResp, http.StatusAccepted)
+	event = <-events
+	assert.Equal(t, values.NewString(ei.Name), event.Value.(*values.Map).Underlying["initiator"])
+}